@@ -10,9 +10,13 @@ import (
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/credentials"
 	balancerConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/balancer/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/certificates"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/config"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/meta"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry/breaker"
 	"github.com/ydb-platform/ydb-go-sdk/v3/retry/budget"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry/limiter"
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
@@ -24,6 +28,8 @@ type Config struct {
 	dialTimeout    time.Duration
 	connectionTTL  time.Duration
 	balancerConfig *balancerConfig.Config
+	circuitBreaker *breaker.Config
+	limiter        *limiter.Config
 	secure         bool
 	endpoint       string
 	database       string
@@ -33,6 +39,9 @@ type Config struct {
 	tlsConfig      *tls.Config
 	meta           *meta.Meta
 
+	rootsReloader      *certificates.Reloader
+	clientCertReloader *certificates.Reloader
+
 	excludeGRPCCodesForPessimization []grpcCodes.Code
 }
 
@@ -48,11 +57,41 @@ func (c *Config) ExcludeGRPCCodesForPessimization() []grpcCodes.Code {
 // GrpcDialOptions reports about used grpc dialing options
 func (c *Config) GrpcDialOptions() []grpc.DialOption {
 	return append(
-		defaultGrpcOptions(c.trace, c.secure, c.tlsConfig),
+		defaultGrpcOptions(c.trace, c.secure, c.reloadableTLSConfig()),
 		c.grpcOptions...,
 	)
 }
 
+// reloadableTLSConfig returns copy of TLS config with hooks for reload certificates from files
+// on new handshakes, if reloadable certificates defined
+func (c *Config) reloadableTLSConfig() *tls.Config {
+	if c.rootsReloader == nil && c.clientCertReloader == nil {
+		return c.tlsConfig
+	}
+	tlsConfig := c.tlsConfig.Clone()
+	if c.clientCertReloader != nil {
+		tlsConfig.GetClientCertificate = c.clientCertReloader.GetClientCertificate(c.trace)
+	}
+	if c.rootsReloader != nil && !tlsConfig.InsecureSkipVerify {
+		verify := c.rootsReloader.VerifyConnection(tlsConfig.RootCAs, c.trace)
+		if next := tlsConfig.VerifyConnection; next != nil {
+			tlsConfig.VerifyConnection = func(cs tls.ConnectionState) error {
+				if err := verify(cs); err != nil {
+					return err
+				}
+
+				return next(cs)
+			}
+		} else {
+			tlsConfig.VerifyConnection = verify
+		}
+		// server certificate verifies in VerifyConnection with actual CA certificates
+		tlsConfig.InsecureSkipVerify = true //nolint:gosec
+	}
+
+	return tlsConfig
+}
+
 // Meta reports meta information about database connection
 func (c *Config) Meta() *meta.Meta {
 	return c.meta
@@ -104,6 +143,18 @@ func (c *Config) Balancer() *balancerConfig.Config {
 	return c.balancerConfig
 }
 
+// CircuitBreaker is an optional configuration of client-side circuit breakers per endpoint.
+// Circuit breakers disabled if nil
+func (c *Config) CircuitBreaker() *breaker.Config {
+	return c.circuitBreaker
+}
+
+// ConcurrencyLimiter is an optional configuration of adaptive concurrency limiters per service.
+// Concurrency limiters disabled if nil
+func (c *Config) ConcurrencyLimiter() *limiter.Config {
+	return c.limiter
+}
+
 type Option func(c *Config)
 
 // WithInternalDNSResolver
@@ -143,6 +194,24 @@ func WithCertificate(certificate *x509.Certificate) Option {
 	}
 }
 
+// WithReloadableCertificatesFromFile appends CA certificates from file which reloads on new connections
+// after changes of file (for example, rotation of certificates by cert-manager)
+func WithReloadableCertificatesFromFile(caFile string, opts ...certificates.ReloaderOption) Option {
+	return func(c *Config) {
+		c.rootsReloader = certificates.NewRootsReloader(caFile, opts...)
+	}
+}
+
+// WithReloadableClientCertificateFromFiles sets client certificate from files which reloads on new connections
+// after changes of files (for example, rotation of certificates by cert-manager)
+func WithReloadableClientCertificateFromFiles(
+	certFile, keyFile string, opts ...certificates.ReloaderOption,
+) Option {
+	return func(c *Config) {
+		c.clientCertReloader = certificates.NewClientCertificateReloader(certFile, keyFile, opts...)
+	}
+}
+
 // WithTLSConfig replaces older TLS config
 //
 // Warning: all early changes of TLS config will be lost
@@ -165,6 +234,36 @@ func WithRetryBudget(b budget.Budget) Option {
 	}
 }
 
+// WithRetryPolicy applies retry policy to retry loops of all clients (table, query, scheme, scripting,
+// coordination, ratelimiter, topic control plane and database/sql with retry.Do and retry.DoTx helpers)
+// and to invalidation of table and query sessions on errors.
+// Reconnects of topic reader and writer streams are not affected (see topicoptions retry settings).
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithRetryPolicy(p *retry.Policy) Option {
+	return func(c *Config) {
+		config.SetRetryPolicy(&c.Common, p)
+	}
+}
+
+// WithCircuitBreaker enables client-side circuit breakers per endpoint (and optionally per retry label)
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithCircuitBreaker(opts ...breaker.Option) Option {
+	return func(c *Config) {
+		c.circuitBreaker = breaker.New(opts...)
+	}
+}
+
+// WithConcurrencyLimiter enables adaptive concurrency limiters of calls per service
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithConcurrencyLimiter(opts ...limiter.Option) Option {
+	return func(c *Config) {
+		c.limiter = limiter.New(opts...)
+	}
+}
+
 func WithTraceRetry(t *trace.Retry, opts ...trace.RetryComposeOption) Option {
 	return func(c *Config) {
 		config.SetTraceRetry(&c.Common, t, opts...)
@@ -35,6 +35,38 @@ func NewStaticCredentials(
 	return credentials.NewStaticCredentials(user, password, authEndpoint, opts...)
 }
 
+// NewTokenFileCredentials makes credentials object with token from file
+// Token re-reads from file after changes of file (for example, rotation of kubernetes projected token),
+// file checks for changes not often than refresh interval (see WithTokenFileRefreshInterval)
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func NewTokenFileCredentials(
+	path string, opts ...credentials.TokenFileCredentialsOption,
+) *credentials.TokenFile {
+	return credentials.NewTokenFileCredentials(path, opts...)
+}
+
+// FromEnviron makes credentials from standard YDB environment variables
+//
+// Variables checks in order:
+//   - YDB_ANONYMOUS_CREDENTIALS=1 - anonymous credentials
+//   - YDB_ACCESS_TOKEN_CREDENTIALS - access token
+//   - YDB_STATIC_CREDENTIALS_USER, YDB_STATIC_CREDENTIALS_PASSWORD and YDB_STATIC_CREDENTIALS_ENDPOINT -
+//     static credentials
+//   - YDB_OAUTH2_KEY_FILE - path to config file of OAuth 2.0 token exchange credentials
+//
+// Service account key file (YDB_SERVICE_ACCOUNT_KEY_FILE_CREDENTIALS) and metadata service
+// (YDB_METADATA_CREDENTIALS=1) credentials are not implemented in ydb-go-sdk. These variables checks
+// only if constructor of credentials is passed with WithEnvironServiceAccountKeyFile or WithEnvironMetadata
+// (for example, constructors from github.com/ydb-platform/ydb-go-yc).
+//
+// Anonymous credentials returns if no one variable defined.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func FromEnviron(opts ...credentials.EnvironOption) (Credentials, error) {
+	return credentials.FromEnviron(opts...)
+}
+
 // NewOauth2TokenExchangeCredentials makes OAuth 2.0 token exchange protocol credentials object
 // https://www.rfc-editor.org/rfc/rfc8693
 func NewOauth2TokenExchangeCredentials(
@@ -25,6 +25,32 @@ func WithGrpcDialOptions(opts ...grpc.DialOption) credentials.StaticCredentialsO
 	return credentials.WithGrpcDialOptions(opts...)
 }
 
+// WithTokenFileRefreshInterval sets interval between checks of token file for changes (1m by default)
+func WithTokenFileRefreshInterval(interval time.Duration) credentials.TokenFileRefreshIntervalOption {
+	return credentials.WithTokenFileRefreshInterval(interval)
+}
+
+// WithEnvironServiceAccountKeyFile sets constructor of credentials from service account key file and enables
+// YDB_SERVICE_ACCOUNT_KEY_FILE_CREDENTIALS in FromEnviron (for example, credentials from github.com/ydb-platform/ydb-go-yc)
+func WithEnvironServiceAccountKeyFile(f func(keyFile string) (Credentials, error)) credentials.EnvironOption {
+	return credentials.WithEnvironServiceAccountKeyFile(func(keyFile string) (credentials.Credentials, error) {
+		return f(keyFile)
+	})
+}
+
+// WithEnvironMetadata sets constructor of credentials from metadata service and enables
+// YDB_METADATA_CREDENTIALS in FromEnviron
+func WithEnvironMetadata(f func() (Credentials, error)) credentials.EnvironOption {
+	return credentials.WithEnvironMetadata(func() (credentials.Credentials, error) {
+		return f()
+	})
+}
+
+// WithEnvironStaticCredentialsOptions sets options of static credentials for FromEnviron
+func WithEnvironStaticCredentialsOptions(opts ...credentials.StaticCredentialsOption) credentials.EnvironOption {
+	return credentials.WithEnvironStaticCredentialsOptions(opts...)
+}
+
 // TokenEndpoint
 func WithTokenEndpoint(endpoint string) Oauth2TokenExchangeCredentialsOption {
 	return credentials.WithTokenEndpoint(endpoint)
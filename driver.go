@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/url"
 	"os"
 	"sync"
 
@@ -54,7 +55,9 @@ var _ Connection = (*Driver)(nil)
 type Driver struct {
 	ctxCancel context.CancelFunc
 
-	userInfo *dsn.UserInfo
+	userInfo                  *dsn.UserInfo
+	staticCredentialsEndpoint string
+	dsnParams                 url.Values
 
 	logger        log.Logger
 	loggerOpts    []log.Option
@@ -98,6 +101,8 @@ type Driver struct {
 	mtx      sync.Mutex
 	balancer *balancer.Balancer
 
+	faultRules []conn.FaultRule
+
 	children    map[uint64]*Driver
 	childrenMtx xsync.Mutex
 	onClose     []func(c *Driver)
@@ -241,7 +246,10 @@ func (d *Driver) Topic() topic.Client {
 //
 // DSN accept Driver string like
 //
-//	"grpc[s]://{endpoint}/{database}[?param=value]"
+//	"grpc[s]://[{user}:{password}@]{endpoint}/{database}[?param=value]"
+//
+// Supported params listed in description of dsnParams. Params of custom parsers must be declared on
+// RegisterDsnParser. Unknown params are errors.
 //
 // See sugar.DSN helper for make dsn from endpoint and database
 //
@@ -401,10 +409,14 @@ func (d *Driver) connect(ctx context.Context) (err error) {
 	}
 
 	if d.userInfo != nil {
+		staticCredentialsEndpoint := d.config.Endpoint()
+		if d.staticCredentialsEndpoint != "" {
+			staticCredentialsEndpoint = d.staticCredentialsEndpoint
+		}
 		d.config = d.config.With(config.WithCredentials(
 			credentials.NewStaticCredentials(
 				d.userInfo.User, d.userInfo.Password,
-				d.config.Endpoint(),
+				staticCredentialsEndpoint,
 				credentials.WithGrpcDialOptions(d.config.GrpcDialOptions()...),
 			),
 		))
@@ -419,9 +431,14 @@ func (d *Driver) connect(ctx context.Context) (err error) {
 		return xerrors.WithStackTrace(err)
 	}
 
+	var cc grpc.ClientConnInterface = d.balancer
+	if len(d.faultRules) > 0 {
+		cc = conn.WithFaults(cc, d.faultRules...)
+	}
+
 	d.table = xsync.OnceValue(func() (*internalTable.Client, error) {
 		return internalTable.New(xcontext.ValueOnly(ctx),
-			d.balancer,
+			cc,
 			tableConfig.New(
 				append(
 					// prepend common params from root config
@@ -436,7 +453,7 @@ func (d *Driver) connect(ctx context.Context) (err error) {
 
 	d.query = xsync.OnceValue(func() (*internalQuery.Client, error) {
 		return internalQuery.New(xcontext.ValueOnly(ctx),
-			d.balancer,
+			cc,
 			queryConfig.New(
 				append(
 					// prepend common params from root config
@@ -454,7 +471,7 @@ func (d *Driver) connect(ctx context.Context) (err error) {
 
 	d.scheme = xsync.OnceValue(func() (*internalScheme.Client, error) {
 		return internalScheme.New(xcontext.ValueOnly(ctx),
-			d.balancer,
+			cc,
 			schemeConfig.New(
 				append(
 					// prepend common params from root config
@@ -470,7 +487,7 @@ func (d *Driver) connect(ctx context.Context) (err error) {
 
 	d.coordination = xsync.OnceValue(func() (*internalCoordination.Client, error) {
 		return internalCoordination.New(xcontext.ValueOnly(ctx),
-			d.balancer,
+			cc,
 			coordinationConfig.New(
 				append(
 					// prepend common params from root config
@@ -485,7 +502,7 @@ func (d *Driver) connect(ctx context.Context) (err error) {
 
 	d.ratelimiter = xsync.OnceValue(func() (*internalRatelimiter.Client, error) {
 		return internalRatelimiter.New(xcontext.ValueOnly(ctx),
-			d.balancer,
+			cc,
 			ratelimiterConfig.New(
 				append(
 					// prepend common params from root config
@@ -525,7 +542,7 @@ func (d *Driver) connect(ctx context.Context) (err error) {
 
 	d.scripting = xsync.OnceValue(func() (*internalScripting.Client, error) {
 		return internalScripting.New(xcontext.ValueOnly(ctx),
-			d.balancer,
+			cc,
 			scriptingConfig.New(
 				append(
 					// prepend common params from root config
@@ -540,7 +557,7 @@ func (d *Driver) connect(ctx context.Context) (err error) {
 
 	d.topic = xsync.OnceValue(func() (*topicclientinternal.Client, error) {
 		return topicclientinternal.New(xcontext.ValueOnly(ctx),
-			d.balancer,
+			cc,
 			d.config.Credentials(),
 			append(
 				// prepend common params from root config
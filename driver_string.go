@@ -1,23 +1,91 @@
 package ydb
 
 import (
-	"fmt"
+	"net/url"
+	"strconv"
 
-	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xstring"
+	"github.com/ydb-platform/ydb-go-sdk/v3/config"
+	internalCredentials "github.com/ydb-platform/ydb-go-sdk/v3/internal/credentials"
+	discoveryConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/discovery/config"
+	tableConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/table/config"
 )
 
-// String returns string representation of Driver
+// String returns data source name of the driver which equivalent to DSN used for open the driver
+// and options of the driver.
+// Secrets (password of user info, token and secret params of custom DSN parsers) replaced with "***".
+//
+// Params, which can't be restored from config of driver (balancer, retry budget, params of database/sql
+// connector, files of certificates and credentials), are taken from DSN used for open the driver.
 func (d *Driver) String() string {
-	buffer := xstring.Buffer()
-	defer buffer.Free()
-	buffer.WriteString("Driver{")
-	fmt.Fprintf(buffer, "Endpoint:%q,", d.config.Endpoint())
-	fmt.Fprintf(buffer, "Database:%q,", d.config.Database())
-	fmt.Fprintf(buffer, "Secure:%v", d.config.Secure())
-	if c, has := d.config.Credentials().(fmt.Stringer); has {
-		fmt.Fprintf(buffer, ",Credentials:%v", c.String())
-	}
-	buffer.WriteByte('}')
-
-	return buffer.String()
+	u := url.URL{
+		Scheme: "grpcs",
+		Host:   d.config.Endpoint(),
+		Path:   d.config.Database(),
+	}
+	if !d.config.Secure() {
+		u.Scheme = "grpc"
+	}
+	if d.userInfo != nil {
+		if d.userInfo.Password != "" {
+			u.User = url.UserPassword(d.userInfo.User, redactedSecret)
+		} else {
+			u.User = url.User(d.userInfo.User)
+		}
+	}
+
+	params := d.effectiveDsnParams()
+	for name := range params {
+		if isSecretDsnParam(name) {
+			params[name] = []string{redactedSecret}
+		}
+	}
+	u.RawQuery = params.Encode()
+
+	return u.String()
+}
+
+// effectiveDsnParams returns DSN params from effective config of driver. Params with default values are skipped.
+func (d *Driver) effectiveDsnParams() url.Values {
+	params := make(url.Values, len(d.dsnParams))
+	for name, values := range d.dsnParams {
+		params[name] = values
+	}
+
+	var (
+		defaults          = config.New()
+		table             = tableConfig.New(d.tableOptions...)
+		defaultTable      = tableConfig.New()
+		discovery         = discoveryConfig.New(d.discoveryOptions...)
+		defaultsDiscovery = discoveryConfig.New()
+	)
+
+	if _, has := d.config.Credentials().(*internalCredentials.AccessToken); has {
+		params.Set("token", redactedSecret)
+	}
+	if v := d.config.DialTimeout(); v != defaults.DialTimeout() {
+		params.Set("dial_timeout", v.String())
+	}
+	if v := d.config.ConnectionTTL(); v != defaults.ConnectionTTL() {
+		params.Set("connection_ttl", v.String())
+	}
+	if tlsConfig := d.config.TLSConfig(); tlsConfig != nil && tlsConfig.InsecureSkipVerify {
+		params.Set("insecure_skip_verify", strconv.FormatBool(true))
+	}
+	if v := d.config.Meta().ApplicationName(); v != "" {
+		params.Set("application_name", v)
+	}
+	if v := table.SizeLimit(); v != defaultTable.SizeLimit() {
+		params.Set("session_pool_size_limit", strconv.Itoa(v))
+	}
+	if v := table.IdleThreshold(); v != defaultTable.IdleThreshold() {
+		params.Set("session_pool_idle_threshold", v.String())
+	}
+	if v := discovery.Interval(); v != defaultsDiscovery.Interval() {
+		params.Set("discovery_interval", v.String())
+	}
+	if d.staticCredentialsEndpoint != "" {
+		params.Set("static_credentials_endpoint", d.staticCredentialsEndpoint)
+	}
+
+	return params
 }
@@ -1,10 +1,15 @@
 package ydb
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"net/url"
 	"regexp"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/balancers"
 	"github.com/ydb-platform/ydb-go-sdk/v3/credentials"
@@ -12,9 +17,64 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/dsn"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry/budget"
 )
 
-const tablePathPrefixTransformer = "table_path_prefix"
+const (
+	tablePathPrefixTransformer = "table_path_prefix"
+	retryBudgetPercent         = "percent"
+	retryBudgetLimited         = "limited"
+	redactedSecret             = "***"
+)
+
+// dsnParams describes known parameters of connection string. Value of param is true for secret params
+// which must be redacted on Driver.String()
+//
+// Supported parameters:
+//
+//   - token - access token for authenticate requests
+//   - balancer (go_balancer) - balancer config, see balancers.FromConfig
+//   - query_mode (go_query_mode) - default query mode of database/sql connector
+//     (scripting, scheme, data, query, ...)
+//   - go_fake_tx - comma separated list of query modes with fake transactions in database/sql connector
+//   - go_query_bind - comma separated list of query binders of database/sql connector
+//     (declare, positional, numeric, named, slices, table_path_prefix(path))
+//   - dial_timeout - timeout of establishing connections, for example 5s
+//   - connection_ttl - duration for parking idle connections, for example 5m
+//   - ca_file - path to file with certificates of CA in PEM format
+//   - insecure_skip_verify - skip verifying of server certificate (true or false)
+//   - application_name - application name which attached to all api requests
+//   - session_pool_size_limit - max size of sessions pool
+//   - session_pool_idle_threshold - interval for idle sessions, for example 5m
+//   - discovery_interval - interval of discovery of cluster endpoints, for example 1m
+//   - retry_budget - retry budget as percent(N) (percent of retries of all attempts)
+//     or limited(N) (retries per second)
+//   - static_credentials_endpoint - endpoint for request token by user and password from DSN
+//     (initial endpoint by default)
+//   - oauth2_config_file - path to config file of OAuth 2.0 token exchange credentials,
+//     see ydb.WithOauth2TokenExchangeCredentialsFile
+var dsnParams = map[string]bool{
+	"token":                       true,
+	"balancer":                    false,
+	"go_balancer":                 false,
+	"query_mode":                  false,
+	"go_query_mode":               false,
+	"go_fake_tx":                  false,
+	"go_query_bind":               false,
+	"dial_timeout":                false,
+	"connection_ttl":              false,
+	"ca_file":                     false,
+	"insecure_skip_verify":        false,
+	"application_name":            false,
+	"session_pool_size_limit":     false,
+	"session_pool_idle_threshold": false,
+	"discovery_interval":          false,
+	"retry_budget":                false,
+	"static_credentials_endpoint": false,
+	"oauth2_config_file":          false,
+}
+
+var errUnknownDsnParam = errors.New("unknown data source name parameter")
 
 var dsnParsers = []func(dsn string) (opts []Option, _ error){
 	func(dsn string) ([]Option, error) {
@@ -27,13 +87,43 @@ var dsnParsers = []func(dsn string) (opts []Option, _ error){
 	},
 }
 
+// customDsnParams are names of DSN parameters, accepted by registered DSN parsers, by registration ID.
+// Value of param is true for secret params
+var customDsnParams = map[int]map[string]bool{}
+
+// secretDsnParamPrefix marks secret params of RegisterDsnParser
+const secretDsnParamPrefix = "secret:"
+
+// SecretDsnParam marks DSN parameter of custom parser as secret on RegisterDsnParser.
+// Values of secret params are replaced with "***" on Driver.String()
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func SecretDsnParam(name string) string {
+	return secretDsnParamPrefix + name
+}
+
 // RegisterDsnParser registers DSN parser for ydb.Open and sql.Open driver constructors
 //
+// Params are names of DSN parameters which parser accepts. Parameters, unknown for builtin and registered parsers,
+// are errors of ydb.Open and sql.Open. Secret params (tokens, passwords, etc.) must be wrapped with SecretDsnParam
+//
 // Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
-func RegisterDsnParser(parser func(dsn string) (opts []Option, _ error)) (registrationID int) {
+func RegisterDsnParser(parser func(dsn string) (opts []Option, _ error), params ...string) (registrationID int) {
 	dsnParsers = append(dsnParsers, parser)
+	registrationID = len(dsnParsers) - 1
+	if len(params) > 0 {
+		names := make(map[string]bool, len(params))
+		for _, param := range params {
+			if name, secret := strings.CutPrefix(param, secretDsnParamPrefix); secret {
+				names[name] = true
+			} else if _, has := names[param]; !has {
+				names[param] = false
+			}
+		}
+		customDsnParams[registrationID] = names
+	}
 
-	return len(dsnParsers) - 1
+	return registrationID
 }
 
 // UnregisterDsnParser unregisters DSN parser by key
@@ -41,6 +131,34 @@ func RegisterDsnParser(parser func(dsn string) (opts []Option, _ error)) (regist
 // Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
 func UnregisterDsnParser(registrationID int) {
 	dsnParsers[registrationID] = nil
+	delete(customDsnParams, registrationID)
+}
+
+func isKnownDsnParam(name string) bool {
+	if _, has := dsnParams[name]; has {
+		return true
+	}
+	for _, params := range customDsnParams {
+		if _, has := params[name]; has {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isSecretDsnParam reports whether value of DSN param must be redacted
+func isSecretDsnParam(name string) bool {
+	if dsnParams[name] {
+		return true
+	}
+	for _, params := range customDsnParams {
+		if params[name] {
+			return true
+		}
+	}
+
+	return false
 }
 
 //nolint:funlen
@@ -49,7 +167,10 @@ func parseConnectionString(dataSourceName string) (opts []Option, _ error) {
 	if err != nil {
 		return nil, xerrors.WithStackTrace(err)
 	}
-	opts = append(opts, With(info.Options...))
+	if err = checkDsnParams(info.Params); err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	opts = append(opts, With(info.Options...), withDsnParams(info.Params))
 	if token := info.Params.Get("token"); token != "" {
 		opts = append(opts, WithCredentials(credentials.NewAccessTokenCredentials(token)))
 	}
@@ -91,6 +212,10 @@ func parseConnectionString(dataSourceName string) (opts []Option, _ error) {
 				binders = append(binders, xsql.WithQueryBind(bind.PositionalArgs{}))
 			case "numeric":
 				binders = append(binders, xsql.WithQueryBind(bind.NumericArgs{}))
+			case "named":
+				binders = append(binders, xsql.WithQueryBind(bind.NamedArgs{}))
+			case "slices":
+				binders = append(binders, xsql.WithQueryBind(bind.SliceArgs{}))
 			default:
 				if strings.HasPrefix(transformer, tablePathPrefixTransformer) {
 					prefix, err := extractTablePathPrefixFromBinderName(transformer)
@@ -107,6 +232,95 @@ func parseConnectionString(dataSourceName string) (opts []Option, _ error) {
 		}
 		opts = append(opts, withConnectorOptions(binders...))
 	}
+	driverOpts, err := parseDriverParams(info.Params)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return append(opts, driverOpts...), nil
+}
+
+func checkDsnParams(params url.Values) error {
+	var unknown []string
+	for name := range params {
+		if !isKnownDsnParam(name) {
+			unknown = append(unknown, name)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+
+		return xerrors.WithStackTrace(fmt.Errorf("%w: %s", errUnknownDsnParam, strings.Join(unknown, ", ")))
+	}
+
+	return nil
+}
+
+//nolint:funlen
+func parseDriverParams(params url.Values) (opts []Option, _ error) {
+	if params.Has("dial_timeout") {
+		timeout, err := time.ParseDuration(params.Get("dial_timeout"))
+		if err != nil {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("wrong dial_timeout: %w", err))
+		}
+		opts = append(opts, WithDialTimeout(timeout))
+	}
+	if params.Has("connection_ttl") {
+		ttl, err := time.ParseDuration(params.Get("connection_ttl"))
+		if err != nil {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("wrong connection_ttl: %w", err))
+		}
+		opts = append(opts, WithConnectionTTL(ttl))
+	}
+	if caFile := params.Get("ca_file"); caFile != "" {
+		opts = append(opts, WithCertificatesFromFile(caFile))
+	}
+	if params.Has("insecure_skip_verify") {
+		skipVerify, err := strconv.ParseBool(params.Get("insecure_skip_verify"))
+		if err != nil {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("wrong insecure_skip_verify: %w", err))
+		}
+		if skipVerify {
+			opts = append(opts, WithTLSSInsecureSkipVerify())
+		}
+	}
+	if applicationName := params.Get("application_name"); applicationName != "" {
+		opts = append(opts, WithApplicationName(applicationName))
+	}
+	if params.Has("session_pool_size_limit") {
+		sizeLimit, err := strconv.Atoi(params.Get("session_pool_size_limit"))
+		if err != nil {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("wrong session_pool_size_limit: %w", err))
+		}
+		opts = append(opts, WithSessionPoolSizeLimit(sizeLimit))
+	}
+	if params.Has("session_pool_idle_threshold") {
+		idleThreshold, err := time.ParseDuration(params.Get("session_pool_idle_threshold"))
+		if err != nil {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("wrong session_pool_idle_threshold: %w", err))
+		}
+		opts = append(opts, WithSessionPoolIdleThreshold(idleThreshold))
+	}
+	if params.Has("discovery_interval") {
+		interval, err := time.ParseDuration(params.Get("discovery_interval"))
+		if err != nil {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("wrong discovery_interval: %w", err))
+		}
+		opts = append(opts, WithDiscoveryInterval(interval))
+	}
+	if params.Has("retry_budget") {
+		b, err := parseRetryBudget(params.Get("retry_budget"))
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+		opts = append(opts, WithRetryBudget(b))
+	}
+	if endpoint := params.Get("static_credentials_endpoint"); endpoint != "" {
+		opts = append(opts, WithStaticCredentialsEndpoint(endpoint))
+	}
+	if configFile := params.Get("oauth2_config_file"); configFile != "" {
+		opts = append(opts, WithOauth2TokenExchangeCredentialsFile(configFile))
+	}
 
 	return opts, nil
 }
@@ -124,3 +338,39 @@ func extractTablePathPrefixFromBinderName(binderName string) (string, error) {
 
 	return ss[0][1], nil
 }
+
+var (
+	retryBudgetRe       = regexp.MustCompile(`^(\w+)\((\d+)\)$`)
+	errWrongRetryBudget = errors.New("wrong retry_budget")
+)
+
+func parseRetryBudget(s string) (budget.Budget, error) {
+	ss := retryBudgetRe.FindStringSubmatch(s)
+	if len(ss) != 3 { //nolint:gomnd
+		return nil, xerrors.WithStackTrace(fmt.Errorf("%w: %s", errWrongRetryBudget, s))
+	}
+	value, err := strconv.Atoi(ss[2])
+	if err != nil {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("%w: %s", errWrongRetryBudget, s))
+	}
+	switch ss[1] {
+	case retryBudgetPercent:
+		if value > 100 { //nolint:gomnd
+			return nil, xerrors.WithStackTrace(fmt.Errorf("%w: %s", errWrongRetryBudget, s))
+		}
+
+		return budget.Percent(value), nil
+	case retryBudgetLimited:
+		return budget.Limited(value), nil
+	default:
+		return nil, xerrors.WithStackTrace(fmt.Errorf("%w: %s", errWrongRetryBudget, s))
+	}
+}
+
+func withDsnParams(params url.Values) Option {
+	return func(ctx context.Context, d *Driver) error {
+		d.dsnParams = params
+
+		return nil
+	}
+}
@@ -3,6 +3,7 @@ package ydb
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -163,6 +164,21 @@ func TestParse(t *testing.T) {
 			},
 			err: nil,
 		},
+		{
+			dsn: "grpc://localhost:2135/local?query_mode=scripting&go_query_bind=named,slices,declare",
+			opts: []config.Option{
+				config.WithSecure(false),
+				config.WithEndpoint("localhost:2135"),
+				config.WithDatabase("/local"),
+			},
+			connectorOpts: []xsql.ConnectorOption{
+				xsql.WithDefaultQueryMode(xsql.ScriptingQueryMode),
+				xsql.WithQueryBind(bind.NamedArgs{}),
+				xsql.WithQueryBind(bind.SliceArgs{}),
+				xsql.WithQueryBind(bind.AutoDeclare{}),
+			},
+			err: nil,
+		},
 	} {
 		t.Run("", func(t *testing.T) {
 			opts, err := parseConnectionString(tt.dsn)
@@ -179,6 +195,71 @@ func TestParse(t *testing.T) {
 	}
 }
 
+func TestParseDriverParams(t *testing.T) {
+	opts, err := parseConnectionString("grpcs://localhost:2135/local?" +
+		"dial_timeout=3s&connection_ttl=1m&insecure_skip_verify=true&application_name=app&" +
+		"session_pool_size_limit=10&session_pool_idle_threshold=5m&discovery_interval=30s&" +
+		"retry_budget=percent(10)&static_credentials_endpoint=auth:2135",
+	)
+	require.NoError(t, err)
+	d, err := newConnectionFromOptions(context.Background(), opts...)
+	require.NoError(t, err)
+	require.Equal(t, 3*time.Second, d.config.DialTimeout())
+	require.Equal(t, time.Minute, d.config.ConnectionTTL())
+	require.True(t, d.config.TLSConfig().InsecureSkipVerify)
+	require.Equal(t, "auth:2135", d.staticCredentialsEndpoint)
+	require.Len(t, d.tableOptions, 2)
+	require.Len(t, d.queryOptions, 1)
+	require.Len(t, d.discoveryOptions, 1)
+}
+
+func TestParseWrongParams(t *testing.T) {
+	for _, tt := range []struct {
+		dsn string
+		err error
+	}{
+		{
+			dsn: "grpc://localhost:2135/local?unknown=1",
+			err: errUnknownDsnParam,
+		},
+		{
+			dsn: "grpc://localhost:2135/local?retry_budget=percent(101)",
+			err: errWrongRetryBudget,
+		},
+		{
+			dsn: "grpc://localhost:2135/local?retry_budget=10",
+			err: errWrongRetryBudget,
+		},
+		{
+			dsn: "grpc://localhost:2135/local?retry_budget=unlimited(10)",
+			err: errWrongRetryBudget,
+		},
+	} {
+		t.Run("", func(t *testing.T) {
+			_, err := parseConnectionString(tt.dsn)
+			require.ErrorIs(t, err, tt.err)
+		})
+	}
+	t.Run("WrongValue", func(t *testing.T) {
+		_, err := parseConnectionString("grpc://localhost:2135/local?dial_timeout=abc")
+		require.Error(t, err)
+	})
+	t.Run("CustomParser", func(t *testing.T) {
+		id := RegisterDsnParser(func(dsn string) ([]Option, error) {
+			return nil, nil
+		}, "custom")
+		defer UnregisterDsnParser(id)
+		_, err := parseConnectionString("grpc://localhost:2135/local?custom=1")
+		require.NoError(t, err)
+		_, err = parseConnectionString("grpc://localhost:2135/local?custom=1&dial_timeuot=5s")
+		require.ErrorIs(t, err, errUnknownDsnParam)
+
+		UnregisterDsnParser(id)
+		_, err = parseConnectionString("grpc://localhost:2135/local?custom=1")
+		require.ErrorIs(t, err, errUnknownDsnParam)
+	})
+}
+
 func TestExtractTablePathPrefixFromBinderName(t *testing.T) {
 	for _, tt := range []struct {
 		binderName      string
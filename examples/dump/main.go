@@ -0,0 +1,106 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	environ "github.com/ydb-platform/ydb-go-sdk-auth-environ"
+	ydb "github.com/ydb-platform/ydb-go-sdk/v3"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/dump"
+)
+
+var (
+	dsn         string
+	mode        string
+	prefix      string
+	dir         string
+	format      string
+	concurrency int
+)
+
+func init() { //nolint:gochecknoinits
+	required := []string{"ydb", "mode", "dir"}
+	flagSet := flag.NewFlagSet(os.Args[0], flag.ExitOnError)
+	flagSet.Usage = func() {
+		out := flagSet.Output()
+		_, _ = fmt.Fprintf(out, "Usage:\n%s [options]\n", os.Args[0])
+		_, _ = fmt.Fprintf(out, "\nOptions:\n")
+		flagSet.PrintDefaults()
+	}
+	flagSet.StringVar(&dsn,
+		"ydb", "",
+		"YDB connection string",
+	)
+	flagSet.StringVar(&mode,
+		"mode", "",
+		"dump or restore",
+	)
+	flagSet.StringVar(&prefix,
+		"path", "",
+		"database path (relative to database root) for dump from or restore to",
+	)
+	flagSet.StringVar(&dir,
+		"dir", "",
+		"local directory for dump to or restore from",
+	)
+	flagSet.StringVar(&format,
+		"format", string(dump.FormatJSONL),
+		"format of data files: jsonl or csv",
+	)
+	flagSet.IntVar(&concurrency,
+		"concurrency", 4,
+		"count of concurrently read key ranges or uploaded chunks",
+	)
+	if err := flagSet.Parse(os.Args[1:]); err != nil {
+		flagSet.Usage()
+		os.Exit(1)
+	}
+	flagSet.Visit(func(f *flag.Flag) {
+		for i, arg := range required {
+			if arg == f.Name {
+				required = append(required[:i], required[i+1:]...)
+			}
+		}
+	})
+	if len(required) > 0 {
+		fmt.Printf("\nSome required options not defined: %v\n\n", required)
+		flagSet.Usage()
+		os.Exit(1)
+	}
+}
+
+func main() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	db, err := ydb.Open(ctx, dsn,
+		environ.WithEnvironCredentials(),
+	)
+	if err != nil {
+		panic(fmt.Errorf("connect error: %w", err))
+	}
+	defer func() { _ = db.Close(ctx) }()
+
+	opts := []dump.Option{
+		dump.WithFormat(dump.Format(format)),
+		dump.WithConcurrency(concurrency),
+		dump.WithOnTable(func(path string) {
+			log.Printf("%s table %q...", mode, path)
+		}),
+	}
+
+	switch mode {
+	case "dump":
+		err = dump.Dump(ctx, db, prefix, dir, opts...)
+	case "restore":
+		err = dump.Restore(ctx, db, dir, prefix, opts...)
+	default:
+		err = fmt.Errorf("unknown mode %q", mode)
+	}
+	if err != nil {
+		panic(fmt.Errorf("%s error: %w", mode, err))
+	}
+	log.Printf("%s done", mode)
+}
@@ -204,4 +204,14 @@ func main() {
 	if err != nil {
 		panic(fmt.Errorf("read table error: %w", err))
 	}
+
+	log.Println("Read whole table by partitions in parallel, sorted by composite primary key:")
+	err = parallelReadTable(
+		ctx,
+		db.Table(),
+		path.Join(prefix, tableName),
+	)
+	if err != nil {
+		panic(fmt.Errorf("parallel read table error: %w", err))
+	}
 }
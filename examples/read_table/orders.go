@@ -8,6 +8,7 @@ import (
 	"time"
 
 	ydb "github.com/ydb-platform/ydb-go-sdk/v3"
+	"github.com/ydb-platform/ydb-go-sdk/v3/sugar"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/result/named"
@@ -183,3 +184,22 @@ func readTable(ctx context.Context, c table.Client, path string, opts ...options
 
 	return err
 }
+
+func parallelReadTable(ctx context.Context, c table.Client, path string) error {
+	return sugar.ParallelReadTable(ctx, c, path, 4,
+		func(ctx context.Context, tr sugar.TableRow) error {
+			var r row
+			if err := tr.Scan(&r.id, &r.orderID, &r.date); err != nil {
+				return err
+			}
+			log.Printf("#  Order, CustomerId: %d, OrderId: %d, Order date: %s, Partition: %d",
+				r.id, r.orderID, r.date.Format("2006-01-02"), tr.KeyRange(),
+			)
+
+			return nil
+		},
+		sugar.WithParallelReadColumns("customer_id", "order_id", "order_date"),
+		sugar.WithParallelReadOrdered(),
+		sugar.WithParallelReadKeyRangeSnapshot(),
+	)
+}
@@ -0,0 +1,209 @@
+// Package faults provides rules of faults injection into gRPC calls of driver for resilience
+// testing of applications: retries, session pool behaviour, reconnects of topic streams, etc.
+//
+// Rules are attached to driver with ydb.WithFaultInjection and can be enabled or disabled at runtime.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+package faults
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	grpcCodes "google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/conn"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xrand"
+)
+
+var _ conn.FaultRule = (*Rule)(nil)
+
+type (
+	// Rule injects fault into calls with matched method name
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	Rule struct {
+		method      string
+		fault       conn.Fault
+		streamsOnly bool
+		probability float64
+		limit       uint64
+		seed        int64
+		rand        xrand.Rand
+
+		enabled  atomic.Bool
+		mu       sync.Mutex
+		injected uint64
+	}
+	Option func(r *Rule)
+)
+
+// WithStatus injects YDB operation error with given status code (BAD_SESSION, OVERLOADED, UNAVAILABLE, etc.)
+func WithStatus(code Ydb.StatusIds_StatusCode) Option {
+	return func(r *Rule) {
+		r.fault.Err = xerrors.Operation(
+			xerrors.WithStatusCode(code),
+		)
+	}
+}
+
+// WithTransportError injects transport (gRPC) error with given code
+func WithTransportError(code grpcCodes.Code) Option {
+	return func(r *Rule) {
+		r.fault.Err = xerrors.Transport(
+			grpcStatus.Error(code, "injected fault"),
+		)
+	}
+}
+
+// WithLatency injects delay before call
+func WithLatency(latency time.Duration) Option {
+	return func(r *Rule) {
+		r.fault.Delay = latency
+	}
+}
+
+// WithStreamBreak breaks matched streams with transport error UNAVAILABLE after afterMessages received messages
+func WithStreamBreak(afterMessages int) Option {
+	return func(r *Rule) {
+		r.streamsOnly = true
+		r.fault.StreamErr = xerrors.Transport(
+			grpcStatus.Error(grpcCodes.Unavailable, "injected stream break"),
+		)
+		r.fault.StreamAfter = afterMessages
+	}
+}
+
+// WithStreamDisconnect closes matched streams (such as topic read and write streams) from server side
+// after afterMessages received messages
+func WithStreamDisconnect(afterMessages int) Option {
+	return func(r *Rule) {
+		r.streamsOnly = true
+		r.fault.StreamErr = io.EOF
+		r.fault.StreamAfter = afterMessages
+	}
+}
+
+// WithProbability sets probability of fault injection into matched call. Default probability is 1
+func WithProbability(probability float64) Option {
+	return func(r *Rule) {
+		r.probability = probability
+	}
+}
+
+// WithLimit limits count of injected faults. Zero limit means unlimited injections
+func WithLimit(limit uint64) Option {
+	return func(r *Rule) {
+		r.limit = limit
+	}
+}
+
+// WithSeed sets seed of random generator for deterministic probabilistic injections
+func WithSeed(seed int64) Option {
+	return func(r *Rule) {
+		r.seed = seed
+	}
+}
+
+// WithDisabled makes rule which disabled until call of Rule.Enable
+func WithDisabled() Option {
+	return func(r *Rule) {
+		r.enabled.Store(false)
+	}
+}
+
+// NewRule makes rule of faults injection into calls with method matched to pattern
+//
+// Pattern is a full gRPC method name (such as "/Ydb.Table.V1.TableService/ExecuteDataQuery") or
+// path.Match pattern (such as "/Ydb.Topic.V1.TopicService/*"). Empty pattern matches all methods
+func NewRule(method string, opts ...Option) *Rule {
+	r := &Rule{
+		method:      method,
+		probability: 1,
+		seed:        time.Now().UnixNano(),
+	}
+	r.enabled.Store(true)
+	for _, opt := range opts {
+		if opt != nil {
+			opt(r)
+		}
+	}
+	r.rand = xrand.New(xrand.WithLock(), xrand.WithSeed(r.seed))
+
+	return r
+}
+
+// Enable turns on injection of faults
+func (r *Rule) Enable() {
+	r.enabled.Store(true)
+}
+
+// Disable turns off injection of faults
+func (r *Rule) Disable() {
+	r.enabled.Store(false)
+}
+
+// Enabled reports whether rule injects faults
+func (r *Rule) Enabled() bool {
+	return r.enabled.Load()
+}
+
+// Injected returns count of injected faults
+func (r *Rule) Injected() uint64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.injected
+}
+
+// Reset resets count of injected faults for continue injections after reaching the limit
+func (r *Rule) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.injected = 0
+}
+
+func (r *Rule) String() string {
+	return fmt.Sprintf("faults.Rule{method:%q,enabled:%t,injected:%d}", r.method, r.Enabled(), r.Injected())
+}
+
+func (r *Rule) match(method string) bool {
+	if r.method == "" || r.method == method {
+		return true
+	}
+	matched, err := path.Match(r.method, method)
+
+	return err == nil && matched
+}
+
+// Fault implements internal interface of driver middleware
+func (r *Rule) Fault(ctx context.Context, method string, stream bool) (_ conn.Fault, ok bool) {
+	if !r.Enabled() || (r.streamsOnly && !stream) || !r.match(method) {
+		return conn.Fault{}, false
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.limit > 0 && r.injected >= r.limit {
+		return conn.Fault{}, false
+	}
+
+	const precision = 1_000_000
+	if r.probability < 1 && float64(r.rand.Int64(precision)) >= r.probability*precision {
+		return conn.Fault{}, false
+	}
+
+	r.injected++
+
+	return r.fault, true
+}
@@ -0,0 +1,156 @@
+package faults
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Topic"
+	"google.golang.org/grpc"
+	grpcCodes "google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/conn"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/testutil"
+)
+
+const (
+	keepAlive  = "/Ydb.Table.V1.TableService/KeepAlive"
+	streamRead = "/Ydb.Topic.V1.TopicService/StreamRead"
+)
+
+func newConn(rules ...conn.FaultRule) grpc.ClientConnInterface {
+	return conn.WithFaults(testutil.NewBalancer(
+		testutil.WithInvokeHandlers(testutil.InvokeHandlers{
+			testutil.TableKeepAlive: func(request interface{}) (proto.Message, error) {
+				return &Ydb_Table.KeepAliveResult{}, nil
+			},
+		}),
+		testutil.WithNewStreamHandlers(testutil.NewStreamHandlers{
+			testutil.UnknownMethod: func(desc *grpc.StreamDesc) (grpc.ClientStream, error) {
+				return &testutil.ClientStream{
+					OnRecvMsg: func(m interface{}) error {
+						return nil
+					},
+				}, nil
+			},
+		}),
+	), rules...)
+}
+
+func keepAliveCall(ctx context.Context, cc grpc.ClientConnInterface) error {
+	return cc.Invoke(ctx, keepAlive, &Ydb_Table.KeepAliveRequest{}, &Ydb_Table.KeepAliveResponse{})
+}
+
+func TestRuleStatus(t *testing.T) {
+	ctx := context.Background()
+	rule := NewRule(keepAlive, WithStatus(Ydb.StatusIds_BAD_SESSION), WithLimit(2))
+	cc := newConn(rule)
+
+	for i := 0; i < 2; i++ {
+		err := keepAliveCall(ctx, cc)
+		require.True(t, xerrors.IsOperationError(err, Ydb.StatusIds_BAD_SESSION))
+	}
+	require.NoError(t, keepAliveCall(ctx, cc))
+	require.EqualValues(t, 2, rule.Injected())
+
+	rule.Reset()
+	require.Error(t, keepAliveCall(ctx, cc))
+}
+
+func TestRuleToggle(t *testing.T) {
+	ctx := context.Background()
+	rule := NewRule("/Ydb.Table.V1.TableService/*",
+		WithTransportError(grpcCodes.Unavailable),
+		WithDisabled(),
+	)
+	cc := newConn(rule)
+
+	require.False(t, rule.Enabled())
+	require.NoError(t, keepAliveCall(ctx, cc))
+
+	rule.Enable()
+	err := keepAliveCall(ctx, cc)
+	require.True(t, xerrors.IsTransportError(err, grpcCodes.Unavailable))
+
+	rule.Disable()
+	require.NoError(t, keepAliveCall(ctx, cc))
+	require.EqualValues(t, 1, rule.Injected())
+}
+
+func TestRuleProbability(t *testing.T) {
+	injections := func() (injected []bool) {
+		rule := NewRule("", WithStatus(Ydb.StatusIds_OVERLOADED), WithProbability(0.3), WithSeed(42))
+		cc := newConn(rule)
+		for i := 0; i < 100; i++ {
+			injected = append(injected, keepAliveCall(context.Background(), cc) != nil)
+		}
+
+		return injected
+	}
+	first, second := injections(), injections()
+	require.Equal(t, first, second)
+
+	count := 0
+	for _, injected := range first {
+		if injected {
+			count++
+		}
+	}
+	require.Greater(t, count, 10)
+	require.Less(t, count, 50)
+}
+
+func TestRuleLatency(t *testing.T) {
+	rule := NewRule(keepAlive, WithLatency(time.Hour))
+	cc := newConn(rule)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	err := keepAliveCall(ctx, cc)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestRuleStreams(t *testing.T) {
+	for _, tt := range []struct {
+		name  string
+		fault Option
+		check func(t *testing.T, err error)
+	}{
+		{
+			name:  "Break",
+			fault: WithStreamBreak(2),
+			check: func(t *testing.T, err error) {
+				require.True(t, xerrors.IsTransportError(err, grpcCodes.Unavailable))
+			},
+		},
+		{
+			name:  "Disconnect",
+			fault: WithStreamDisconnect(2),
+			check: func(t *testing.T, err error) {
+				require.Equal(t, io.EOF, err)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			rule := NewRule(streamRead, tt.fault)
+			cc := newConn(rule)
+
+			require.NoError(t, keepAliveCall(context.Background(), cc))
+
+			s, err := cc.NewStream(context.Background(), &grpc.StreamDesc{}, streamRead)
+			require.NoError(t, err)
+			for i := 0; i < 2; i++ {
+				require.NoError(t, s.RecvMsg(&Ydb_Topic.StreamReadMessage_FromServer{}))
+			}
+			tt.check(t, s.RecvMsg(&Ydb_Topic.StreamReadMessage_FromServer{}))
+			require.EqualValues(t, 1, rule.Injected())
+		})
+	}
+}
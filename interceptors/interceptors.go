@@ -0,0 +1,170 @@
+// Package interceptors provides hooks which called before execution of queries in query and
+// table clients and in database/sql connector. Interceptor can see and rewrite query text and
+// parameters, deny call and observe rows of result.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+package interceptors
+
+import (
+	"context"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+type (
+	// Client is a name of client which executes query
+	Client string
+
+	// Isolation is a mode of transaction which begins with query
+	Isolation string
+
+	// TxControl describes transaction of query
+	TxControl struct {
+		// TxID is an identifier of existing transaction. TxID is empty if query begins new
+		// transaction or executes without transaction
+		TxID string
+		// Isolation is a mode of new transaction. Isolation is empty if query executes in existing
+		// transaction or without transaction
+		Isolation Isolation
+		// CommitTx reports whether transaction commits after query
+		CommitTx bool
+	}
+
+	// Call describes query before execution
+	//
+	// Interceptor can rewrite Query and Params of call
+	Call struct {
+		Client    Client
+		Query     string
+		Params    *params.Parameters
+		TxControl TxControl
+	}
+
+	// Row is an observed row of query result
+	Row struct {
+		Columns []string
+		Values  []types.Value
+	}
+
+	// Observer observes execution of intercepted call
+	Observer struct {
+		// OnRow called on each row which read from result
+		OnRow func(row Row)
+		// OnDone called once on finish of execution: on error of call or after reading of all
+		// results or on close of result
+		OnDone func(err error)
+	}
+
+	// Interceptor called before execution of query
+	//
+	// Interceptor can modify call or deny execution with returned error. Returned observer
+	// (may be nil) notified about rows and finish of execution
+	//
+	// Interceptor called on each attempt of execution: if query retried (for example, by helpers
+	// of query client or by Do and DoTx of clients), interceptor called again with original query
+	// and parameters, and observer of failed attempt notified about finish with error of attempt.
+	// Intercepted queries of query client bypass result cache
+	Interceptor func(ctx context.Context, call *Call) (*Observer, error)
+)
+
+const (
+	QueryClient Client = "query"
+	TableClient Client = "table"
+	DatabaseSQL Client = "database/sql"
+)
+
+const (
+	SerializableReadWrite Isolation = "SerializableReadWrite"
+	OnlineReadOnly        Isolation = "OnlineReadOnly"
+	StaleReadOnly         Isolation = "StaleReadOnly"
+	SnapshotReadOnly      Isolation = "SnapshotReadOnly"
+)
+
+// Merge makes single interceptor which calls interceptors in order of arguments
+//
+// If some interceptor denies call, observers of previous interceptors notified about finish
+// of execution with error of denial
+func Merge(interceptors ...Interceptor) Interceptor {
+	var merged []Interceptor
+	for _, interceptor := range interceptors {
+		if interceptor != nil {
+			merged = append(merged, interceptor)
+		}
+	}
+
+	switch len(merged) {
+	case 0:
+		return nil
+	case 1:
+		return merged[0]
+	}
+
+	return func(ctx context.Context, call *Call) (*Observer, error) {
+		observers := make([]*Observer, 0, len(merged))
+		for _, interceptor := range merged {
+			observer, err := interceptor(ctx, call)
+			if err != nil {
+				for _, o := range observers {
+					o.Done(err)
+				}
+
+				return nil, err
+			}
+			if observer != nil {
+				observers = append(observers, observer)
+			}
+		}
+
+		return mergeObservers(observers), nil
+	}
+}
+
+func mergeObservers(observers []*Observer) *Observer {
+	switch len(observers) {
+	case 0:
+		return nil
+	case 1:
+		return observers[0]
+	}
+
+	merged := &Observer{
+		OnDone: func(err error) {
+			for _, o := range observers {
+				o.Done(err)
+			}
+		},
+	}
+	for _, o := range observers {
+		if o.OnRow != nil {
+			merged.OnRow = func(row Row) {
+				for _, o := range observers {
+					o.Row(row)
+				}
+			}
+
+			break
+		}
+	}
+
+	return merged
+}
+
+// Row notifies observer about row. Row is safe for nil observer
+func (o *Observer) Row(row Row) {
+	if o != nil && o.OnRow != nil {
+		o.OnRow(row)
+	}
+}
+
+// Done notifies observer about finish of execution. Done is safe for nil observer
+func (o *Observer) Done(err error) {
+	if o != nil && o.OnDone != nil {
+		o.OnDone(err)
+	}
+}
+
+// ObserveRows reports whether observer needs rows
+func (o *Observer) ObserveRows() bool {
+	return o != nil && o.OnRow != nil
+}
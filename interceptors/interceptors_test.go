@@ -0,0 +1,93 @@
+package interceptors
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMerge(t *testing.T) {
+	t.Run("Nil", func(t *testing.T) {
+		require.Nil(t, Merge())
+		require.Nil(t, Merge(nil, nil))
+	})
+	t.Run("Order", func(t *testing.T) {
+		var (
+			rows int
+			done []string
+		)
+		interceptor := Merge(
+			func(ctx context.Context, call *Call) (*Observer, error) {
+				call.Query = "/* first */ " + call.Query
+
+				return &Observer{
+					OnRow: func(row Row) {
+						rows++
+					},
+					OnDone: func(err error) {
+						done = append(done, "first")
+					},
+				}, nil
+			},
+			nil,
+			func(ctx context.Context, call *Call) (*Observer, error) {
+				call.Query = "/* second */ " + call.Query
+
+				return &Observer{
+					OnDone: func(err error) {
+						done = append(done, "second")
+					},
+				}, nil
+			},
+		)
+		call := &Call{Client: QueryClient, Query: "SELECT 1"}
+		observer, err := interceptor(context.Background(), call)
+		require.NoError(t, err)
+		require.Equal(t, "/* second */ /* first */ SELECT 1", call.Query)
+		require.True(t, observer.ObserveRows())
+		observer.Row(Row{})
+		observer.Done(nil)
+		require.Equal(t, 1, rows)
+		require.Equal(t, []string{"first", "second"}, done)
+	})
+	t.Run("Deny", func(t *testing.T) {
+		var (
+			errDenied = errors.New("denied")
+			done      []error
+			called    bool
+		)
+		interceptor := Merge(
+			func(ctx context.Context, call *Call) (*Observer, error) {
+				return &Observer{
+					OnDone: func(err error) {
+						done = append(done, err)
+					},
+				}, nil
+			},
+			func(ctx context.Context, call *Call) (*Observer, error) {
+				return nil, errDenied
+			},
+			func(ctx context.Context, call *Call) (*Observer, error) {
+				called = true
+
+				return nil, nil //nolint:nilnil
+			},
+		)
+		observer, err := interceptor(context.Background(), &Call{Query: "SELECT 1"})
+		require.ErrorIs(t, err, errDenied)
+		require.Nil(t, observer)
+		require.False(t, called)
+		require.Equal(t, []error{errDenied}, done)
+	})
+}
+
+func TestNilObserver(t *testing.T) {
+	var observer *Observer
+	require.False(t, observer.ObserveRows())
+	require.NotPanics(t, func() {
+		observer.Row(Row{})
+		observer.Done(nil)
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"strings"
 	"sync/atomic"
 
+	"github.com/jonboulle/clockwork"
 	"google.golang.org/grpc"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/config"
@@ -41,6 +42,8 @@ type Balancer struct {
 	discoveryClient   discoveryClient
 	discoveryRepeater repeater.Repeater
 	localDCDetector   func(ctx context.Context, endpoints []endpoint.Endpoint) (string, error)
+	circuitBreakers   *circuitBreakers
+	limiters          *concurrencyLimiters
 
 	connectionsState atomic.Pointer[connectionsState]
 
@@ -79,6 +82,7 @@ func (b *Balancer) clusterDiscovery(ctx context.Context) (err error) {
 		retry.WithIdempotent(true),
 		retry.WithTrace(b.driverConfig.TraceRetry()),
 		retry.WithBudget(b.driverConfig.RetryBudget()),
+		retry.WithPolicy(b.driverConfig.RetryPolicy()),
 	)
 }
 
@@ -153,6 +157,9 @@ func (b *Balancer) applyDiscoveredEndpoints(ctx context.Context, newest []endpoi
 
 	info := balancerConfig.Info{SelfLocation: localDC}
 	state := newConnectionsState(connections, b.config.Filter, info, b.config.AllowFallback)
+	if b.circuitBreakers != nil {
+		state.pessimized = b.circuitBreakers.IsOpen
+	}
 
 	endpointsInfo := make([]endpoint.Info, len(newest))
 	for i, e := range newest {
@@ -221,6 +228,14 @@ func New(
 		localDCDetector: detectLocalDC,
 	}
 
+	if config := driverConfig.CircuitBreaker(); config != nil {
+		b.circuitBreakers = newCircuitBreakers(config, clockwork.NewRealClock(), driverConfig.Trace())
+	}
+
+	if config := driverConfig.ConcurrencyLimiter(); config != nil {
+		b.limiters = newConcurrencyLimiters(config, clockwork.NewRealClock(), driverConfig.Trace())
+	}
+
 	if config := driverConfig.Balancer(); config == nil {
 		b.config = balancerConfig.Config{}
 	} else {
@@ -255,7 +270,17 @@ func (b *Balancer) Invoke(
 	args interface{},
 	reply interface{},
 	opts ...grpc.CallOption,
-) error {
+) (err error) {
+	if b.limiters != nil {
+		release, acquireErr := b.limiters.Acquire(ctx, method, false)
+		if acquireErr != nil {
+			return xerrors.WithStackTrace(acquireErr)
+		}
+		defer func() {
+			release(err)
+		}()
+	}
+
 	return b.wrapCall(ctx, func(ctx context.Context, cc conn.Conn) error {
 		return cc.Invoke(ctx, method, args, reply, opts...)
 	})
@@ -267,6 +292,20 @@ func (b *Balancer) NewStream(
 	method string,
 	opts ...grpc.CallOption,
 ) (_ grpc.ClientStream, err error) {
+	if b.limiters != nil {
+		release, acquireErr := b.limiters.Acquire(ctx, method, true)
+		if acquireErr != nil {
+			return nil, xerrors.WithStackTrace(acquireErr)
+		}
+		defer func() {
+			if err != nil {
+				release(err)
+			}
+		}()
+		// slot of stream releases on finish of stream
+		opts = append(opts, grpc.OnFinish(release))
+	}
+
 	var client grpc.ClientStream
 	err = b.wrapCall(ctx, func(ctx context.Context, cc conn.Conn) error {
 		client, err = cc.NewStream(ctx, desc, method, opts...)
@@ -286,6 +325,16 @@ func (b *Balancer) wrapCall(ctx context.Context, f func(ctx context.Context, cc
 		return xerrors.WithStackTrace(err)
 	}
 
+	if b.circuitBreakers != nil {
+		onDone, allowErr := b.circuitBreakers.Allow(ctx, cc.Endpoint())
+		if allowErr != nil {
+			return xerrors.WithStackTrace(allowErr)
+		}
+		defer func() {
+			onDone(err)
+		}()
+	}
+
 	defer func() {
 		if err == nil {
 			if cc.GetState() == conn.Banned {
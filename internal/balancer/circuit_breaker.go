@@ -0,0 +1,222 @@
+package balancer
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	grpcCodes "google.golang.org/grpc/codes"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/endpoint"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry/breaker"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+type (
+	circuitBreakerKey struct {
+		address string
+		label   string
+	}
+	// circuitBreakers keeps circuit breakers by endpoint address and (optionally) retry label
+	circuitBreakers struct {
+		config *breaker.Config
+		clock  clockwork.Clock
+		trace  *trace.Driver
+
+		mu       sync.Mutex
+		breakers map[circuitBreakerKey]*circuitBreaker
+	}
+	circuitBreaker struct {
+		mu          sync.Mutex
+		state       breaker.State
+		windowStart time.Time
+		requests    int
+		failures    int
+		slow        int
+		openedAt    time.Time
+		probes      int
+		successes   int
+	}
+)
+
+func newCircuitBreakers(config *breaker.Config, clock clockwork.Clock, t *trace.Driver) *circuitBreakers {
+	return &circuitBreakers{
+		config:   config,
+		clock:    clock,
+		trace:    t,
+		breakers: make(map[circuitBreakerKey]*circuitBreaker),
+	}
+}
+
+func (cbs *circuitBreakers) get(key circuitBreakerKey) *circuitBreaker {
+	cbs.mu.Lock()
+	defer cbs.mu.Unlock()
+
+	cb, has := cbs.breakers[key]
+	if !has {
+		cb = &circuitBreaker{windowStart: cbs.clock.Now()}
+		cbs.breakers[key] = cb
+	}
+
+	return cb
+}
+
+func (cbs *circuitBreakers) key(ctx context.Context, e endpoint.Endpoint) circuitBreakerKey {
+	key := circuitBreakerKey{address: e.Address()}
+	if cbs.config.ByLabel {
+		key.label = xcontext.RetryLabel(ctx)
+	}
+
+	return key
+}
+
+// IsOpen reports whether circuit breaker of endpoint rejects calls now.
+// IsOpen doesn't change state of circuit breaker
+func (cbs *circuitBreakers) IsOpen(ctx context.Context, e endpoint.Endpoint) bool {
+	key := cbs.key(ctx, e)
+
+	cbs.mu.Lock()
+	cb, has := cbs.breakers[key]
+	cbs.mu.Unlock()
+	if !has {
+		return false
+	}
+
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	switch cb.state {
+	case breaker.Open:
+		return cbs.config.OpenTimeout-cbs.clock.Since(cb.openedAt) > 0
+	case breaker.HalfOpen:
+		return cb.probes >= cbs.config.HalfOpenMaxCalls
+	default:
+		return false
+	}
+}
+
+// Allow checks circuit breaker of endpoint and returns callback for reporting result of call.
+// Returns *breaker.OpenError if circuit breaker is open
+func (cbs *circuitBreakers) Allow(ctx context.Context, e endpoint.Endpoint) (onDone func(err error), _ error) {
+	key := cbs.key(ctx, e)
+	cb := cbs.get(key)
+
+	cb.mu.Lock()
+	from, now := cb.state, cbs.clock.Now()
+	if cb.state == breaker.Open {
+		if retryAfter := cbs.config.OpenTimeout - now.Sub(cb.openedAt); retryAfter > 0 {
+			cb.mu.Unlock()
+
+			return nil, xerrors.WithStackTrace(xerrors.Retryable(&breaker.OpenError{
+				Address:    key.address,
+				Label:      key.label,
+				RetryAfter: retryAfter,
+			}, xerrors.WithName("CircuitBreakerOpen")))
+		}
+		cb.state, cb.probes, cb.successes = breaker.HalfOpen, 0, 0
+	}
+	if cb.state == breaker.HalfOpen {
+		if cb.probes >= cbs.config.HalfOpenMaxCalls {
+			cb.mu.Unlock()
+			cbs.stateChanged(ctx, e, key.label, from, breaker.HalfOpen)
+
+			return nil, xerrors.WithStackTrace(xerrors.Retryable(&breaker.OpenError{
+				Address: key.address,
+				Label:   key.label,
+			}, xerrors.WithName("CircuitBreakerOpen")))
+		}
+		cb.probes++
+	}
+	to := cb.state
+	cb.mu.Unlock()
+
+	cbs.stateChanged(ctx, e, key.label, from, to)
+
+	return func(err error) {
+		cb.mu.Lock()
+		from := cb.state
+		cb.done(cbs.config, cbs.clock.Now(), isCircuitBreakerFailure(err), cbs.clock.Since(now))
+		to := cb.state
+		cb.mu.Unlock()
+
+		cbs.stateChanged(ctx, e, key.label, from, to)
+	}, nil
+}
+
+func (cbs *circuitBreakers) stateChanged(
+	ctx context.Context, e endpoint.Endpoint, label string, from, to breaker.State,
+) {
+	if from == to {
+		return
+	}
+	trace.DriverOnBalancerCircuitBreakerStateChange(cbs.trace, &ctx,
+		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/v3/internal/balancer.(*circuitBreakers).Allow"),
+		e, label, from.String(), to.String(),
+	)()
+}
+
+// done accounts result of call. Must be called under lock
+func (cb *circuitBreaker) done(config *breaker.Config, now time.Time, failed bool, latency time.Duration) {
+	slow := config.SlowCallDuration > 0 && latency >= config.SlowCallDuration
+
+	switch cb.state {
+	case breaker.HalfOpen:
+		if failed || slow {
+			cb.open(now)
+
+			return
+		}
+		cb.successes++
+		if cb.successes >= config.HalfOpenMaxCalls {
+			cb.close(now)
+		}
+	case breaker.Closed:
+		if now.Sub(cb.windowStart) >= config.Window {
+			cb.close(now)
+		}
+		cb.requests++
+		if failed {
+			cb.failures++
+		}
+		if slow {
+			cb.slow++
+		}
+		if cb.requests < config.MinRequests {
+			return
+		}
+		if float64(cb.failures) >= config.ErrorRateThreshold*float64(cb.requests) {
+			cb.open(now)
+
+			return
+		}
+		if config.SlowCallDuration > 0 && float64(cb.slow) >= config.SlowCallRateThreshold*float64(cb.requests) {
+			cb.open(now)
+		}
+	}
+}
+
+func (cb *circuitBreaker) open(now time.Time) {
+	cb.state, cb.openedAt = breaker.Open, now
+}
+
+func (cb *circuitBreaker) close(now time.Time) {
+	cb.state, cb.windowStart = breaker.Closed, now
+	cb.requests, cb.failures, cb.slow = 0, 0, 0
+}
+
+// isCircuitBreakerFailure reports whether err is a sign of degradation of endpoint
+func isCircuitBreakerFailure(err error) bool {
+	if err == nil {
+		return false
+	}
+	if xerrors.IsOperationError(err, Ydb.StatusIds_OVERLOADED, Ydb.StatusIds_UNAVAILABLE) {
+		return true
+	}
+
+	return xerrors.IsTransportError(err) && !xerrors.IsTransportError(err, grpcCodes.OK, grpcCodes.Canceled)
+}
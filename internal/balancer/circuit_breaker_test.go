@@ -0,0 +1,167 @@
+package balancer
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	grpcCodes "google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+
+	balancerConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/balancer/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/conn"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/endpoint"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/mock"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry/breaker"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+func TestCircuitBreakers(t *testing.T) {
+	var (
+		ctx         = context.Background()
+		clock       = clockwork.NewFakeClock()
+		transitions []string
+		e           = endpoint.New("a:2135")
+		unavailable = xerrors.Transport(grpcStatus.Error(grpcCodes.Unavailable, ""))
+	)
+	cbs := newCircuitBreakers(breaker.New(
+		breaker.WithMinRequests(4),
+		breaker.WithErrorRateThreshold(0.5),
+		breaker.WithOpenTimeout(time.Minute),
+		breaker.WithHalfOpenMaxCalls(2),
+	), clock, &trace.Driver{
+		OnBalancerCircuitBreakerStateChange: func(
+			info trace.DriverBalancerCircuitBreakerStateChangeStartInfo,
+		) func(trace.DriverBalancerCircuitBreakerStateChangeDoneInfo) {
+			transitions = append(transitions, info.From+"->"+info.To)
+
+			return nil
+		},
+	})
+	call := func(err error) error {
+		onDone, allowErr := cbs.Allow(ctx, e)
+		if allowErr != nil {
+			return allowErr
+		}
+		onDone(err)
+
+		return nil
+	}
+
+	require.NoError(t, call(nil))
+	require.NoError(t, call(unavailable))
+	require.NoError(t, call(nil))
+	require.NoError(t, call(unavailable))
+	require.Equal(t, []string{"closed->open"}, transitions)
+
+	err := call(nil)
+	require.ErrorIs(t, err, breaker.ErrOpen)
+	var openErr *breaker.OpenError
+	require.ErrorAs(t, err, &openErr)
+	require.Equal(t, "a:2135", openErr.Address)
+	require.Equal(t, time.Minute, openErr.RetryAfter)
+	require.NotNil(t, xerrors.RetryableError(err))
+
+	clock.Advance(time.Minute)
+	require.NoError(t, call(unavailable))
+	require.Equal(t, []string{"closed->open", "open->half-open", "half-open->open"}, transitions)
+
+	clock.Advance(time.Minute)
+	onDone1, err := cbs.Allow(ctx, e)
+	require.NoError(t, err)
+	onDone2, err := cbs.Allow(ctx, e)
+	require.NoError(t, err)
+	_, err = cbs.Allow(ctx, e)
+	require.ErrorIs(t, err, breaker.ErrOpen, "probe calls limit reached")
+	onDone1(nil)
+	onDone2(nil)
+	require.Equal(t, "half-open->closed", transitions[len(transitions)-1])
+
+	t.Run("CanceledNotFailure", func(t *testing.T) {
+		require.False(t, isCircuitBreakerFailure(xerrors.Transport(grpcStatus.Error(grpcCodes.Canceled, ""))))
+		require.False(t, isCircuitBreakerFailure(errors.New("test")))
+		require.True(t, isCircuitBreakerFailure(xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_OVERLOADED))))
+	})
+}
+
+func TestCircuitBreakersSlowCalls(t *testing.T) {
+	clock := clockwork.NewFakeClock()
+	cbs := newCircuitBreakers(breaker.New(
+		breaker.WithMinRequests(2),
+		breaker.WithSlowCallThreshold(time.Second, 1),
+	), clock, &trace.Driver{})
+	e := endpoint.New("a:2135")
+	for range make([]struct{}, 2) {
+		onDone, err := cbs.Allow(context.Background(), e)
+		require.NoError(t, err)
+		clock.Advance(time.Second)
+		onDone(nil)
+	}
+	_, err := cbs.Allow(context.Background(), e)
+	require.ErrorIs(t, err, breaker.ErrOpen)
+}
+
+func TestCircuitBreakersByLabel(t *testing.T) {
+	cbs := newCircuitBreakers(breaker.New(
+		breaker.WithMinRequests(1),
+		breaker.WithLabels(true),
+	), clockwork.NewFakeClock(), &trace.Driver{})
+	e := endpoint.New("a:2135")
+	ctx := xcontext.WithRetryLabel(context.Background(), "heavy")
+	onDone, err := cbs.Allow(ctx, e)
+	require.NoError(t, err)
+	onDone(xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_OVERLOADED)))
+
+	_, err = cbs.Allow(ctx, e)
+	var openErr *breaker.OpenError
+	require.ErrorAs(t, err, &openErr)
+	require.Equal(t, "heavy", openErr.Label)
+
+	_, err = cbs.Allow(context.Background(), e)
+	require.NoError(t, err, "other labels not affected")
+}
+
+func TestCircuitBreakersPessimizeConnection(t *testing.T) {
+	var (
+		ctx   = context.Background()
+		clock = clockwork.NewFakeClock()
+		open  = &mock.Conn{AddrField: "open:2135", State: conn.Online}
+		good  = &mock.Conn{AddrField: "good:2135", State: conn.Online}
+	)
+	cbs := newCircuitBreakers(breaker.New(
+		breaker.WithMinRequests(1),
+		breaker.WithOpenTimeout(time.Minute),
+	), clock, &trace.Driver{})
+
+	onDone, err := cbs.Allow(ctx, open.Endpoint())
+	require.NoError(t, err)
+	onDone(xerrors.Transport(grpcStatus.Error(grpcCodes.Unavailable, "")))
+	require.True(t, cbs.IsOpen(ctx, open.Endpoint()))
+	require.False(t, cbs.IsOpen(ctx, good.Endpoint()))
+
+	s := newConnectionsState([]conn.Conn{open, good}, nil, balancerConfig.Info{}, false)
+	s.pessimized = cbs.IsOpen
+	for i := 0; i < 100; i++ {
+		c, failed := s.GetConnection(ctx)
+		require.Equal(t, good, c)
+		require.Equal(t, 0, failed)
+	}
+
+	t.Run("AllOpen", func(t *testing.T) {
+		s := newConnectionsState([]conn.Conn{open}, nil, balancerConfig.Info{}, false)
+		s.pessimized = cbs.IsOpen
+		c, _ := s.GetConnection(ctx)
+		require.Equal(t, open, c)
+	})
+
+	t.Run("AfterOpenTimeout", func(t *testing.T) {
+		clock.Advance(time.Minute)
+		require.False(t, cbs.IsOpen(ctx, open.Endpoint()))
+	})
+}
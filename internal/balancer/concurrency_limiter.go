@@ -0,0 +1,230 @@
+package balancer
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Query_V1"
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Table_V1"
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Topic_V1"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	grpcCodes "google.golang.org/grpc/codes"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/backoff"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry/limiter"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+var (
+	queryServicePrefix = "/" + Ydb_Query_V1.QueryService_ServiceDesc.ServiceName + "/"
+	tableServicePrefix = "/" + Ydb_Table_V1.TableService_ServiceDesc.ServiceName + "/"
+	topicServicePrefix = "/" + Ydb_Topic_V1.TopicService_ServiceDesc.ServiceName + "/"
+)
+
+type (
+	// concurrencyLimiters keeps adaptive concurrency limiters by services
+	concurrencyLimiters struct {
+		limiters map[limiter.Service]*concurrencyLimiter
+	}
+	concurrencyLimiter struct {
+		service limiter.Service
+		config  *limiter.Config
+		clock   clockwork.Clock
+		trace   *trace.Driver
+
+		mu       sync.Mutex
+		limit    float64
+		inFlight int
+		queue    []chan struct{}
+	}
+)
+
+func newConcurrencyLimiters(
+	config *limiter.Config, clock clockwork.Clock, t *trace.Driver,
+) *concurrencyLimiters {
+	services := []limiter.Service{limiter.ServiceQuery, limiter.ServiceTable, limiter.ServiceTopic}
+	cls := &concurrencyLimiters{
+		limiters: make(map[limiter.Service]*concurrencyLimiter, len(services)),
+	}
+	for _, service := range services {
+		serviceConfig := config.ForService(service)
+		cls.limiters[service] = &concurrencyLimiter{
+			service: service,
+			config:  serviceConfig,
+			clock:   clock,
+			trace:   t,
+			limit:   float64(serviceConfig.InitialLimit),
+		}
+	}
+
+	return cls
+}
+
+// limitedService returns service of method or false if calls of method are not limited.
+// Long-lived streams (session attaching, topic reading and writing) are not limited
+func limitedService(method string, isStream bool) (limiter.Service, bool) {
+	switch {
+	case strings.HasPrefix(method, queryServicePrefix):
+		return limiter.ServiceQuery, method != Ydb_Query_V1.QueryService_AttachSession_FullMethodName
+	case strings.HasPrefix(method, tableServicePrefix):
+		return limiter.ServiceTable, true
+	case strings.HasPrefix(method, topicServicePrefix):
+		return limiter.ServiceTopic, !isStream
+	default:
+		return "", false
+	}
+}
+
+// Acquire takes slot for call of method and returns callback for releasing slot with result of call.
+// Returns *limiter.LimitExceededError if slot is not acquired
+func (cls *concurrencyLimiters) Acquire(
+	ctx context.Context, method string, isStream bool,
+) (release func(err error), _ error) {
+	service, ok := limitedService(method, isStream)
+	if !ok {
+		return func(error) {}, nil
+	}
+
+	return cls.limiters[service].acquire(ctx)
+}
+
+func (l *concurrencyLimiter) acquire(ctx context.Context) (release func(err error), finalErr error) {
+	l.mu.Lock()
+	limit, inFlight, queued := int(l.limit), l.inFlight, len(l.queue)
+	l.mu.Unlock()
+
+	onDone := trace.DriverOnBalancerConcurrencyLimiterAcquire(l.trace, &ctx,
+		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/v3/internal/balancer.(*concurrencyLimiter).acquire"),
+		string(l.service), limit, inFlight, queued,
+	)
+	defer func() {
+		onDone(finalErr)
+	}()
+
+	l.mu.Lock()
+	if l.inFlight < int(l.limit) && len(l.queue) == 0 {
+		l.inFlight++
+		l.mu.Unlock()
+
+		return l.releaser(ctx), nil
+	}
+	if len(l.queue) >= l.config.MaxQueueSize {
+		err := l.limitExceeded()
+		l.mu.Unlock()
+
+		return nil, err
+	}
+	ch := make(chan struct{})
+	l.queue = append(l.queue, ch)
+	l.mu.Unlock()
+
+	var timeout <-chan time.Time
+	if l.config.MaxQueueWait > 0 {
+		timer := l.clock.NewTimer(l.config.MaxQueueWait)
+		defer timer.Stop()
+		timeout = timer.Chan()
+	}
+
+	select {
+	case <-ch:
+		return l.releaser(ctx), nil
+	case <-ctx.Done():
+	case <-timeout:
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	select {
+	case <-ch:
+		// slot was granted concurrently with cancellation, return it back
+		l.inFlight--
+		l.grant()
+	default:
+		for i := range l.queue {
+			if l.queue[i] == ch {
+				l.queue = append(l.queue[:i], l.queue[i+1:]...)
+
+				break
+			}
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return nil, l.limitExceeded()
+}
+
+// limitExceeded makes error of rejected call. Must be called under lock
+func (l *concurrencyLimiter) limitExceeded() error {
+	return xerrors.WithStackTrace(xerrors.Retryable(&limiter.LimitExceededError{
+		Service: l.service,
+		Limit:   int(l.limit),
+		Queued:  len(l.queue),
+	}, xerrors.WithBackoff(backoff.TypeSlow), xerrors.WithName("ConcurrencyLimitExceeded")))
+}
+
+// grant passes free slots to waiting calls. Must be called under lock
+func (l *concurrencyLimiter) grant() {
+	for len(l.queue) > 0 && l.inFlight < int(l.limit) {
+		close(l.queue[0])
+		l.queue = l.queue[1:]
+		l.inFlight++
+	}
+}
+
+func (l *concurrencyLimiter) releaser(ctx context.Context) func(err error) {
+	var (
+		start = l.clock.Now()
+		once  sync.Once
+	)
+
+	return func(err error) {
+		once.Do(func() {
+			l.release(ctx, err, l.clock.Since(start))
+		})
+	}
+}
+
+func (l *concurrencyLimiter) release(ctx context.Context, err error, latency time.Duration) {
+	l.mu.Lock()
+	from := int(l.limit)
+	if isOverloaded(err) || (l.config.Timeout > 0 && latency >= l.config.Timeout) {
+		l.limit *= l.config.BackoffRatio
+		if l.limit < float64(l.config.MinLimit) {
+			l.limit = float64(l.config.MinLimit)
+		}
+	} else if err == nil && l.inFlight*2 >= int(l.limit) {
+		l.limit++
+		if l.limit > float64(l.config.MaxLimit) {
+			l.limit = float64(l.config.MaxLimit)
+		}
+	}
+	to := int(l.limit)
+	l.inFlight--
+	l.grant()
+	l.mu.Unlock()
+
+	if from != to {
+		trace.DriverOnBalancerConcurrencyLimitChange(l.trace, &ctx,
+			stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/v3/internal/balancer.(*concurrencyLimiter).release"),
+			string(l.service), from, to,
+		)()
+	}
+}
+
+// isOverloaded reports whether err is a server overload signal
+func isOverloaded(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	return xerrors.IsOperationError(err, Ydb.StatusIds_OVERLOADED) ||
+		xerrors.IsTransportError(err, grpcCodes.ResourceExhausted)
+}
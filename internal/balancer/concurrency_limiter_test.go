@@ -0,0 +1,174 @@
+package balancer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Query_V1"
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Topic_V1"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	grpcCodes "google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry/limiter"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+func TestLimitedService(t *testing.T) {
+	for _, tt := range []struct {
+		method   string
+		isStream bool
+		service  limiter.Service
+		limited  bool
+	}{
+		{
+			method:   Ydb_Query_V1.QueryService_ExecuteQuery_FullMethodName,
+			isStream: true,
+			service:  limiter.ServiceQuery,
+			limited:  true,
+		},
+		{
+			method:   Ydb_Query_V1.QueryService_AttachSession_FullMethodName,
+			isStream: true,
+			service:  limiter.ServiceQuery,
+			limited:  false,
+		},
+		{
+			method:  "/Ydb.Table.V1.TableService/ExecuteDataQuery",
+			service: limiter.ServiceTable,
+			limited: true,
+		},
+		{
+			method:  Ydb_Topic_V1.TopicService_DescribeTopic_FullMethodName,
+			service: limiter.ServiceTopic,
+			limited: true,
+		},
+		{
+			method:   Ydb_Topic_V1.TopicService_StreamRead_FullMethodName,
+			isStream: true,
+			service:  limiter.ServiceTopic,
+			limited:  false,
+		},
+		{
+			method:  "/Ydb.Discovery.V1.DiscoveryService/ListEndpoints",
+			limited: false,
+		},
+	} {
+		t.Run(tt.method, func(t *testing.T) {
+			service, limited := limitedService(tt.method, tt.isStream)
+			require.Equal(t, tt.limited, limited)
+			if limited {
+				require.Equal(t, tt.service, service)
+			}
+		})
+	}
+}
+
+func TestConcurrencyLimiterAIMD(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		changes []int
+	)
+	cls := newConcurrencyLimiters(limiter.New(
+		limiter.WithInitialLimit(2),
+		limiter.WithLimits(1, 3),
+		limiter.WithBackoffRatio(0.5),
+		limiter.WithService(limiter.ServiceTable, limiter.WithInitialLimit(1)),
+	), clockwork.NewFakeClock(), &trace.Driver{
+		OnBalancerConcurrencyLimitChange: func(
+			info trace.DriverBalancerConcurrencyLimitChangeStartInfo,
+		) func(trace.DriverBalancerConcurrencyLimitChangeDoneInfo) {
+			changes = append(changes, info.To)
+
+			return nil
+		},
+	})
+	l := cls.limiters[limiter.ServiceQuery]
+	require.Equal(t, 1, int(cls.limiters[limiter.ServiceTable].limit))
+
+	release, err := l.acquire(ctx)
+	require.NoError(t, err)
+	release(nil)
+	require.Equal(t, []int{3}, changes, "additive increase")
+
+	release, err = l.acquire(ctx)
+	require.NoError(t, err)
+	release(xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_OVERLOADED)))
+	require.Equal(t, []int{3, 1}, changes, "multiplicative decrease")
+
+	release, err = l.acquire(ctx)
+	require.NoError(t, err)
+	release(xerrors.Transport(grpcStatus.Error(grpcCodes.ResourceExhausted, "")))
+	require.Equal(t, []int{3, 1}, changes, "min limit")
+	require.Equal(t, 0, l.inFlight)
+}
+
+func TestConcurrencyLimiterQueue(t *testing.T) {
+	ctx := xtest.Context(t)
+	cls := newConcurrencyLimiters(limiter.New(
+		limiter.WithInitialLimit(1),
+		limiter.WithMaxQueueSize(1),
+		limiter.WithMaxQueueWait(time.Second),
+	), clockwork.NewFakeClock(), &trace.Driver{})
+	l := cls.limiters[limiter.ServiceQuery]
+
+	release, err := l.acquire(ctx)
+	require.NoError(t, err)
+
+	acquired := make(chan error, 1)
+	go func() {
+		release, err := l.acquire(ctx)
+		if err == nil {
+			release(nil)
+		}
+		acquired <- err
+	}()
+	xtest.SpinWaitCondition(t, &l.mu, func() bool {
+		return len(l.queue) == 1
+	})
+
+	_, err = l.acquire(ctx)
+	require.ErrorIs(t, err, limiter.ErrLimitExceeded, "queue is full")
+	var limitErr *limiter.LimitExceededError
+	require.ErrorAs(t, err, &limitErr)
+	require.Equal(t, limiter.ServiceQuery, limitErr.Service)
+
+	release(nil)
+	require.NoError(t, <-acquired)
+
+	t.Run("MaxQueueWait", func(t *testing.T) {
+		clock := clockwork.NewFakeClock()
+		l.mu.Lock()
+		l.limit, l.inFlight, l.clock = 1, 1, clock
+		l.mu.Unlock()
+		go func() {
+			_, err := l.acquire(ctx)
+			acquired <- err
+		}()
+		clock.BlockUntil(1)
+		clock.Advance(time.Second)
+		require.ErrorIs(t, <-acquired, limiter.ErrLimitExceeded)
+		require.Empty(t, l.queue)
+	})
+
+	t.Run("ContextDone", func(t *testing.T) {
+		clock := clockwork.NewFakeClock()
+		l.mu.Lock()
+		l.clock = clock
+		l.mu.Unlock()
+		childCtx, cancel := context.WithCancel(ctx)
+		go func() {
+			_, err := l.acquire(childCtx)
+			acquired <- err
+		}()
+		clock.BlockUntil(1)
+		cancel()
+		require.ErrorIs(t, <-acquired, context.Canceled)
+		require.Empty(t, l.queue)
+	})
+}
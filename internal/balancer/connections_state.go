@@ -16,6 +16,10 @@ type connectionsState struct {
 	fallback []conn.Conn
 	all      []conn.Conn
 
+	// pessimized reports whether endpoint must be chosen only if no other good connections
+	// (for example, circuit breaker of endpoint is open)
+	pessimized func(ctx context.Context, e endpoint.Endpoint) bool
+
 	rand xrand.Rand
 }
 
@@ -66,18 +70,35 @@ func (s *connectionsState) GetConnection(ctx context.Context) (_ conn.Conn, fail
 		return c, 0
 	}
 
-	try := func(conns []conn.Conn) conn.Conn {
-		c, tryFailed := s.selectRandomConnection(conns, false)
+	try := func(conns []conn.Conn, isOk func(c conn.Conn) bool) conn.Conn {
+		c, tryFailed := s.selectRandomConnectionFunc(conns, isOk)
 		failedCount += tryFailed
 
 		return c
 	}
 
-	if c := try(s.prefer); c != nil {
+	isGood := func(c conn.Conn) bool {
+		return isOkConnection(c, false)
+	}
+
+	if s.pessimized != nil {
+		isNotPessimized := func(c conn.Conn) bool {
+			return isGood(c) && !s.pessimized(ctx, c.Endpoint())
+		}
+		if c := try(s.prefer, isNotPessimized); c != nil {
+			return c, failedCount
+		}
+		if c := try(s.fallback, isNotPessimized); c != nil {
+			return c, failedCount
+		}
+		failedCount = 0
+	}
+
+	if c := try(s.prefer, isGood); c != nil {
 		return c, failedCount
 	}
 
-	if c := try(s.fallback); c != nil {
+	if c := try(s.fallback, isGood); c != nil {
 		return c, failedCount
 	}
 
@@ -98,6 +119,14 @@ func (s *connectionsState) preferConnection(ctx context.Context) conn.Conn {
 }
 
 func (s *connectionsState) selectRandomConnection(conns []conn.Conn, allowBanned bool) (c conn.Conn, failedConns int) {
+	return s.selectRandomConnectionFunc(conns, func(c conn.Conn) bool {
+		return isOkConnection(c, allowBanned)
+	})
+}
+
+func (s *connectionsState) selectRandomConnectionFunc(
+	conns []conn.Conn, isOk func(c conn.Conn) bool,
+) (c conn.Conn, failedConns int) {
 	connCount := len(conns)
 	if connCount == 0 {
 		// return for empty list need for prevent panic in fast path
@@ -105,7 +134,7 @@ func (s *connectionsState) selectRandomConnection(conns []conn.Conn, allowBanned
 	}
 
 	// fast path
-	if c := conns[s.rand.Int(connCount)]; isOkConnection(c, allowBanned) {
+	if c := conns[s.rand.Int(connCount)]; isOk(c) {
 		return c, 0
 	}
 
@@ -120,7 +149,7 @@ func (s *connectionsState) selectRandomConnection(conns []conn.Conn, allowBanned
 
 	for _, index := range indexes {
 		c := conns[index]
-		if isOkConnection(c, allowBanned) {
+		if isOk(c) {
 			return c, 0
 		}
 		failedConns++
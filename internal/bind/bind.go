@@ -13,6 +13,7 @@ type blockID int
 const (
 	blockPragma = blockID(iota)
 	blockDeclare
+	blockSliceArgs
 	blockYQL
 )
 
@@ -1,6 +1,7 @@
 package bind
 
 import (
+	"database/sql"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -23,9 +24,35 @@ func TestSort(t *testing.T) {
 				NumericArgs{},
 			},
 		},
+		{
+			bindings: []Bind{
+				NamedArgs{},
+				AutoDeclare{},
+				SliceArgs{},
+			},
+			sorted: []Bind{
+				AutoDeclare{},
+				SliceArgs{},
+				NamedArgs{},
+			},
+		},
 	} {
 		t.Run("", func(t *testing.T) {
 			require.Equal(t, tt.sorted, Sort(tt.bindings))
 		})
 	}
 }
+
+func TestBindingsNamedAndSliceArgs(t *testing.T) {
+	yql, params, err := Bindings(Sort([]Bind{NamedArgs{}, SliceArgs{}, AutoDeclare{}})).RewriteQuery(
+		`SELECT * FROM t WHERE id IN (@ids)`,
+		sql.Named("ids", []int64{1, 2, 3}),
+	)
+	require.NoError(t, err)
+	require.Len(t, params, 1)
+	require.Equal(t, `-- bind declares
+DECLARE $ids AS List<Int64>;
+
+-- origin query with named args replacement
+SELECT * FROM t WHERE id IN $ids`, yql)
+}
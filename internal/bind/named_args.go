@@ -0,0 +1,170 @@
+package bind
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xstring"
+)
+
+// NamedArgs rewrites named args in `@name` and `:name` styles to YQL parameters `$name`
+type NamedArgs struct{}
+
+func (m NamedArgs) blockID() blockID {
+	return blockYQL
+}
+
+func (m NamedArgs) RewriteQuery(sql string, args ...interface{}) (
+	yql string, newArgs []interface{}, err error,
+) {
+	l := &sqlLexer{
+		src:        sql,
+		stateFn:    namedArgsStateFn,
+		rawStateFn: namedArgsStateFn,
+	}
+
+	for l.stateFn != nil {
+		l.stateFn = l.stateFn(l)
+	}
+
+	parameters, err := Params(args...)
+	if err != nil {
+		return "", nil, xerrors.WithStackTrace(err)
+	}
+
+	names := make(map[string]*params.Parameter, len(parameters))
+	for _, param := range parameters {
+		names[param.Name()] = param
+	}
+
+	var (
+		buffer = xstring.Buffer()
+		count  = 0
+	)
+	defer buffer.Free()
+
+	for _, p := range l.parts {
+		switch p := p.(type) {
+		case string:
+			buffer.WriteString(p)
+		case namedArg:
+			name := "$" + string(p)
+			if _, has := names[name]; !has {
+				return "", nil, xerrors.WithStackTrace(
+					fmt.Errorf("%w: named arg '%s' not found in query args", ErrInconsistentArgs, name),
+				)
+			}
+			buffer.WriteString(name)
+			count++
+		}
+	}
+
+	for _, param := range parameters {
+		newArgs = append(newArgs, param)
+	}
+
+	if count > 0 {
+		return "-- origin query with named args replacement\n" + buffer.String(), newArgs, nil
+	}
+
+	return buffer.String(), newArgs, nil
+}
+
+func isIdentifierStart(r rune) bool {
+	return isLetter(r) || r == '_'
+}
+
+func isIdentifier(r rune) bool {
+	return isIdentifierStart(r) || isNumber(r)
+}
+
+func namedArgsStateFn(l *sqlLexer) stateFn {
+	for {
+		r, width := utf8.DecodeRuneInString(l.src[l.pos:])
+		l.pos += width
+
+		switch r {
+		case '`':
+			return backtickState
+		case '\'':
+			return singleQuoteState
+		case '"':
+			return doubleQuoteState
+		case '@', ':':
+			nextRune, nextWidth := utf8.DecodeRuneInString(l.src[l.pos:])
+			switch {
+			case r == '@' && nextRune == '@':
+				l.pos += nextWidth
+
+				return multilineStringState
+			case r == ':' && nextRune == ':':
+				// skip namespace delimiter (Math::Sqrt) and postgres-style cast (x::int)
+				l.pos += nextWidth
+			case isIdentifierStart(nextRune):
+				prevRune, _ := utf8.DecodeLastRuneInString(l.src[:l.pos-width])
+				if isIdentifier(prevRune) {
+					continue
+				}
+				l.parts = append(l.parts, l.src[l.start:l.pos-width])
+				l.start = l.pos
+
+				return namedArgState
+			}
+		case '-':
+			nextRune, width := utf8.DecodeRuneInString(l.src[l.pos:])
+			if nextRune == '-' {
+				l.pos += width
+
+				return oneLineCommentState
+			}
+		case '/':
+			nextRune, width := utf8.DecodeRuneInString(l.src[l.pos:])
+			if nextRune == '*' {
+				l.pos += width
+
+				return multilineCommentState
+			}
+		case utf8.RuneError:
+			if l.pos-l.start > 0 {
+				l.parts = append(l.parts, l.src[l.start:l.pos])
+				l.start = l.pos
+			}
+
+			return nil
+		}
+	}
+}
+
+func namedArgState(l *sqlLexer) stateFn {
+	end := strings.IndexFunc(l.src[l.pos:], func(r rune) bool {
+		return !isIdentifier(r)
+	})
+	if end < 0 {
+		end = len(l.src) - l.pos
+	}
+	l.parts = append(l.parts, namedArg(l.src[l.pos:l.pos+end]))
+	l.pos += end
+	l.start = l.pos
+
+	return l.rawStateFn
+}
+
+// multilineStringState skips YQL multiline string literal @@...@@
+func multilineStringState(l *sqlLexer) stateFn {
+	end := strings.Index(l.src[l.pos:], "@@")
+	if end < 0 {
+		l.pos = len(l.src)
+		if l.pos-l.start > 0 {
+			l.parts = append(l.parts, l.src[l.start:l.pos])
+			l.start = l.pos
+		}
+
+		return nil
+	}
+	l.pos += end + len("@@")
+
+	return l.rawStateFn
+}
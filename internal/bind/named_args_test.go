@@ -0,0 +1,80 @@
+package bind
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+func TestNamedArgsBindRewriteQuery(t *testing.T) {
+	b := NamedArgs{}
+	for _, tt := range []struct {
+		sql    string
+		args   []interface{}
+		yql    string
+		params []interface{}
+		err    error
+	}{
+		{
+			sql: `SELECT @a, :b`,
+			args: []interface{}{
+				sql.Named("a", 100),
+				sql.Named("b", 200),
+			},
+			yql: `-- origin query with named args replacement
+SELECT $a, $b`,
+			params: []interface{}{
+				table.ValueParam("$a", types.Int32Value(100)),
+				table.ValueParam("$b", types.Int32Value(200)),
+			},
+		},
+		{
+			sql: `SELECT @a, @b`,
+			args: []interface{}{
+				sql.Named("a", 100),
+			},
+			err: ErrInconsistentArgs,
+		},
+		{
+			sql: "SELECT @a, '@b', \"@b\", `@b` -- @b\n/* :b */",
+			args: []interface{}{
+				sql.Named("a", 100),
+			},
+			yql: "-- origin query with named args replacement\nSELECT $a, '@b', \"@b\", `@b` -- @b\n/* :b */",
+			params: []interface{}{
+				table.ValueParam("$a", types.Int32Value(100)),
+			},
+		},
+		{
+			sql: `SELECT CAST(:a AS Int64)::Int64, @@:b@@, email@host`,
+			args: []interface{}{
+				sql.Named("a", 100),
+			},
+			yql: `-- origin query with named args replacement
+SELECT CAST($a AS Int64)::Int64, @@:b@@, email@host`,
+			params: []interface{}{
+				table.ValueParam("$a", types.Int32Value(100)),
+			},
+		},
+		{
+			sql: `SELECT 1`,
+			yql: `SELECT 1`,
+		},
+	} {
+		t.Run("", func(t *testing.T) {
+			yql, params, err := b.RewriteQuery(tt.sql, tt.args...)
+			if tt.err != nil {
+				require.Error(t, err)
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.yql, yql)
+				require.Equal(t, tt.params, params)
+			}
+		})
+	}
+}
@@ -6,10 +6,12 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"reflect"
 	"sort"
 	"time"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
+	internalTypes "github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
@@ -125,6 +127,10 @@ func toValue(v interface{}) (_ types.Value, err error) {
 	case *time.Duration:
 		return types.NullableIntervalValueFromDuration(x), nil
 	default:
+		if rv := reflect.ValueOf(x); rv.Kind() == reflect.Slice || rv.Kind() == reflect.Array {
+			return sliceToValue(rv)
+		}
+
 		return nil, xerrors.WithStackTrace(
 			fmt.Errorf("%T: %w. Create issue for support new type %s",
 				x, errUnsupportedType, supportNewTypeLink(x),
@@ -133,6 +139,30 @@ func toValue(v interface{}) (_ types.Value, err error) {
 	}
 }
 
+// sliceToValue makes list value from go slice or array. Empty slice makes empty list
+// with item type inferred from go type of slice items
+func sliceToValue(v reflect.Value) (types.Value, error) {
+	if v.Len() == 0 {
+		t, err := internalTypes.FromGoType(v.Type())
+		if err != nil {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("%s: %w", v.Type(), errUnsupportedType))
+		}
+
+		return value.ZeroValue(t), nil
+	}
+
+	items := make([]types.Value, v.Len())
+	for i := range items {
+		item, err := toValue(v.Index(i).Interface())
+		if err != nil {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("item %d: %w", i, err))
+		}
+		items[i] = item
+	}
+
+	return types.ListValue(items...), nil
+}
+
 func supportNewTypeLink(x interface{}) string {
 	v := url.Values{}
 	v.Add("labels", "enhancement,database/sql")
@@ -0,0 +1,147 @@
+package bind
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xstring"
+)
+
+var (
+	inListPrefix = regexp.MustCompile(`(?i)\bIN\s*\(\s*$`)
+	inListSuffix = regexp.MustCompile(`^\s*\)`)
+)
+
+// SliceArgs expands `IN ($ids)` with list parameter $ids (from go slice) to `IN $ids`
+type SliceArgs struct{}
+
+func (m SliceArgs) blockID() blockID {
+	return blockSliceArgs
+}
+
+func (m SliceArgs) RewriteQuery(sql string, args ...interface{}) (
+	yql string, newArgs []interface{}, err error,
+) {
+	parameters, err := Params(args...)
+	if err != nil {
+		return "", nil, xerrors.WithStackTrace(err)
+	}
+
+	lists := make(map[string]struct{}, len(parameters))
+	for _, param := range parameters {
+		newArgs = append(newArgs, param)
+		if _, isList := param.Value().Type().(*types.List); isList {
+			lists[param.Name()] = struct{}{}
+		}
+	}
+
+	if len(lists) == 0 {
+		return sql, newArgs, nil
+	}
+
+	l := &sqlLexer{
+		src:        sql,
+		stateFn:    paramArgsStateFn,
+		rawStateFn: paramArgsStateFn,
+	}
+
+	for l.stateFn != nil {
+		l.stateFn = l.stateFn(l)
+	}
+
+	for i, p := range l.parts {
+		name, isParam := p.(paramArg)
+		if !isParam || i == 0 || i == len(l.parts)-1 {
+			continue
+		}
+		if _, isList := lists[string(name)]; !isList {
+			continue
+		}
+		prefix, isPrefixString := l.parts[i-1].(string)
+		suffix, isSuffixString := l.parts[i+1].(string)
+		if !isPrefixString || !isSuffixString ||
+			!inListPrefix.MatchString(prefix) || !inListSuffix.MatchString(suffix) {
+			continue
+		}
+		prefix = strings.TrimSuffix(strings.TrimRightFunc(prefix, unicode.IsSpace), "(")
+		l.parts[i-1] = strings.TrimRightFunc(prefix, unicode.IsSpace) + " "
+		l.parts[i+1] = strings.TrimPrefix(strings.TrimLeftFunc(suffix, unicode.IsSpace), ")")
+	}
+
+	buffer := xstring.Buffer()
+	defer buffer.Free()
+
+	for _, p := range l.parts {
+		switch p := p.(type) {
+		case string:
+			buffer.WriteString(p)
+		case paramArg:
+			buffer.WriteString(string(p))
+		}
+	}
+
+	return buffer.String(), newArgs, nil
+}
+
+func paramArgsStateFn(l *sqlLexer) stateFn {
+	for {
+		r, width := utf8.DecodeRuneInString(l.src[l.pos:])
+		l.pos += width
+
+		switch r {
+		case '`':
+			return backtickState
+		case '\'':
+			return singleQuoteState
+		case '"':
+			return doubleQuoteState
+		case '$':
+			nextRune, _ := utf8.DecodeRuneInString(l.src[l.pos:])
+			if isIdentifierStart(nextRune) {
+				l.parts = append(l.parts, l.src[l.start:l.pos-width])
+				l.start = l.pos - width
+
+				return paramArgState
+			}
+		case '-':
+			nextRune, width := utf8.DecodeRuneInString(l.src[l.pos:])
+			if nextRune == '-' {
+				l.pos += width
+
+				return oneLineCommentState
+			}
+		case '/':
+			nextRune, width := utf8.DecodeRuneInString(l.src[l.pos:])
+			if nextRune == '*' {
+				l.pos += width
+
+				return multilineCommentState
+			}
+		case utf8.RuneError:
+			if l.pos-l.start > 0 {
+				l.parts = append(l.parts, l.src[l.start:l.pos])
+				l.start = l.pos
+			}
+
+			return nil
+		}
+	}
+}
+
+func paramArgState(l *sqlLexer) stateFn {
+	end := strings.IndexFunc(l.src[l.pos:], func(r rune) bool {
+		return !isIdentifier(r)
+	})
+	if end < 0 {
+		end = len(l.src) - l.pos
+	}
+	l.pos += end
+	l.parts = append(l.parts, paramArg(l.src[l.start:l.pos]))
+	l.start = l.pos
+
+	return l.rawStateFn
+}
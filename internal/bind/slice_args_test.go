@@ -0,0 +1,84 @@
+package bind
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+func TestSliceArgsBindRewriteQuery(t *testing.T) {
+	b := SliceArgs{}
+	for _, tt := range []struct {
+		sql    string
+		args   []interface{}
+		yql    string
+		params []interface{}
+		err    error
+	}{
+		{
+			sql: `SELECT * FROM t WHERE id IN ( $ids ) AND name = $name`,
+			args: []interface{}{
+				sql.Named("ids", []int64{1, 2}),
+				sql.Named("name", "a"),
+			},
+			yql: `SELECT * FROM t WHERE id IN $ids AND name = $name`,
+			params: []interface{}{
+				table.ValueParam("$ids", types.ListValue(types.Int64Value(1), types.Int64Value(2))),
+				table.ValueParam("$name", types.TextValue("a")),
+			},
+		},
+		{
+			sql: `SELECT * FROM t WHERE id in($ids)`,
+			args: []interface{}{
+				sql.Named("ids", []int64{}),
+			},
+			yql: `SELECT * FROM t WHERE id in $ids`,
+			params: []interface{}{
+				table.ValueParam("$ids", types.ZeroValue(types.List(types.TypeInt64))),
+			},
+		},
+		{
+			sql: `SELECT * FROM t WHERE id IN $ids OR id = ListHead(($ids))`,
+			args: []interface{}{
+				sql.Named("ids", []uint32{1}),
+			},
+			yql: `SELECT * FROM t WHERE id IN $ids OR id = ListHead(($ids))`,
+			params: []interface{}{
+				table.ValueParam("$ids", types.ListValue(types.Uint32Value(1))),
+			},
+		},
+		{
+			sql: `SELECT * FROM t WHERE id IN ($id)`,
+			args: []interface{}{
+				sql.Named("id", 1),
+			},
+			yql: `SELECT * FROM t WHERE id IN ($id)`,
+			params: []interface{}{
+				table.ValueParam("$id", types.Int32Value(1)),
+			},
+		},
+		{
+			sql: `SELECT * FROM t WHERE id IN ($ids)`,
+			args: []interface{}{
+				sql.Named("ids", []chan int{}),
+			},
+			err: errUnsupportedType,
+		},
+	} {
+		t.Run("", func(t *testing.T) {
+			yql, params, err := b.RewriteQuery(tt.sql, tt.args...)
+			if tt.err != nil {
+				require.Error(t, err)
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.yql, yql)
+				require.Equal(t, tt.params, params)
+			}
+		})
+	}
+}
@@ -15,6 +15,8 @@ type sqlLexer struct {
 type (
 	positionalArg struct{}
 	numericArg    int
+	namedArg      string
+	paramArg      string
 
 	stateFn func(*sqlLexer) stateFn
 )
@@ -0,0 +1,240 @@
+package certificates
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+const DefaultReloadInterval = 10 * time.Second
+
+var (
+	errNoPeerCertificates = errors.New("no peer certificates")
+	errNoTLSMaterial      = errors.New("no loaded TLS certificates")
+)
+
+type (
+	fileStamp struct {
+		modTime time.Time
+		size    int64
+	}
+	// Reloader keeps CA certificates and client certificate loaded from files and reloads them
+	// if files changed. Files checks for changes on TLS handshakes not often than reload interval.
+	Reloader struct {
+		caFile   string
+		certFile string
+		keyFile  string
+		interval time.Duration
+		clock    clockwork.Clock
+
+		mu        sync.Mutex
+		checkedAt time.Time
+		stamps    [3]fileStamp
+		roots     *x509.CertPool
+		cert      *tls.Certificate
+	}
+	ReloaderOption func(r *Reloader)
+)
+
+func WithReloadInterval(interval time.Duration) ReloaderOption {
+	return func(r *Reloader) {
+		if interval > 0 {
+			r.interval = interval
+		}
+	}
+}
+
+func withReloaderClock(clock clockwork.Clock) ReloaderOption {
+	return func(r *Reloader) {
+		r.clock = clock
+	}
+}
+
+// NewRootsReloader makes reloader of CA certificates from pem-encoded file
+func NewRootsReloader(caFile string, opts ...ReloaderOption) *Reloader {
+	return newReloader(caFile, "", "", opts...)
+}
+
+// NewClientCertificateReloader makes reloader of client certificate from pem-encoded files
+func NewClientCertificateReloader(certFile, keyFile string, opts ...ReloaderOption) *Reloader {
+	return newReloader("", certFile, keyFile, opts...)
+}
+
+func newReloader(caFile, certFile, keyFile string, opts ...ReloaderOption) *Reloader {
+	r := &Reloader{
+		caFile:   caFile,
+		certFile: certFile,
+		keyFile:  keyFile,
+		interval: DefaultReloadInterval,
+		clock:    clockwork.NewRealClock(),
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(r)
+		}
+	}
+
+	return r
+}
+
+func (r *Reloader) files() [3]string {
+	return [3]string{r.caFile, r.certFile, r.keyFile}
+}
+
+func (r *Reloader) loaded() bool {
+	return r.roots != nil || r.cert != nil
+}
+
+// reload checks files for changes and loads them if needed.
+// Error returns only if no one previous TLS material loaded
+func (r *Reloader) reload(ctx context.Context, t *trace.Driver) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := r.clock.Now()
+	if r.loaded() && now.Sub(r.checkedAt) < r.interval {
+		return nil
+	}
+	r.checkedAt = now
+
+	var stamps [3]fileStamp
+	for i, file := range r.files() {
+		if file == "" {
+			continue
+		}
+		info, err := os.Stat(file)
+		if err != nil {
+			return r.failed(ctx, t, xerrors.WithStackTrace(err))
+		}
+		stamps[i] = fileStamp{modTime: info.ModTime(), size: info.Size()}
+	}
+	if r.loaded() && stamps == r.stamps {
+		return nil
+	}
+
+	roots, cert, err := r.load()
+	if err != nil {
+		return r.failed(ctx, t, err)
+	}
+
+	onDone := trace.DriverOnTLSCertificatesReload(t, &ctx,
+		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/v3/internal/certificates.(*Reloader).reload"),
+		r.caFile, r.certFile, r.keyFile,
+	)
+	r.roots, r.cert, r.stamps = roots, cert, stamps
+	onDone(nil)
+
+	return nil
+}
+
+// failed reports about failed reload. Previous TLS material keeps if loaded, because files
+// may be in the middle of rotation
+func (r *Reloader) failed(ctx context.Context, t *trace.Driver, err error) error {
+	onDone := trace.DriverOnTLSCertificatesReload(t, &ctx,
+		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/v3/internal/certificates.(*Reloader).reload"),
+		r.caFile, r.certFile, r.keyFile,
+	)
+	onDone(err)
+
+	if r.loaded() {
+		return nil
+	}
+
+	return err
+}
+
+func (r *Reloader) load() (roots *x509.CertPool, cert *tls.Certificate, _ error) {
+	if r.caFile != "" {
+		bytes, err := os.ReadFile(r.caFile)
+		if err != nil {
+			return nil, nil, xerrors.WithStackTrace(err)
+		}
+		certs, err := FromPem(bytes, FromPemNoCache(true))
+		if err != nil {
+			return nil, nil, xerrors.WithStackTrace(err)
+		}
+		roots = x509.NewCertPool()
+		for _, c := range certs {
+			roots.AddCert(c)
+		}
+	}
+	if r.certFile != "" {
+		c, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+		if err != nil {
+			return nil, nil, xerrors.WithStackTrace(err)
+		}
+		cert = &c
+	}
+
+	return roots, cert, nil
+}
+
+// GetClientCertificate returns function for tls.Config.GetClientCertificate with actual client certificate
+func (r *Reloader) GetClientCertificate(
+	t *trace.Driver,
+) func(info *tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	return func(info *tls.CertificateRequestInfo) (*tls.Certificate, error) {
+		if err := r.reload(info.Context(), t); err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		r.mu.Lock()
+		defer r.mu.Unlock()
+
+		if r.cert == nil {
+			return nil, xerrors.WithStackTrace(errNoTLSMaterial)
+		}
+
+		return r.cert, nil
+	}
+}
+
+// VerifyConnection returns function for tls.Config.VerifyConnection which verifies server certificate
+// with actual CA certificates or with base roots (usually system roots)
+func (r *Reloader) VerifyConnection(
+	base *x509.CertPool, t *trace.Driver,
+) func(cs tls.ConnectionState) error {
+	return func(cs tls.ConnectionState) error {
+		if err := r.reload(context.Background(), t); err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+
+		r.mu.Lock()
+		roots := r.roots
+		r.mu.Unlock()
+
+		if len(cs.PeerCertificates) == 0 {
+			return xerrors.WithStackTrace(errNoPeerCertificates)
+		}
+		opts := x509.VerifyOptions{
+			DNSName:       cs.ServerName,
+			Intermediates: x509.NewCertPool(),
+			Roots:         roots,
+		}
+		for _, cert := range cs.PeerCertificates[1:] {
+			opts.Intermediates.AddCert(cert)
+		}
+		_, err := cs.PeerCertificates[0].Verify(opts)
+		if err != nil && base != nil {
+			opts.Roots = base
+			if _, baseErr := cs.PeerCertificates[0].Verify(opts); baseErr == nil {
+				return nil
+			}
+		}
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+
+		return nil
+	}
+}
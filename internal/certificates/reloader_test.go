@@ -0,0 +1,171 @@
+package certificates
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+type testCert struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	der  []byte
+}
+
+func newTestCert(t *testing.T, name string, parent *testCert) *testCert {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: name},
+		DNSNames:              []string{name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  parent == nil,
+	}
+	parentCert, parentKey := template, key
+	if parent != nil {
+		parentCert, parentKey = parent.cert, parent.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parentCert, &key.PublicKey, parentKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return &testCert{cert: cert, key: key, der: der}
+}
+
+func (c *testCert) writeFiles(t *testing.T, certFile, keyFile string, modTime time.Time) {
+	certPem := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: c.der})
+	require.NoError(t, os.WriteFile(certFile, certPem, 0o600))
+	require.NoError(t, os.Chtimes(certFile, modTime, modTime))
+	if keyFile != "" {
+		keyDer, err := x509.MarshalECPrivateKey(c.key)
+		require.NoError(t, err)
+		keyPem := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+		require.NoError(t, os.WriteFile(keyFile, keyPem, 0o600))
+		require.NoError(t, os.Chtimes(keyFile, modTime, modTime))
+	}
+}
+
+func reloadsCounter(reloads, failures *int) *trace.Driver {
+	return &trace.Driver{
+		OnTLSCertificatesReload: func(
+			trace.DriverTLSCertificatesReloadStartInfo,
+		) func(trace.DriverTLSCertificatesReloadDoneInfo) {
+			return func(info trace.DriverTLSCertificatesReloadDoneInfo) {
+				if info.Error != nil {
+					*failures++
+				} else {
+					*reloads++
+				}
+			}
+		},
+	}
+}
+
+func TestReloaderClientCertificate(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := filepath.Join(dir, "tls.crt"), filepath.Join(dir, "tls.key")
+	clock := clockwork.NewFakeClock()
+	var reloads, failures int
+	r := NewClientCertificateReloader(certFile, keyFile,
+		WithReloadInterval(time.Minute),
+		withReloaderClock(clock),
+	)
+	getClientCertificate := r.GetClientCertificate(reloadsCounter(&reloads, &failures))
+	info := &tls.CertificateRequestInfo{}
+
+	_, err := getClientCertificate(info)
+	require.Error(t, err)
+	require.Equal(t, 1, failures)
+
+	ca := newTestCert(t, "ca", nil)
+	first := newTestCert(t, "first", ca)
+	first.writeFiles(t, certFile, keyFile, clock.Now())
+	cert, err := getClientCertificate(info)
+	require.NoError(t, err)
+	require.Equal(t, first.der, cert.Certificate[0])
+	require.Equal(t, 1, reloads)
+
+	second := newTestCert(t, "second", ca)
+	second.writeFiles(t, certFile, keyFile, clock.Now().Add(time.Second))
+	cert, err = getClientCertificate(info)
+	require.NoError(t, err)
+	require.Equal(t, first.der, cert.Certificate[0], "files checks not often than reload interval")
+
+	clock.Advance(time.Minute)
+	cert, err = getClientCertificate(info)
+	require.NoError(t, err)
+	require.Equal(t, second.der, cert.Certificate[0])
+	require.Equal(t, 2, reloads)
+
+	// broken files on rotation keeps previous certificate
+	require.NoError(t, os.WriteFile(keyFile, []byte("broken"), 0o600))
+	clock.Advance(time.Minute)
+	cert, err = getClientCertificate(info)
+	require.NoError(t, err)
+	require.Equal(t, second.der, cert.Certificate[0])
+	require.Equal(t, 2, failures)
+}
+
+func TestReloaderVerifyConnection(t *testing.T) {
+	caFile := filepath.Join(t.TempDir(), "ca.crt")
+	clock := clockwork.NewFakeClock()
+	var reloads, failures int
+	r := NewRootsReloader(caFile,
+		WithReloadInterval(time.Minute),
+		withReloaderClock(clock),
+	)
+	verify := r.VerifyConnection(nil, reloadsCounter(&reloads, &failures))
+
+	firstCA := newTestCert(t, "first-ca", nil)
+	firstCA.writeFiles(t, caFile, "", clock.Now())
+	secondCA := newTestCert(t, "second-ca", nil)
+	server := newTestCert(t, "server", secondCA)
+	state := tls.ConnectionState{
+		ServerName:       "server",
+		PeerCertificates: []*x509.Certificate{server.cert},
+	}
+
+	require.Error(t, verify(state))
+	require.Equal(t, 1, reloads)
+
+	secondCA.writeFiles(t, caFile, "", clock.Now().Add(time.Second))
+	clock.Advance(time.Minute)
+	require.NoError(t, verify(state))
+	require.Equal(t, 2, reloads)
+
+	require.ErrorIs(t, verify(tls.ConnectionState{ServerName: "server"}), errNoPeerCertificates)
+
+	t.Run("BaseRoots", func(t *testing.T) {
+		base := x509.NewCertPool()
+		base.AddCert(secondCA.cert)
+		firstCA.writeFiles(t, caFile, "", clock.Now().Add(2*time.Second))
+		clock.Advance(time.Minute)
+		require.NoError(t, r.VerifyConnection(base, &trace.Driver{})(state))
+	})
+}
+
+func TestReloaderNoFiles(t *testing.T) {
+	r := NewRootsReloader(filepath.Join(t.TempDir(), "not_exists.crt"))
+	require.Error(t, r.reload(context.Background(), &trace.Driver{}))
+}
@@ -3,6 +3,7 @@ package config
 import (
 	"time"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
 	"github.com/ydb-platform/ydb-go-sdk/v3/retry/budget"
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
@@ -15,6 +16,7 @@ type Common struct {
 	disableAutoRetry     bool
 	traceRetry           trace.Retry
 	retryBudget          budget.Budget
+	retryPolicy          *retry.Policy
 
 	panicCallback func(e interface{})
 }
@@ -60,6 +62,12 @@ func (c *Common) RetryBudget() budget.Budget {
 	return c.retryBudget
 }
 
+// RetryPolicy returns retry policy for all retry loops of clients
+// If nil - retries with default rules of SDK
+func (c *Common) RetryPolicy() *retry.Policy {
+	return c.retryPolicy
+}
+
 // SetOperationTimeout define the maximum amount of time a YDB server will process
 // an operation. After timeout exceeds YDB will try to cancel operation and
 // regardless of the cancellation appropriate error will be returned to
@@ -97,3 +105,7 @@ func SetTraceRetry(c *Common, t *trace.Retry, opts ...trace.RetryComposeOption)
 func SetRetryBudget(c *Common, b budget.Budget) {
 	c.retryBudget = b
 }
+
+func SetRetryPolicy(c *Common, p *retry.Policy) {
+	c.retryPolicy = p
+}
@@ -0,0 +1,134 @@
+package conn
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+type (
+	// Fault describes failure which injected into call instead of (or in addition to) calling of
+	// underlying connection
+	Fault struct {
+		// Delay is a latency before call
+		Delay time.Duration
+		// Err returns from call (or from opening of stream) without calling of underlying connection
+		Err error
+		// StreamErr returns from RecvMsg of stream after StreamAfter successfully received messages
+		StreamErr   error
+		StreamAfter int
+	}
+	// FaultRule decides which fault must be injected into call
+	FaultRule interface {
+		Fault(ctx context.Context, method string, stream bool) (_ Fault, ok bool)
+	}
+)
+
+// WithFaults makes middleware which injects faults from first matched rule into calls
+func WithFaults(cc grpc.ClientConnInterface, rules ...FaultRule) grpc.ClientConnInterface {
+	fault := func(ctx context.Context, method string, stream bool) (Fault, bool) {
+		for _, rule := range rules {
+			if f, ok := rule.Fault(ctx, method, stream); ok {
+				return f, true
+			}
+		}
+
+		return Fault{}, false
+	}
+
+	return &middleware{
+		invoke: func(ctx context.Context, method string, args interface{}, reply interface{}, opts ...grpc.CallOption) error {
+			f, ok := fault(ctx, method, false)
+			if !ok {
+				return cc.Invoke(ctx, method, args, reply, opts...)
+			}
+			if err := delay(ctx, f.Delay); err != nil {
+				return xerrors.WithStackTrace(err)
+			}
+			if f.Err != nil {
+				return xerrors.WithStackTrace(f.Err)
+			}
+
+			return cc.Invoke(ctx, method, args, reply, opts...)
+		},
+		newStream: func(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (
+			grpc.ClientStream, error,
+		) {
+			f, ok := fault(ctx, method, true)
+			if !ok {
+				return cc.NewStream(ctx, desc, method, opts...)
+			}
+			if err := delay(ctx, f.Delay); err != nil {
+				return nil, xerrors.WithStackTrace(err)
+			}
+			if f.Err != nil {
+				return nil, xerrors.WithStackTrace(f.Err)
+			}
+
+			s, err := cc.NewStream(ctx, desc, method, opts...)
+			if err != nil || f.StreamErr == nil {
+				return s, err
+			}
+
+			return &faultyClientStream{
+				ClientStream: s,
+				err:          f.StreamErr,
+				after:        f.StreamAfter,
+			}, nil
+		},
+	}
+}
+
+func delay(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+type faultyClientStream struct {
+	grpc.ClientStream
+
+	mu       sync.Mutex
+	err      error
+	after    int
+	received int
+}
+
+func (s *faultyClientStream) RecvMsg(m interface{}) error {
+	s.mu.Lock()
+	broken := s.received >= s.after
+	s.mu.Unlock()
+
+	if broken {
+		if xerrors.Is(s.err, io.EOF) {
+			return io.EOF
+		}
+
+		return xerrors.WithStackTrace(s.err)
+	}
+
+	if err := s.ClientStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	s.received++
+	s.mu.Unlock()
+
+	return nil
+}
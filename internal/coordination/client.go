@@ -118,6 +118,7 @@ func (c *Client) CreateNode(ctx context.Context, path string, config coordinatio
 		retry.WithIdempotent(true),
 		retry.WithTrace(c.config.TraceRetry()),
 		retry.WithBudget(c.config.RetryBudget()),
+		retry.WithPolicy(c.config.RetryPolicy()),
 	)
 }
 
@@ -151,6 +152,7 @@ func (c *Client) AlterNode(ctx context.Context, path string, config coordination
 		retry.WithIdempotent(true),
 		retry.WithTrace(c.config.TraceRetry()),
 		retry.WithBudget(c.config.RetryBudget()),
+		retry.WithPolicy(c.config.RetryPolicy()),
 	)
 }
 
@@ -212,6 +214,7 @@ func (c *Client) DropNode(ctx context.Context, path string) (finalErr error) {
 		retry.WithIdempotent(true),
 		retry.WithTrace(c.config.TraceRetry()),
 		retry.WithBudget(c.config.RetryBudget()),
+		retry.WithPolicy(c.config.RetryPolicy()),
 	)
 }
 
@@ -272,6 +275,7 @@ func (c *Client) DescribeNode(
 		retry.WithIdempotent(true),
 		retry.WithTrace(c.config.TraceRetry()),
 		retry.WithBudget(c.config.RetryBudget()),
+		retry.WithPolicy(c.config.RetryPolicy()),
 	)
 	if err != nil {
 		return nil, nil, xerrors.WithStackTrace(err)
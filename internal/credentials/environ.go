@@ -0,0 +1,125 @@
+package credentials
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+const (
+	EnvServiceAccountKeyFile = "YDB_SERVICE_ACCOUNT_KEY_FILE_CREDENTIALS"
+	EnvAnonymous             = "YDB_ANONYMOUS_CREDENTIALS"
+	EnvMetadata              = "YDB_METADATA_CREDENTIALS"
+	EnvAccessToken           = "YDB_ACCESS_TOKEN_CREDENTIALS"
+	EnvStaticUser            = "YDB_STATIC_CREDENTIALS_USER"
+	EnvStaticPassword        = "YDB_STATIC_CREDENTIALS_PASSWORD"
+	EnvStaticEndpoint        = "YDB_STATIC_CREDENTIALS_ENDPOINT"
+	EnvOauth2KeyFile         = "YDB_OAUTH2_KEY_FILE"
+)
+
+var (
+	errEnvironStaticEndpointRequired = errors.New("static credentials endpoint required")
+)
+
+type (
+	environConfig struct {
+		serviceAccountKeyFile func(keyFile string) (Credentials, error)
+		metadata              func() (Credentials, error)
+		staticOpts            []StaticCredentialsOption
+	}
+	EnvironOption func(c *environConfig)
+)
+
+// WithEnvironServiceAccountKeyFile sets constructor of credentials from service account key file
+// and enables check of YDB_SERVICE_ACCOUNT_KEY_FILE_CREDENTIALS
+func WithEnvironServiceAccountKeyFile(f func(keyFile string) (Credentials, error)) EnvironOption {
+	return func(c *environConfig) {
+		c.serviceAccountKeyFile = f
+	}
+}
+
+// WithEnvironMetadata sets constructor of credentials from metadata service
+// and enables check of YDB_METADATA_CREDENTIALS
+func WithEnvironMetadata(f func() (Credentials, error)) EnvironOption {
+	return func(c *environConfig) {
+		c.metadata = f
+	}
+}
+
+// WithEnvironStaticCredentialsOptions sets options of static credentials
+func WithEnvironStaticCredentialsOptions(opts ...StaticCredentialsOption) EnvironOption {
+	return func(c *environConfig) {
+		c.staticOpts = append(c.staticOpts, opts...)
+	}
+}
+
+// FromEnviron makes credentials from standard YDB environment variables.
+// Variables checks in order:
+//   - YDB_ANONYMOUS_CREDENTIALS=1 - anonymous credentials
+//   - YDB_ACCESS_TOKEN_CREDENTIALS - access token
+//   - YDB_STATIC_CREDENTIALS_USER, YDB_STATIC_CREDENTIALS_PASSWORD and YDB_STATIC_CREDENTIALS_ENDPOINT -
+//     static credentials
+//   - YDB_OAUTH2_KEY_FILE - path to config file of OAuth 2.0 token exchange credentials
+//
+// YDB_SERVICE_ACCOUNT_KEY_FILE_CREDENTIALS (checks first) and YDB_METADATA_CREDENTIALS=1 (checks after
+// YDB_ANONYMOUS_CREDENTIALS) are not supported by core and checks only if constructor of credentials
+// is set with WithEnvironServiceAccountKeyFile and WithEnvironMetadata.
+//
+// Anonymous credentials returns if no one variable defined
+func FromEnviron(opts ...EnvironOption) (Credentials, error) {
+	cfg := &environConfig{}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(cfg)
+		}
+	}
+
+	if keyFile, has := os.LookupEnv(EnvServiceAccountKeyFile); has && cfg.serviceAccountKeyFile != nil {
+		c, err := cfg.serviceAccountKeyFile(keyFile)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		return c, nil
+	}
+	if os.Getenv(EnvAnonymous) == "1" {
+		return NewAnonymousCredentials(WithSourceInfo("credentials.FromEnviron(" + EnvAnonymous + ")")), nil
+	}
+	if os.Getenv(EnvMetadata) == "1" && cfg.metadata != nil {
+		c, err := cfg.metadata()
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		return c, nil
+	}
+	if accessToken, has := os.LookupEnv(EnvAccessToken); has {
+		return NewAccessTokenCredentials(accessToken,
+			WithSourceInfo("credentials.FromEnviron("+EnvAccessToken+")"),
+		), nil
+	}
+	if user, has := os.LookupEnv(EnvStaticUser); has {
+		endpoint := os.Getenv(EnvStaticEndpoint)
+		if endpoint == "" {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("%w: %s", errEnvironStaticEndpointRequired, EnvStaticEndpoint))
+		}
+
+		return NewStaticCredentials(user, os.Getenv(EnvStaticPassword), endpoint,
+			append(cfg.staticOpts, WithSourceInfo("credentials.FromEnviron("+EnvStaticUser+")"))...,
+		), nil
+	}
+	if keyFile, has := os.LookupEnv(EnvOauth2KeyFile); has {
+		c, err := NewOauth2TokenExchangeCredentialsFile(keyFile,
+			WithSourceInfo("credentials.FromEnviron("+EnvOauth2KeyFile+")"),
+		)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		return c, nil
+	}
+
+	return NewAnonymousCredentials(WithSourceInfo("credentials.FromEnviron()")), nil
+}
@@ -0,0 +1,104 @@
+package credentials
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromEnviron(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		env  map[string]string
+		opts []EnvironOption
+		c    string
+		err  error
+	}{
+		{
+			name: "Default",
+			c:    "Anonymous{From:\"credentials.FromEnviron()\"}",
+		},
+		{
+			name: "Anonymous",
+			env:  map[string]string{EnvAnonymous: "1", EnvAccessToken: "token"},
+			c:    "Anonymous{From:\"credentials.FromEnviron(YDB_ANONYMOUS_CREDENTIALS)\"}",
+		},
+		{
+			name: "AccessToken",
+			env:  map[string]string{EnvAccessToken: "123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ"},
+			c:    "AccessToken{Token:\"1234****WXYZ(CRC-32c: 81993EA5)\",From:\"credentials.FromEnviron(YDB_ACCESS_TOKEN_CREDENTIALS)\"}", //nolint:lll
+		},
+		{
+			name: "Static",
+			env: map[string]string{
+				EnvStaticUser:     "user",
+				EnvStaticPassword: "password",
+				EnvStaticEndpoint: "localhost:2135",
+			},
+			c: "Static{User:\"user\",Password:\"pas***rd\",Token:\"****(CRC-32c: 00000000)\",From:\"credentials.FromEnviron(YDB_STATIC_CREDENTIALS_USER)\"}", //nolint:lll
+		},
+		{
+			name: "StaticWithoutEndpoint",
+			env:  map[string]string{EnvStaticUser: "user"},
+			err:  errEnvironStaticEndpointRequired,
+		},
+		{
+			name: "MetadataWithoutConstructor",
+			env:  map[string]string{EnvMetadata: "1", EnvAccessToken: "token"},
+			c:    "AccessToken{Token:\"****(CRC-32c: 5F37A13B)\",From:\"credentials.FromEnviron(YDB_ACCESS_TOKEN_CREDENTIALS)\"}", //nolint:lll
+		},
+		{
+			name: "ServiceAccountKeyFileWithoutConstructor",
+			env:  map[string]string{EnvServiceAccountKeyFile: "key.json"},
+			c:    "Anonymous{From:\"credentials.FromEnviron()\"}",
+		},
+		{
+			name: "Metadata",
+			env:  map[string]string{EnvMetadata: "1"},
+			opts: []EnvironOption{
+				WithEnvironMetadata(func() (Credentials, error) {
+					return NewAnonymousCredentials(WithSourceInfo("metadata")), nil
+				}),
+			},
+			c: "Anonymous{From:\"metadata\"}",
+		},
+		{
+			name: "ServiceAccountKeyFile",
+			env:  map[string]string{EnvServiceAccountKeyFile: "key.json", EnvAnonymous: "1"},
+			opts: []EnvironOption{
+				WithEnvironServiceAccountKeyFile(func(keyFile string) (Credentials, error) {
+					return NewAnonymousCredentials(WithSourceInfo(keyFile)), nil
+				}),
+			},
+			c: "Anonymous{From:\"key.json\"}",
+		},
+		{
+			name: "Oauth2KeyFile",
+			env:  map[string]string{EnvOauth2KeyFile: "not_existing_file.json"},
+			err:  errCouldNotReadConfigFile,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, name := range []string{
+				EnvServiceAccountKeyFile, EnvAnonymous, EnvMetadata, EnvAccessToken,
+				EnvStaticUser, EnvStaticPassword, EnvStaticEndpoint, EnvOauth2KeyFile,
+			} {
+				t.Setenv(name, "")
+				require.NoError(t, os.Unsetenv(name))
+			}
+			for name, value := range tt.env {
+				t.Setenv(name, value)
+			}
+			c, err := FromEnviron(tt.opts...)
+			if tt.err != nil {
+				require.True(t, errors.Is(err, tt.err), err)
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.c, c.(interface{ String() string }).String())
+		})
+	}
+}
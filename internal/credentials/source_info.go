@@ -14,6 +14,10 @@ func (sourceInfo SourceInfoOption) ApplyAccessTokenCredentialsOption(h *AccessTo
 	h.sourceInfo = string(sourceInfo)
 }
 
+func (sourceInfo SourceInfoOption) ApplyTokenFileCredentialsOption(h *TokenFile) {
+	h.sourceInfo = string(sourceInfo)
+}
+
 func (sourceInfo SourceInfoOption) ApplyOauth2CredentialsOption(h *oauth2TokenExchange) error {
 	h.sourceInfo = string(sourceInfo)
 
@@ -0,0 +1,150 @@
+package credentials
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/secret"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xstring"
+)
+
+const defaultTokenFileRefreshInterval = time.Minute
+
+var (
+	_ Credentials                = (*TokenFile)(nil)
+	_ fmt.Stringer               = (*TokenFile)(nil)
+	_ TokenFileCredentialsOption = SourceInfoOption("")
+	_ TokenFileCredentialsOption = TokenFileRefreshIntervalOption(0)
+
+	errEmptyTokenFile = errors.New("empty token file")
+)
+
+type TokenFileCredentialsOption interface {
+	ApplyTokenFileCredentialsOption(c *TokenFile)
+}
+
+type TokenFileRefreshIntervalOption time.Duration
+
+func (interval TokenFileRefreshIntervalOption) ApplyTokenFileCredentialsOption(c *TokenFile) {
+	if interval > 0 {
+		c.refreshInterval = time.Duration(interval)
+	}
+}
+
+// WithTokenFileRefreshInterval sets interval between checks of token file for changes
+func WithTokenFileRefreshInterval(interval time.Duration) TokenFileRefreshIntervalOption {
+	return TokenFileRefreshIntervalOption(interval)
+}
+
+type tokenFileClockOption struct {
+	clock clockwork.Clock
+}
+
+func (o tokenFileClockOption) ApplyTokenFileCredentialsOption(c *TokenFile) {
+	c.clock = o.clock
+}
+
+// TokenFile implements Credentials interface with token from file.
+// File checks for changes not often than refresh interval, token re-reads from file
+// only if modification time or size of file changed. Such approach suits for rotated tokens
+// (for example, kubernetes projected service account tokens) without inotify.
+type TokenFile struct {
+	path            string
+	refreshInterval time.Duration
+	clock           clockwork.Clock
+	sourceInfo      string
+
+	mu        sync.Mutex
+	token     string
+	modTime   time.Time
+	size      int64
+	checkedAt time.Time
+}
+
+func NewTokenFileCredentials(path string, opts ...TokenFileCredentialsOption) *TokenFile {
+	if len(path) > 0 && path[0] == '~' {
+		if home, err := os.UserHomeDir(); err == nil {
+			path = filepath.Join(home, path[1:])
+		}
+	}
+	c := &TokenFile{
+		path:            path,
+		refreshInterval: defaultTokenFileRefreshInterval,
+		clock:           clockwork.NewRealClock(),
+		sourceInfo:      stack.Record(1),
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt.ApplyTokenFileCredentialsOption(c)
+		}
+	}
+
+	return c
+}
+
+// Token implements Credentials.
+func (c *TokenFile) Token(_ context.Context) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.clock.Now()
+	if c.token != "" && now.Sub(c.checkedAt) < c.refreshInterval {
+		return c.token, nil
+	}
+
+	info, err := os.Stat(c.path)
+	if err != nil {
+		return "", xerrors.WithStackTrace(fmt.Errorf("%w %s: %w", errCouldNotReadFile, c.path, err))
+	}
+	if c.token != "" && info.ModTime().Equal(c.modTime) && info.Size() == c.size {
+		c.checkedAt = now
+
+		return c.token, nil
+	}
+
+	content, err := os.ReadFile(c.path)
+	if err != nil {
+		return "", xerrors.WithStackTrace(fmt.Errorf("%w %s: %w", errCouldNotReadFile, c.path, err))
+	}
+	token := strings.TrimSpace(string(content))
+	if token == "" {
+		return "", xerrors.WithStackTrace(fmt.Errorf("%w: %s", errEmptyTokenFile, c.path))
+	}
+
+	c.token = token
+	c.modTime = info.ModTime()
+	c.size = info.Size()
+	c.checkedAt = now
+
+	return c.token, nil
+}
+
+func (c *TokenFile) String() string {
+	c.mu.Lock()
+	token := c.token
+	c.mu.Unlock()
+
+	buffer := xstring.Buffer()
+	defer buffer.Free()
+	buffer.WriteString("TokenFile{Path:")
+	fmt.Fprintf(buffer, "%q", c.path)
+	buffer.WriteString(",Token:")
+	fmt.Fprintf(buffer, "%q", secret.Token(token))
+	if c.sourceInfo != "" {
+		buffer.WriteString(",From:")
+		fmt.Fprintf(buffer, "%q", c.sourceInfo)
+	}
+	buffer.WriteByte('}')
+
+	return buffer.String()
+}
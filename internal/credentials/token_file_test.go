@@ -0,0 +1,58 @@
+package credentials
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenFile(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "token")
+	clock := clockwork.NewFakeClock()
+	writeToken := func(token string, modTime time.Time) {
+		require.NoError(t, os.WriteFile(path, []byte(token), 0o600))
+		require.NoError(t, os.Chtimes(path, modTime, modTime))
+	}
+
+	c := NewTokenFileCredentials(path,
+		WithTokenFileRefreshInterval(time.Minute),
+		tokenFileClockOption{clock: clock},
+	)
+
+	_, err := c.Token(ctx)
+	require.ErrorIs(t, err, errCouldNotReadFile)
+
+	writeToken("\n", clock.Now())
+	_, err = c.Token(ctx)
+	require.ErrorIs(t, err, errEmptyTokenFile)
+
+	writeToken("token-1\n", clock.Now())
+	token, err := c.Token(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "token-1", token)
+
+	// cached until refresh interval
+	modTime := clock.Now().Add(time.Second)
+	writeToken("token-2", modTime)
+	token, err = c.Token(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "token-1", token)
+
+	clock.Advance(time.Minute)
+	token, err = c.Token(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "token-2", token)
+
+	// file with same modification time and size not re-read
+	writeToken("token-3", modTime)
+	clock.Advance(time.Minute)
+	token, err = c.Token(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "token-2", token)
+}
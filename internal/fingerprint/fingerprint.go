@@ -0,0 +1,209 @@
+// Package fingerprint makes normalized forms and stable fingerprints of YQL queries
+package fingerprint
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xstring"
+)
+
+// Literal is a placeholder of stripped literals in normalized query
+const Literal = '?'
+
+var literalsList = regexp.MustCompile(`\?(,\?)+`)
+
+// Normalize returns compact canonical form of YQL query
+//
+// Normalize strips comments, replaces string and numeric literals with `?`,
+// collapses lists of literals such as `IN (1, 2, 3)` to single `?` and drops
+// whitespace which not separates words. Identifiers, keywords and query
+// parameters such as `$id` are kept as is
+func Normalize(q string) string {
+	buffer := xstring.Buffer()
+	defer buffer.Free()
+
+	var (
+		space bool
+		last  rune
+	)
+	write := func(token string) {
+		first, _ := utf8.DecodeRuneInString(token)
+		if space && isWord(last) && isWord(first) {
+			buffer.WriteByte(' ')
+		}
+		space = false
+		buffer.WriteString(token)
+		last, _ = utf8.DecodeLastRuneInString(token)
+	}
+
+	for pos := 0; pos < len(q); {
+		r, width := utf8.DecodeRuneInString(q[pos:])
+		switch {
+		case unicode.IsSpace(r):
+			space = true
+			pos += width
+		case strings.HasPrefix(q[pos:], "--"):
+			space = true
+			end := strings.IndexByte(q[pos:], '\n')
+			if end < 0 {
+				end = len(q) - pos
+			}
+			pos += end
+		case strings.HasPrefix(q[pos:], "/*"):
+			space = true
+			end := strings.Index(q[pos+2:], "*/")
+			if end < 0 {
+				pos = len(q)
+			} else {
+				pos += 2 + end + 2
+			}
+		case strings.HasPrefix(q[pos:], "@@"):
+			pos = skipMultilineString(q, pos+2)
+			pos = skipIdentifier(q, pos) // string literal suffixes such as 'text'u
+			write(string(Literal))
+		case r == '\'' || r == '"':
+			pos = skipQuoted(q, pos+width, r)
+			pos = skipIdentifier(q, pos)
+			write(string(Literal))
+		case r == '`':
+			end := skipQuoted(q, pos+width, r)
+			write(q[pos:end])
+			pos = end
+		case r == '$' || isIdentifierStart(r):
+			end := skipIdentifier(q, pos+width)
+			write(q[pos:end])
+			pos = end
+		case isDigit(r):
+			pos = skipNumber(q, pos)
+			write(string(Literal))
+		default:
+			write(q[pos : pos+width])
+			pos += width
+		}
+	}
+
+	return strings.TrimRight(literalsList.ReplaceAllLiteralString(buffer.String(), string(Literal)), ";")
+}
+
+// Fingerprint returns stable hex-encoded hash of normalized query
+//
+// Queries which differ only in literals, comments and formatting have the same
+// fingerprint, so fingerprint is suitable as low-cardinality label of metrics
+func Fingerprint(q string) string {
+	sum := sha256.Sum256([]byte(Normalize(q)))
+
+	return hex.EncodeToString(sum[:8])
+}
+
+// Stringer is a lazy fmt.Stringer which computes fingerprint of query on demand
+type Stringer string
+
+func (q Stringer) String() string {
+	return Fingerprint(string(q))
+}
+
+// DataQuery is a lazy fmt.Stringer which computes fingerprint of YQL text of query on demand.
+// Fingerprint of nil query is empty
+type DataQuery struct {
+	Query interface {
+		YQL() string
+	}
+}
+
+func (q DataQuery) String() string {
+	if q.Query == nil {
+		return ""
+	}
+
+	return Fingerprint(q.Query.YQL())
+}
+
+// Normalized is a lazy fmt.Stringer which computes normalized query on demand
+type Normalized string
+
+func (q Normalized) String() string {
+	return Normalize(string(q))
+}
+
+func isWord(r rune) bool {
+	return r == Literal || r == '$' || r == '`' || isIdentifier(r)
+}
+
+func isIdentifierStart(r rune) bool {
+	return r == '_' || unicode.IsLetter(r)
+}
+
+func isIdentifier(r rune) bool {
+	return isIdentifierStart(r) || isDigit(r)
+}
+
+func isDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
+
+func skipIdentifier(q string, pos int) int {
+	end := strings.IndexFunc(q[pos:], func(r rune) bool {
+		return !isIdentifier(r)
+	})
+	if end < 0 {
+		return len(q)
+	}
+
+	return pos + end
+}
+
+// skipQuoted returns position after closing quote with respect of backslash escapes
+func skipQuoted(q string, pos int, quote rune) int {
+	for pos < len(q) {
+		r, width := utf8.DecodeRuneInString(q[pos:])
+		pos += width
+		switch r {
+		case '\\':
+			_, width = utf8.DecodeRuneInString(q[pos:])
+			pos += width
+		case quote:
+			return pos
+		}
+	}
+
+	return pos
+}
+
+// skipMultilineString returns position after closing `@@` with respect of `@@@@` escapes
+func skipMultilineString(q string, pos int) int {
+	for {
+		end := strings.Index(q[pos:], "@@")
+		if end < 0 {
+			return len(q)
+		}
+		pos += end + 2
+		if !strings.HasPrefix(q[pos:], "@@") {
+			return pos
+		}
+		pos += 2
+	}
+}
+
+// skipNumber returns position after numeric literal such as 1, 1.5e-3, 0xFF, 10ul
+func skipNumber(q string, pos int) int {
+	start := pos
+	for pos < len(q) {
+		c := q[pos]
+		switch {
+		case c == '.' || c == '_' || isDigit(rune(c)) || unicode.IsLetter(rune(c)):
+			pos++
+		case (c == '+' || c == '-') && (q[pos-1] == 'e' || q[pos-1] == 'E') &&
+			!strings.HasPrefix(strings.ToLower(q[start:]), "0x"):
+			pos++
+		default:
+			return pos
+		}
+	}
+
+	return pos
+}
@@ -0,0 +1,66 @@
+package fingerprint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalize(t *testing.T) {
+	for _, tt := range []struct {
+		query      string
+		normalized string
+	}{
+		{
+			query:      "SELECT 1",
+			normalized: "SELECT ?",
+		},
+		{
+			query: `
+				-- comment
+				SELECT id, name /* inline comment */
+				FROM   ` + "`path/to/table`" + `
+				WHERE  id = 42 AND name = 'John' AND note = "it's";
+			`,
+			normalized: "SELECT id,name FROM `path/to/table` WHERE id=? AND name=? AND note=?",
+		},
+		{
+			query:      "SELECT * FROM t WHERE id IN (1, 2, 3) AND x > 1.5e-3 AND y = 0xFF AND z = 10ul",
+			normalized: "SELECT*FROM t WHERE id IN(?)AND x>? AND y=? AND z=?",
+		},
+		{
+			query:      "DECLARE $id AS Int64; SELECT * FROM t WHERE id = $id AND s = 'a'u AND m = @@multi@@@@line@@",
+			normalized: "DECLARE $id AS Int64;SELECT*FROM t WHERE id=$id AND s=? AND m=?",
+		},
+		{
+			query:      `SELECT 'escaped \' quote', t1.c2 FROM t1`,
+			normalized: "SELECT ?,t1.c2 FROM t1",
+		},
+	} {
+		t.Run("", func(t *testing.T) {
+			require.Equal(t, tt.normalized, Normalize(tt.query))
+		})
+	}
+}
+
+func TestFingerprint(t *testing.T) {
+	require.Equal(t,
+		Fingerprint("SELECT * FROM t WHERE id IN (1, 2, 3)"),
+		Fingerprint("SELECT *\nFROM t -- comment\nWHERE id IN (4,5);"),
+	)
+	require.NotEqual(t,
+		Fingerprint("SELECT * FROM t1 WHERE id = 1"),
+		Fingerprint("SELECT * FROM t2 WHERE id = 1"),
+	)
+	require.Len(t, Fingerprint("SELECT 1"), 16)
+	require.Equal(t, Fingerprint("SELECT 1"), Stringer("SELECT 2").String())
+	require.Equal(t, "SELECT ?", Normalized("SELECT 1").String())
+	require.Equal(t, Fingerprint("SELECT 1"), DataQuery{Query: yql("SELECT 3")}.String())
+	require.Equal(t, "", DataQuery{}.String())
+}
+
+type yql string
+
+func (q yql) YQL() string {
+	return string(q)
+}
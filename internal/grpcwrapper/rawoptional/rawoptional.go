@@ -35,6 +35,18 @@ func (v *Duration) ToProto() *durationpb.Duration {
 	return nil
 }
 
+func (v *Duration) MustFromProto(proto *durationpb.Duration) {
+	if proto == nil {
+		v.Value = 0
+		v.HasValue = false
+
+		return
+	}
+
+	v.HasValue = true
+	v.Value = proto.AsDuration()
+}
+
 type Int64 struct {
 	Value    int64
 	HasValue bool
@@ -30,6 +30,18 @@ func (c *Client) AlterTopic(ctx context.Context, req *AlterTopicRequest) (res Al
 	return res, err
 }
 
+// CommitOffset set committed offset of the consumer for the partition outside of read sessions
+func (c *Client) CommitOffset(ctx context.Context, req *CommitOffsetRequest) error {
+	resp, err := c.service.CommitOffset(ctx, req.ToProto())
+	if err != nil {
+		return xerrors.WithStackTrace(fmt.Errorf("ydb: commit offset grpc failed: %w", err))
+	}
+
+	var operation rawydb.Operation
+
+	return operation.FromProtoWithStatusCheck(resp.GetOperation())
+}
+
 func (c *Client) CreateTopic(
 	ctx context.Context,
 	req *CreateTopicRequest,
@@ -55,6 +67,20 @@ func (c *Client) DescribeTopic(ctx context.Context, req DescribeTopicRequest) (r
 	return res, err
 }
 
+func (c *Client) DescribeConsumer(ctx context.Context, req DescribeConsumerRequest) (
+	res DescribeConsumerResult, err error,
+) {
+	resp, err := c.service.DescribeConsumer(ctx, req.ToProto())
+	if err != nil {
+		return DescribeConsumerResult{}, xerrors.WithStackTrace(xerrors.Wrap(
+			fmt.Errorf("ydb: describe consumer grpc failed: %w", err),
+		))
+	}
+	err = res.FromProto(resp)
+
+	return res, err
+}
+
 func (c *Client) DropTopic(
 	ctx context.Context,
 	req DropTopicRequest,
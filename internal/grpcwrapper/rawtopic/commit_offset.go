@@ -0,0 +1,26 @@
+package rawtopic
+
+import (
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Topic"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopiccommon"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawydb"
+)
+
+type CommitOffsetRequest struct {
+	OperationParams rawydb.OperationParams
+	Path            string
+	PartitionID     int64
+	Consumer        string
+	Offset          rawtopiccommon.Offset
+}
+
+func (r *CommitOffsetRequest) ToProto() *Ydb_Topic.CommitOffsetRequest {
+	return &Ydb_Topic.CommitOffsetRequest{
+		OperationParams: r.OperationParams.ToProto(),
+		Path:            r.Path,
+		PartitionId:     r.PartitionID,
+		Consumer:        r.Consumer,
+		Offset:          r.Offset.ToInt64(),
+	}
+}
@@ -0,0 +1,82 @@
+package rawtopic
+
+import (
+	"fmt"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Topic"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/clone"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawscheme"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawydb"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/operation"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+type DescribeConsumerRequest struct {
+	OperationParams rawydb.OperationParams
+	Path            string
+	Consumer        string
+	IncludeStats    bool
+}
+
+func (req *DescribeConsumerRequest) ToProto() *Ydb_Topic.DescribeConsumerRequest {
+	return &Ydb_Topic.DescribeConsumerRequest{
+		OperationParams: req.OperationParams.ToProto(),
+		Path:            req.Path,
+		Consumer:        req.Consumer,
+		IncludeStats:    req.IncludeStats,
+	}
+}
+
+type DescribeConsumerResult struct {
+	Operation rawydb.Operation
+
+	Self       rawscheme.Entry
+	Consumer   Consumer
+	Partitions []DescribeConsumerPartitionInfo
+}
+
+func (res *DescribeConsumerResult) FromProto(response operation.Response) error {
+	if err := res.Operation.FromProtoWithStatusCheck(response.GetOperation()); err != nil {
+		return err
+	}
+
+	protoResult := &Ydb_Topic.DescribeConsumerResult{}
+	if err := response.GetOperation().GetResult().UnmarshalTo(protoResult); err != nil {
+		return xerrors.WithStackTrace(fmt.Errorf("ydb: describe consumer result failed on unmarshal grpc result: %w", err))
+	}
+
+	if err := res.Self.FromProto(protoResult.GetSelf()); err != nil {
+		return err
+	}
+
+	res.Consumer.MustFromProto(protoResult.GetConsumer())
+
+	protoPartitions := protoResult.GetPartitions()
+	res.Partitions = make([]DescribeConsumerPartitionInfo, len(protoPartitions))
+	for i, protoPartition := range protoPartitions {
+		res.Partitions[i].mustFromProto(protoPartition)
+	}
+
+	return nil
+}
+
+type DescribeConsumerPartitionInfo struct {
+	PartitionID            int64
+	Active                 bool
+	ChildPartitionIDs      []int64
+	ParentPartitionIDs     []int64
+	PartitionStats         PartitionStats
+	PartitionConsumerStats PartitionConsumerStats
+}
+
+func (pi *DescribeConsumerPartitionInfo) mustFromProto(proto *Ydb_Topic.DescribeConsumerResult_PartitionInfo) {
+	pi.PartitionID = proto.GetPartitionId()
+	pi.Active = proto.GetActive()
+
+	pi.ChildPartitionIDs = clone.Int64Slice(proto.GetChildPartitionIds())
+	pi.ParentPartitionIDs = clone.Int64Slice(proto.GetParentPartitionIds())
+
+	pi.PartitionStats.MustFromProto(proto.GetPartitionStats())
+	pi.PartitionConsumerStats.MustFromProto(proto.GetPartitionConsumerStats())
+}
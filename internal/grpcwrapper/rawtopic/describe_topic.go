@@ -17,12 +17,14 @@ import (
 type DescribeTopicRequest struct {
 	OperationParams rawydb.OperationParams
 	Path            string
+	IncludeStats    bool
 }
 
 func (req *DescribeTopicRequest) ToProto() *Ydb_Topic.DescribeTopicRequest {
 	return &Ydb_Topic.DescribeTopicRequest{
 		OperationParams: req.OperationParams.ToProto(),
 		Path:            req.Path,
+		IncludeStats:    req.IncludeStats,
 	}
 }
 
@@ -40,6 +42,7 @@ type DescribeTopicResult struct {
 	Attributes                        map[string]string
 	Consumers                         []Consumer
 	MeteringMode                      MeteringMode
+	TopicStats                        TopicStats
 }
 
 func (res *DescribeTopicResult) FromProto(response operation.Response) error {
@@ -85,6 +88,8 @@ func (res *DescribeTopicResult) FromProto(response operation.Response) error {
 
 	res.MeteringMode = MeteringMode(protoResult.GetMeteringMode())
 
+	res.TopicStats.MustFromProto(protoResult.GetTopicStats())
+
 	return nil
 }
 
@@ -93,6 +98,7 @@ type PartitionInfo struct {
 	Active             bool
 	ChildPartitionIDs  []int64
 	ParentPartitionIDs []int64
+	PartitionStats     PartitionStats
 }
 
 func (pi *PartitionInfo) mustFromProto(proto *Ydb_Topic.DescribeTopicResult_PartitionInfo) {
@@ -101,4 +107,6 @@ func (pi *PartitionInfo) mustFromProto(proto *Ydb_Topic.DescribeTopicResult_Part
 
 	pi.ChildPartitionIDs = clone.Int64Slice(proto.GetChildPartitionIds())
 	pi.ParentPartitionIDs = clone.Int64Slice(proto.GetParentPartitionIds())
+
+	pi.PartitionStats.MustFromProto(proto.GetPartitionStats())
 }
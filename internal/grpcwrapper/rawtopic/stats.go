@@ -0,0 +1,79 @@
+package rawtopic
+
+import (
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Topic"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawoptional"
+)
+
+type MultipleWindowsStat struct {
+	PerMinute int64
+	PerHour   int64
+	PerDay    int64
+}
+
+func (s *MultipleWindowsStat) MustFromProto(proto *Ydb_Topic.MultipleWindowsStat) {
+	s.PerMinute = proto.GetPerMinute()
+	s.PerHour = proto.GetPerHour()
+	s.PerDay = proto.GetPerDay()
+}
+
+type TopicStats struct {
+	StoreSizeBytes   int64
+	MinLastWriteTime rawoptional.Time
+	MaxWriteTimeLag  rawoptional.Duration
+	BytesWritten     MultipleWindowsStat
+}
+
+func (s *TopicStats) MustFromProto(proto *Ydb_Topic.DescribeTopicResult_TopicStats) {
+	s.StoreSizeBytes = proto.GetStoreSizeBytes()
+	s.MinLastWriteTime.MustFromProto(proto.GetMinLastWriteTime())
+	s.MaxWriteTimeLag.MustFromProto(proto.GetMaxWriteTimeLag())
+	s.BytesWritten.MustFromProto(proto.GetBytesWritten())
+}
+
+type PartitionStats struct {
+	StartOffset     int64
+	EndOffset       int64
+	StoreSizeBytes  int64
+	LastWriteTime   rawoptional.Time
+	MaxWriteTimeLag rawoptional.Duration
+	BytesWritten    MultipleWindowsStat
+	PartitionNodeID int32
+}
+
+func (s *PartitionStats) MustFromProto(proto *Ydb_Topic.PartitionStats) {
+	s.StartOffset = proto.GetPartitionOffsets().GetStart()
+	s.EndOffset = proto.GetPartitionOffsets().GetEnd()
+	s.StoreSizeBytes = proto.GetStoreSizeBytes()
+	s.LastWriteTime.MustFromProto(proto.GetLastWriteTime())
+	s.MaxWriteTimeLag.MustFromProto(proto.GetMaxWriteTimeLag())
+	s.BytesWritten.MustFromProto(proto.GetBytesWritten())
+	s.PartitionNodeID = proto.GetPartitionNodeId()
+}
+
+type PartitionConsumerStats struct {
+	LastReadOffset                 int64
+	CommittedOffset                int64
+	ReadSessionID                  string
+	PartitionReadSessionCreateTime rawoptional.Time
+	LastReadTime                   rawoptional.Time
+	MaxReadTimeLag                 rawoptional.Duration
+	MaxWriteTimeLag                rawoptional.Duration
+	BytesRead                      MultipleWindowsStat
+	ReaderName                     string
+	ConnectionNodeID               int32
+}
+
+func (s *PartitionConsumerStats) MustFromProto(proto *Ydb_Topic.DescribeConsumerResult_PartitionConsumerStats) {
+	s.LastReadOffset = proto.GetLastReadOffset()
+	s.CommittedOffset = proto.GetCommittedOffset()
+	s.ReadSessionID = proto.GetReadSessionId()
+	s.PartitionReadSessionCreateTime.MustFromProto(proto.GetPartitionReadSessionCreateTime())
+	s.LastReadTime.MustFromProto(proto.GetLastReadTime())
+	s.MaxReadTimeLag.MustFromProto(proto.GetMaxReadTimeLag())
+	s.MaxWriteTimeLag.MustFromProto(proto.GetMaxWriteTimeLag())
+	s.BytesRead.MustFromProto(proto.GetBytesRead())
+	s.ReaderName = proto.GetReaderName()
+	s.ConnectionNodeID = proto.GetConnectionNodeId()
+}
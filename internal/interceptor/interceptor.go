@@ -0,0 +1,159 @@
+package interceptor
+
+import (
+	"context"
+	"io"
+	"sync"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/interceptors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+// Observer notifies observers of intercepted call. Observer is safe for nil value
+type Observer struct {
+	observers   []*interceptors.Observer
+	observeRows bool
+	done        sync.Once
+}
+
+// Enabled reports whether call must be intercepted
+func Enabled(ctx context.Context, interceptor interceptors.Interceptor) bool {
+	return interceptor != nil || contextObserver(ctx) != nil
+}
+
+// Intercept calls interceptor (if defined) and returns observer of execution which notifies
+// returned observer and observer from context
+func Intercept(
+	ctx context.Context, interceptor interceptors.Interceptor, call *interceptors.Call,
+) (*Observer, error) {
+	var observers []*interceptors.Observer
+	if o := contextObserver(ctx); o != nil {
+		observers = append(observers, o)
+	}
+
+	if interceptor != nil {
+		o, err := interceptor(ctx, call)
+		if err != nil {
+			for _, o := range observers {
+				o.Done(err)
+			}
+
+			return nil, xerrors.WithStackTrace(err)
+		}
+		if o != nil {
+			observers = append(observers, o)
+		}
+	}
+
+	if len(observers) == 0 {
+		return nil, nil //nolint:nilnil
+	}
+
+	observer := &Observer{observers: observers}
+	for _, o := range observers {
+		observer.observeRows = observer.observeRows || o.ObserveRows()
+	}
+
+	return observer, nil
+}
+
+// Row notifies observers about row of result set
+func (o *Observer) Row(columns []*Ydb.Column, row *Ydb.Value) {
+	if o == nil || !o.observeRows {
+		return
+	}
+
+	r := interceptors.Row{
+		Columns: make([]string, len(columns)),
+		Values:  make([]types.Value, len(columns)),
+	}
+	for i, column := range columns {
+		r.Columns[i] = column.GetName()
+		if i < len(row.GetItems()) {
+			r.Values[i] = value.FromYDB(column.GetType(), row.GetItems()[i])
+		}
+	}
+
+	for _, observer := range o.observers {
+		observer.Row(r)
+	}
+}
+
+// Done notifies observers about finish of execution once. io.EOF means successful finish
+func (o *Observer) Done(err error) {
+	if o == nil {
+		return
+	}
+
+	o.done.Do(func() {
+		if xerrors.Is(err, io.EOF) {
+			err = nil
+		}
+		for _, observer := range o.observers {
+			observer.Done(err)
+		}
+	})
+}
+
+var isolations = map[protoreflect.Name]interceptors.Isolation{
+	"serializable_read_write": interceptors.SerializableReadWrite,
+	"online_read_only":        interceptors.OnlineReadOnly,
+	"stale_read_only":         interceptors.StaleReadOnly,
+	"snapshot_read_only":      interceptors.SnapshotReadOnly,
+}
+
+type txControl interface {
+	GetTxId() string
+	GetCommitTx() bool
+}
+
+// TxControl converts transaction control of query (Ydb_Query.TransactionControl) or table
+// (Ydb_Table.TransactionControl) service to interceptors.TxControl
+func TxControl(tx txControl, beginTx protoreflect.ProtoMessage) interceptors.TxControl {
+	c := interceptors.TxControl{
+		TxID:     tx.GetTxId(),
+		CommitTx: tx.GetCommitTx(),
+	}
+
+	settings := beginTx.ProtoReflect()
+	if !settings.IsValid() {
+		return c
+	}
+	oneofs := settings.Descriptor().Oneofs()
+	for i := 0; i < oneofs.Len(); i++ {
+		if field := settings.WhichOneof(oneofs.Get(i)); field != nil {
+			if isolation, has := isolations[field.Name()]; has {
+				c.Isolation = isolation
+			} else {
+				c.Isolation = interceptors.Isolation(field.Name())
+			}
+		}
+	}
+
+	return c
+}
+
+type observerCtxKey struct{}
+
+// WithObserver returns context with observer of call. Observer from context notified about
+// execution of next intercepted call (database/sql connector passes observer to table session)
+func WithObserver(ctx context.Context, o *interceptors.Observer) context.Context {
+	if o == nil {
+		return ctx
+	}
+
+	return context.WithValue(ctx, observerCtxKey{}, o)
+}
+
+func contextObserver(ctx context.Context) *interceptors.Observer {
+	if o, ok := ctx.Value(observerCtxKey{}).(*interceptors.Observer); ok {
+		return o
+	}
+
+	return nil
+}
@@ -81,6 +81,10 @@ type Meta struct {
 	capabilities    []string
 }
 
+func (m *Meta) ApplicationName() string {
+	return m.applicationName
+}
+
 func (m *Meta) meta(ctx context.Context) (_ metadata.MD, err error) {
 	md, has := metadata.FromOutgoingContext(ctx)
 	if !has {
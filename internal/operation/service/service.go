@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Operation_V1"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Issue"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Operations"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/conn"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
+)
+
+// Get returns long-running operation by ID with retries
+func Get(ctx context.Context, client Ydb_Operation_V1.OperationServiceClient, opID string, opts ...retry.Option) (
+	*Ydb_Operations.Operation, error,
+) {
+	op, err := retry.RetryWithResult(ctx, func(ctx context.Context) (*Ydb_Operations.Operation, error) {
+		response, err := client.GetOperation(conn.WithoutWrapping(ctx), &Ydb_Operations.GetOperationRequest{
+			Id: opID,
+		})
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		return response.GetOperation(), nil
+	}, append([]retry.Option{retry.WithIdempotent(true)}, opts...)...)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return op, nil
+}
+
+// Cancel starts cancellation of long-running operation with retries
+func Cancel(ctx context.Context, client Ydb_Operation_V1.OperationServiceClient, opID string,
+	opts ...retry.Option,
+) error {
+	err := retry.Retry(ctx, func(ctx context.Context) error {
+		response, err := client.CancelOperation(conn.WithoutWrapping(ctx), &Ydb_Operations.CancelOperationRequest{
+			Id: opID,
+		})
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+
+		return checkStatus(response.GetStatus(), response.GetIssues())
+	}, append([]retry.Option{retry.WithIdempotent(true)}, opts...)...)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// Forget forgets long-running operation with retries
+func Forget(ctx context.Context, client Ydb_Operation_V1.OperationServiceClient, opID string,
+	opts ...retry.Option,
+) error {
+	err := retry.Retry(ctx, func(ctx context.Context) error {
+		response, err := client.ForgetOperation(conn.WithoutWrapping(ctx), &Ydb_Operations.ForgetOperationRequest{
+			Id: opID,
+		})
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+
+		return checkStatus(response.GetStatus(), response.GetIssues())
+	}, append([]retry.Option{retry.WithIdempotent(true)}, opts...)...)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+func checkStatus(status Ydb.StatusIds_StatusCode, issues []*Ydb_Issue.IssueMessage) error {
+	if status != Ydb.StatusIds_SUCCESS {
+		return xerrors.WithStackTrace(xerrors.Operation(
+			xerrors.WithStatusCode(status),
+			xerrors.WithIssues(issues),
+		))
+	}
+
+	return nil
+}
@@ -0,0 +1,251 @@
+package params
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/decimal"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+const nameTag = "sql"
+
+var (
+	errNotAStruct = errors.New("not a struct")
+
+	typeOfTime     = reflect.TypeOf(time.Time{})
+	typeOfDuration = reflect.TypeOf(time.Duration(0))
+	typeOfUUID     = reflect.TypeOf(uuid.UUID{})
+	typeOfDecimal  = reflect.TypeOf(decimal.Decimal{})
+	typeOfBytes    = reflect.TypeOf([]byte(nil))
+
+	structFieldsCache sync.Map // reflect.Type -> []structField
+)
+
+type structField struct {
+	index int
+	name  string
+	t     types.Type
+}
+
+// structFields returns exported fields of struct type with YDB names and types.
+// Fields are cached per go type
+func structFields(t reflect.Type) ([]structField, error) {
+	if cached, has := structFieldsCache.Load(t); has {
+		return cached.([]structField), nil //nolint:forcetypeassert
+	}
+
+	fields := make([]structField, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		name := types.FieldName(f, nameTag)
+		if name == "-" {
+			continue
+		}
+		tt, err := types.FromGoType(f.Type)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("struct field '%s': %w", f.Name, err))
+		}
+		fields = append(fields, structField{
+			index: i,
+			name:  name,
+			t:     tt,
+		})
+	}
+
+	structFieldsCache.Store(t, fields)
+
+	return fields, nil
+}
+
+func paramName(name string) string {
+	if strings.HasPrefix(name, "$") {
+		return name
+	}
+
+	return "$" + name
+}
+
+// FromStruct makes parameters from exported fields of struct (or pointer to struct) v
+//
+// Parameter names are taken from `sql` tag (same as for ScanStruct) or from field names
+// with `$` prefix. Fields with tag `sql:"-"` are skipped. Parameter types are inferred
+// from types of fields with types.FromGoType
+func FromStruct(v interface{}) (*Parameters, error) {
+	rv := reflect.Indirect(reflect.ValueOf(v))
+	if rv.Kind() != reflect.Struct {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("%w: %T", errNotAStruct, v))
+	}
+
+	fields, err := structFields(rv.Type())
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	parameters := make(Parameters, 0, len(fields))
+	for _, f := range fields {
+		fv, err := toValue(f.t, rv.Field(f.index))
+		if err != nil {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("field '%s': %w", f.name, err))
+		}
+		parameters = append(parameters, Named(paramName(f.name), fv))
+	}
+
+	return &parameters, nil
+}
+
+// ListOf makes parameter with List<Struct<...>> value from slice of structs for batch
+// queries such as `UPSERT INTO t SELECT * FROM AS_TABLE($rows)`
+//
+// Struct members are taken from exported fields of T same as in FromStruct.
+// Empty slice makes empty list of structs with members inferred from T
+func ListOf[T any](name string, rows []T) (*Parameters, error) {
+	t, err := types.FromGoType(reflect.TypeOf(rows))
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	if _, isStruct := t.(*types.List).ItemType().(*types.Struct); !isStruct { //nolint:forcetypeassert
+		return nil, xerrors.WithStackTrace(fmt.Errorf("%w: %s", errNotAStruct, reflect.TypeOf(rows).Elem()))
+	}
+
+	v, err := toValue(t, reflect.ValueOf(rows))
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return &Parameters{Named(paramName(name), v)}, nil
+}
+
+// toValue makes value of YDB type t (inferred by types.FromGoType) from go value v
+//
+//nolint:gocyclo,funlen
+func toValue(t types.Type, v reflect.Value) (value.Value, error) {
+	if optional, isOptional := t.(types.Optional); isOptional {
+		if v.IsNil() {
+			return value.NullValue(optional.InnerType()), nil
+		}
+		inner, err := toValue(optional.InnerType(), v.Elem())
+		if err != nil {
+			return nil, err
+		}
+
+		return value.OptionalValue(inner), nil
+	}
+
+	switch v.Type() {
+	case typeOfTime:
+		return value.TimestampValueFromTime(v.Interface().(time.Time)), nil //nolint:forcetypeassert
+	case typeOfDuration:
+		return value.IntervalValueFromDuration(time.Duration(v.Int())), nil
+	case typeOfUUID:
+		return value.UUIDValue(v.Interface().(uuid.UUID)), nil //nolint:forcetypeassert
+	case typeOfDecimal:
+		d := v.Interface().(decimal.Decimal) //nolint:forcetypeassert
+		dt := t.(*types.Decimal)             //nolint:forcetypeassert
+		if d.Precision != 0 && (d.Precision != dt.Precision() || d.Scale != dt.Scale()) {
+			return nil, xerrors.WithStackTrace(fmt.Errorf(
+				"decimal value with precision %d and scale %d not matches type %s",
+				d.Precision, d.Scale, dt.Yql(),
+			))
+		}
+
+		return value.DecimalValue(d.Bytes, dt.Precision(), dt.Scale()), nil
+	case typeOfBytes:
+		return value.BytesValue(v.Bytes()), nil
+	}
+
+	switch v.Kind() {
+	case reflect.Bool:
+		return value.BoolValue(v.Bool()), nil
+	case reflect.Int8:
+		return value.Int8Value(int8(v.Int())), nil
+	case reflect.Uint8:
+		return value.Uint8Value(uint8(v.Uint())), nil
+	case reflect.Int16:
+		return value.Int16Value(int16(v.Int())), nil
+	case reflect.Uint16:
+		return value.Uint16Value(uint16(v.Uint())), nil
+	case reflect.Int32, reflect.Int:
+		return value.Int32Value(int32(v.Int())), nil
+	case reflect.Uint32, reflect.Uint:
+		return value.Uint32Value(uint32(v.Uint())), nil
+	case reflect.Int64:
+		return value.Int64Value(v.Int()), nil
+	case reflect.Uint64:
+		return value.Uint64Value(v.Uint()), nil
+	case reflect.Float32:
+		return value.FloatValue(float32(v.Float())), nil
+	case reflect.Float64:
+		return value.DoubleValue(v.Float()), nil
+	case reflect.String:
+		return value.TextValue(v.String()), nil
+	case reflect.Array, reflect.Slice:
+		if t == types.UUID {
+			var uuid [16]byte
+			reflect.Copy(reflect.ValueOf(&uuid).Elem(), v)
+
+			return value.UUIDValue(uuid), nil
+		}
+		if v.Len() == 0 {
+			return value.ZeroValue(t), nil
+		}
+		itemType := t.(*types.List).ItemType() //nolint:forcetypeassert
+		items := make([]value.Value, v.Len())
+		for i := range items {
+			item, err := toValue(itemType, v.Index(i))
+			if err != nil {
+				return nil, fmt.Errorf("item %d: %w", i, err)
+			}
+			items[i] = item
+		}
+
+		return value.ListValue(items...), nil
+	case reflect.Map:
+		dictType := t.(*types.Dict) //nolint:forcetypeassert
+		if v.Len() == 0 {
+			return value.ZeroValue(t), nil
+		}
+		fields := make([]value.DictValueField, 0, v.Len())
+		for it := v.MapRange(); it.Next(); {
+			k, err := toValue(dictType.KeyType(), it.Key())
+			if err != nil {
+				return nil, err
+			}
+			vv, err := toValue(dictType.ValueType(), it.Value())
+			if err != nil {
+				return nil, err
+			}
+			fields = append(fields, value.DictValueField{K: k, V: vv})
+		}
+
+		return value.DictValue(fields...), nil
+	case reflect.Struct:
+		fields, err := structFields(v.Type())
+		if err != nil {
+			return nil, err
+		}
+		members := make([]value.StructValueField, len(fields))
+		for i, f := range fields {
+			fv, err := toValue(f.t, v.Field(f.index))
+			if err != nil {
+				return nil, fmt.Errorf("struct field '%s': %w", f.name, err)
+			}
+			members[i] = value.StructValueField{Name: f.name, V: fv}
+		}
+
+		return value.StructValue(members...), nil
+	default:
+		return nil, xerrors.WithStackTrace(fmt.Errorf("%w: %s", types.ErrUnsupportedGoType, v.Type()))
+	}
+}
@@ -0,0 +1,103 @@
+package params
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/decimal"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+)
+
+type reflectAddress struct {
+	City string `sql:"city"`
+	Zip  *int32 `sql:"zip"`
+}
+
+type reflectUser struct {
+	ID       uint64            `sql:"id"`
+	Name     string            `sql:"name"`
+	Email    *string           `sql:"email"`
+	Created  time.Time         `sql:"created"`
+	Key      uuid.UUID         `sql:"key"`
+	Balance  decimal.Decimal   `sql:"balance"`
+	Tags     []string          `sql:"tags"`
+	Labels   map[string]uint32 `sql:"labels"`
+	Address  reflectAddress    `sql:"address"`
+	Ignored  string            `sql:"-"`
+	internal string
+}
+
+func TestFromStruct(t *testing.T) {
+	created := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	key := uuid.MustParse("00112233-4455-6677-8899-aabbccddeeff")
+	params, err := FromStruct(&reflectUser{
+		ID:      1,
+		Name:    "test",
+		Created: created,
+		Key:     key,
+		Balance: decimal.Decimal{Bytes: [16]byte{15: 1}},
+		Tags:    []string{"a", "b"},
+		Address: reflectAddress{City: "Moscow"},
+	})
+	require.NoError(t, err)
+
+	values := make(map[string]value.Value)
+	params.Each(func(name string, v value.Value) {
+		values[name] = v
+	})
+	require.Len(t, values, 9)
+	require.Equal(t, value.Uint64Value(1), values["$id"])
+	require.Equal(t, value.TextValue("test"), values["$name"])
+	require.Equal(t, value.NullValue(types.Text), values["$email"])
+	require.Equal(t, value.TimestampValueFromTime(created), values["$created"])
+	require.Equal(t, value.UUIDValue(key), values["$key"])
+	require.Equal(t, value.DecimalValue([16]byte{15: 1}, 22, 9), values["$balance"])
+	require.Equal(t, value.ListValue(value.TextValue("a"), value.TextValue("b")), values["$tags"])
+	require.Equal(t, "Dict<Utf8,Uint32>", values["$labels"].Type().Yql())
+	require.Equal(t, value.StructValue(
+		value.StructValueField{Name: "city", V: value.TextValue("Moscow")},
+		value.StructValueField{Name: "zip", V: value.NullValue(types.Int32)},
+	), values["$address"])
+
+	_, err = FromStruct(1)
+	require.ErrorIs(t, err, errNotAStruct)
+
+	_, err = FromStruct(struct{ F func() }{})
+	require.ErrorIs(t, err, types.ErrUnsupportedGoType)
+
+	_, err = FromStruct(struct{ D decimal.Decimal }{D: decimal.Decimal{Precision: 10, Scale: 2}})
+	require.Error(t, err)
+}
+
+func TestListOf(t *testing.T) {
+	type row struct {
+		ID   uint64  `sql:"id"`
+		Name *string `sql:"name"`
+	}
+	name := "test"
+	params, err := ListOf("rows", []row{{ID: 1, Name: &name}, {ID: 2}})
+	require.NoError(t, err)
+	require.Equal(t, 1, params.Count())
+	require.Equal(t, "$rows", (*params)[0].Name())
+	require.Equal(t, value.ListValue(
+		value.StructValue(
+			value.StructValueField{Name: "id", V: value.Uint64Value(1)},
+			value.StructValueField{Name: "name", V: value.OptionalValue(value.TextValue("test"))},
+		),
+		value.StructValue(
+			value.StructValueField{Name: "id", V: value.Uint64Value(2)},
+			value.StructValueField{Name: "name", V: value.NullValue(types.Text)},
+		),
+	), (*params)[0].Value())
+
+	params, err = ListOf[row]("$rows", nil)
+	require.NoError(t, err)
+	require.Equal(t, "List<Struct<'id':Uint64,'name':Optional<Utf8>>>", (*params)[0].Value().Type().Yql())
+
+	_, err = ListOf("$ids", []uint64{1, 2})
+	require.ErrorIs(t, err, errNotAStruct)
+}
@@ -0,0 +1,258 @@
+package cache
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+const (
+	DefaultTTL          = time.Minute
+	DefaultMaxBytes     = 64 << 20
+	DefaultFetchTimeout = time.Minute
+)
+
+type (
+	// Cache is an in-memory cache of query results with TTL, limit of total size, LRU eviction and
+	// deduplication of concurrent fetches of same key
+	Cache struct {
+		ttl          time.Duration
+		maxBytes     int
+		fetchTimeout time.Duration
+		clock        clockwork.Clock
+
+		group singleflight.Group
+
+		mu         sync.Mutex
+		items      map[string]*list.Element
+		lru        *list.List
+		bytes      int
+		generation uint64
+		stats      Stats
+	}
+	entry struct {
+		key       string
+		value     interface{}
+		size      int
+		tables    []string
+		expiresAt time.Time
+	}
+	// Stats contains counters of cache usage
+	Stats struct {
+		Hits      uint64
+		Misses    uint64
+		Evictions uint64
+		Items     int
+		Bytes     int
+	}
+	Option func(c *Cache)
+)
+
+// WithTTL defines time to live of cached results
+func WithTTL(ttl time.Duration) Option {
+	return func(c *Cache) {
+		if ttl > 0 {
+			c.ttl = ttl
+		}
+	}
+}
+
+// WithMaxBytes defines upper bound of total size of cached results
+func WithMaxBytes(maxBytes int) Option {
+	return func(c *Cache) {
+		if maxBytes > 0 {
+			c.maxBytes = maxBytes
+		}
+	}
+}
+
+// WithFetchTimeout defines timeout of shared fetch of value. Shared fetch is not bound to context of
+// any caller, so it completes (and caches value) even if callers canceled waiting
+func WithFetchTimeout(timeout time.Duration) Option {
+	return func(c *Cache) {
+		if timeout > 0 {
+			c.fetchTimeout = timeout
+		}
+	}
+}
+
+func withClock(clock clockwork.Clock) Option {
+	return func(c *Cache) {
+		c.clock = clock
+	}
+}
+
+func New(opts ...Option) *Cache {
+	c := &Cache{
+		ttl:          DefaultTTL,
+		maxBytes:     DefaultMaxBytes,
+		fetchTimeout: DefaultFetchTimeout,
+		clock:        clockwork.NewRealClock(),
+		items:        make(map[string]*list.Element),
+		lru:          list.New(),
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(c)
+		}
+	}
+
+	return c
+}
+
+// Get returns cached value by key if value exists and not expired
+func (c *Cache) Get(key string) (value interface{}, ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, has := c.items[key]
+	if !has {
+		c.stats.Misses++
+
+		return nil, false
+	}
+	e := el.Value.(*entry) //nolint:forcetypeassert
+	if !c.clock.Now().Before(e.expiresAt) {
+		c.remove(el)
+		c.stats.Misses++
+
+		return nil, false
+	}
+	c.lru.MoveToFront(el)
+	c.stats.Hits++
+
+	return e.value, true
+}
+
+// Do returns cached value by key or fetches value. Concurrent calls with same key waits for
+// single fetch. Fetched value caches with tables for invalidation.
+// Shared fetch runs with values of ctx and own timeout (see WithFetchTimeout): cancellation of ctx
+// stops waiting of caller only, other callers still receive fetched value
+func (c *Cache) Do(
+	ctx context.Context, key string, tables []string,
+	fetch func(ctx context.Context) (value interface{}, size int, _ error),
+) (interface{}, error) {
+	if value, ok := c.Get(key); ok {
+		return value, nil
+	}
+
+	ch := c.group.DoChan(key, func() (interface{}, error) {
+		c.mu.Lock()
+		generation := c.generation
+		c.mu.Unlock()
+
+		fetchCtx, cancel := xcontext.WithTimeout(xcontext.ValueOnly(ctx), c.fetchTimeout)
+		defer cancel()
+
+		value, size, err := fetch(fetchCtx)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		c.mu.Lock()
+		defer c.mu.Unlock()
+
+		// results fetched concurrently with invalidation may be stale
+		if generation == c.generation {
+			c.put(key, value, size, tables)
+		}
+
+		return value, nil
+	})
+
+	select {
+	case <-ctx.Done():
+		return nil, xerrors.WithStackTrace(ctx.Err())
+	case res := <-ch:
+		if res.Err != nil {
+			return nil, xerrors.WithStackTrace(res.Err)
+		}
+
+		return res.Val, nil
+	}
+}
+
+// put stores value into cache. Must be called under lock
+func (c *Cache) put(key string, value interface{}, size int, tables []string) {
+	if size > c.maxBytes {
+		return
+	}
+	if el, has := c.items[key]; has {
+		c.remove(el)
+	}
+	c.items[key] = c.lru.PushFront(&entry{
+		key:       key,
+		value:     value,
+		size:      size,
+		tables:    tables,
+		expiresAt: c.clock.Now().Add(c.ttl),
+	})
+	c.bytes += size
+	for c.bytes > c.maxBytes {
+		c.remove(c.lru.Back())
+		c.stats.Evictions++
+	}
+}
+
+// remove deletes element from cache. Must be called under lock
+func (c *Cache) remove(el *list.Element) {
+	e := el.Value.(*entry) //nolint:forcetypeassert
+	c.lru.Remove(el)
+	delete(c.items, e.key)
+	c.bytes -= e.size
+}
+
+// Invalidate removes cached results which depend on tables. All cached results removes if tables is empty
+func (c *Cache) Invalidate(tables ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.generation++
+
+	if len(tables) == 0 {
+		c.items = make(map[string]*list.Element)
+		c.lru.Init()
+		c.bytes = 0
+
+		return
+	}
+
+	for el := c.lru.Front(); el != nil; {
+		next := el.Next()
+		if dependsOn(el.Value.(*entry).tables, tables) { //nolint:forcetypeassert
+			c.remove(el)
+		}
+		el = next
+	}
+}
+
+func dependsOn(entryTables, tables []string) bool {
+	for _, t := range tables {
+		for _, et := range entryTables {
+			if et == t {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// Stats returns counters of cache usage
+func (c *Cache) Stats() Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	stats := c.stats
+	stats.Items = len(c.items)
+	stats.Bytes = c.bytes
+
+	return stats
+}
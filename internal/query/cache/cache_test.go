@@ -0,0 +1,208 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+)
+
+func value(v interface{}, size int) func(ctx context.Context) (interface{}, int, error) {
+	return func(ctx context.Context) (interface{}, int, error) {
+		return v, size, nil
+	}
+}
+
+func TestCacheTTL(t *testing.T) {
+	ctx := xtest.Context(t)
+	clock := clockwork.NewFakeClock()
+	c := New(WithTTL(time.Second), withClock(clock))
+
+	v, err := c.Do(ctx, "a", nil, value(1, 1))
+	require.NoError(t, err)
+	require.Equal(t, 1, v)
+
+	v, err = c.Do(ctx, "a", nil, value(2, 1))
+	require.NoError(t, err)
+	require.Equal(t, 1, v)
+
+	clock.Advance(time.Second)
+	v, err = c.Do(ctx, "a", nil, value(3, 1))
+	require.NoError(t, err)
+	require.Equal(t, 3, v)
+
+	require.Equal(t, Stats{Hits: 1, Misses: 2, Items: 1, Bytes: 1}, c.Stats())
+}
+
+func TestCacheLRU(t *testing.T) {
+	ctx := xtest.Context(t)
+	c := New(WithMaxBytes(10))
+
+	for _, key := range []string{"a", "b"} {
+		_, err := c.Do(ctx, key, nil, value(key, 4))
+		require.NoError(t, err)
+	}
+	_, ok := c.Get("a")
+	require.True(t, ok)
+
+	_, err := c.Do(ctx, "c", nil, value("c", 4))
+	require.NoError(t, err)
+	_, ok = c.Get("b")
+	require.False(t, ok, "least recently used evicted")
+	_, ok = c.Get("a")
+	require.True(t, ok)
+
+	_, err = c.Do(ctx, "d", nil, value("d", 11))
+	require.NoError(t, err)
+	_, ok = c.Get("d")
+	require.False(t, ok, "too large value not cached")
+
+	stats := c.Stats()
+	require.Equal(t, uint64(1), stats.Evictions)
+	require.Equal(t, 2, stats.Items)
+	require.Equal(t, 8, stats.Bytes)
+}
+
+func TestCacheSingleflight(t *testing.T) {
+	ctx := xtest.Context(t)
+	c := New()
+
+	var (
+		fetches int32
+		start   = make(chan struct{})
+		wg      sync.WaitGroup
+	)
+	fetch := func(ctx context.Context) (interface{}, int, error) {
+		atomic.AddInt32(&fetches, 1)
+		<-start
+
+		return 1, 1, nil
+	}
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			v, err := c.Do(ctx, "a", nil, fetch)
+			require.NoError(t, err)
+			require.Equal(t, 1, v)
+		}()
+	}
+	xtest.SpinWaitCondition(t, nil, func() bool {
+		return atomic.LoadInt32(&fetches) == 1
+	})
+	time.Sleep(10 * time.Millisecond)
+	close(start)
+	wg.Wait()
+	require.Equal(t, int32(1), atomic.LoadInt32(&fetches))
+}
+
+func TestCacheFetchNotCanceledByFirstCaller(t *testing.T) {
+	ctx := xtest.Context(t)
+	c := New()
+
+	var (
+		fetched = make(chan struct{})
+		start   = make(chan struct{})
+		waiter  = make(chan error, 1)
+	)
+	firstCtx, cancelFirst := context.WithCancel(ctx)
+	fetch := func(ctx context.Context) (interface{}, int, error) {
+		close(fetched)
+		select {
+		case <-ctx.Done():
+			return nil, 0, ctx.Err()
+		case <-start:
+			return 1, 1, nil
+		}
+	}
+	go func() {
+		_, err := c.Do(firstCtx, "a", nil, fetch)
+		waiter <- err
+	}()
+	<-fetched
+
+	second := make(chan interface{}, 1)
+	go func() {
+		v, err := c.Do(ctx, "a", nil, fetch)
+		require.NoError(t, err)
+		second <- v
+	}()
+
+	cancelFirst()
+	require.ErrorIs(t, <-waiter, context.Canceled)
+
+	close(start)
+	require.Equal(t, 1, <-second)
+
+	v, ok := c.Get("a")
+	require.True(t, ok)
+	require.Equal(t, 1, v)
+}
+
+func TestCacheFetchTimeout(t *testing.T) {
+	ctx := xtest.Context(t)
+	c := New(WithFetchTimeout(time.Millisecond))
+
+	_, err := c.Do(ctx, "a", nil, func(ctx context.Context) (interface{}, int, error) {
+		<-ctx.Done()
+
+		return nil, 0, ctx.Err()
+	})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestCacheFetchError(t *testing.T) {
+	ctx := xtest.Context(t)
+	c := New()
+	errFetch := errors.New("fetch")
+
+	_, err := c.Do(ctx, "a", nil, func(ctx context.Context) (interface{}, int, error) {
+		return nil, 0, errFetch
+	})
+	require.ErrorIs(t, err, errFetch)
+	require.Zero(t, c.Stats().Items)
+}
+
+func TestCacheInvalidate(t *testing.T) {
+	ctx := xtest.Context(t)
+	c := New()
+
+	for key, tables := range map[string][]string{
+		"a":  {"t1"},
+		"b":  {"t2"},
+		"ab": {"t1", "t2"},
+	} {
+		_, err := c.Do(ctx, key, tables, value(key, 1))
+		require.NoError(t, err)
+	}
+
+	c.Invalidate("t1")
+	_, ok := c.Get("a")
+	require.False(t, ok)
+	_, ok = c.Get("ab")
+	require.False(t, ok)
+	_, ok = c.Get("b")
+	require.True(t, ok)
+
+	c.Invalidate()
+	require.Zero(t, c.Stats().Items)
+	require.Zero(t, c.Stats().Bytes)
+
+	t.Run("ConcurrentFetch", func(t *testing.T) {
+		_, err := c.Do(ctx, "a", []string{"t1"}, func(ctx context.Context) (interface{}, int, error) {
+			c.Invalidate("t1")
+
+			return "stale", 1, nil
+		})
+		require.NoError(t, err)
+		_, ok := c.Get("a")
+		require.False(t, ok, "result fetched concurrently with invalidation not cached")
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Operation_V1"
 	"github.com/ydb-platform/ydb-go-genproto/Ydb_Query_V1"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Operations"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Query"
@@ -11,6 +12,9 @@ import (
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/closer"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/conn"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/fingerprint"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/interceptor"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/operation"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/pool"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/config"
@@ -43,9 +47,10 @@ type (
 		createSession func(ctx context.Context) (*Session, error)
 	}
 	Client struct {
-		config             *config.Config
-		queryServiceClient Ydb_Query_V1.QueryServiceClient
-		pool               sessionPool
+		config                 *config.Config
+		queryServiceClient     Ydb_Query_V1.QueryServiceClient
+		operationServiceClient Ydb_Operation_V1.OperationServiceClient
+		pool                   sessionPool
 
 		done chan struct{}
 	}
@@ -112,7 +117,7 @@ func (c *Client) FetchScriptResults(ctx context.Context,
 		}
 
 		return r, nil
-	}, retry.WithIdempotent(true))
+	}, retry.WithIdempotent(true), retry.WithPolicy(c.config.RetryPolicy()))
 	if err != nil {
 		return nil, xerrors.WithStackTrace(err)
 	}
@@ -135,7 +140,8 @@ func (s *executeScriptSettings) ResultsTTL() time.Duration {
 }
 
 func executeScript(ctx context.Context,
-	client Ydb_Query_V1.QueryServiceClient, request *Ydb_Query.ExecuteScriptRequest, grpcOpts ...grpc.CallOption,
+	client Ydb_Query_V1.QueryServiceClient, request *Ydb_Query.ExecuteScriptRequest, policy *retry.Policy,
+	grpcOpts ...grpc.CallOption,
 ) (*options.ExecuteScriptOperation, error) {
 	op, err := retry.RetryWithResult(ctx, func(ctx context.Context) (*options.ExecuteScriptOperation, error) {
 		response, err := client.ExecuteScript(ctx, request, grpcOpts...)
@@ -148,7 +154,7 @@ func executeScript(ctx context.Context,
 			ConsumedUnits: response.GetCostInfo().GetConsumedUnits(),
 			Metadata:      options.ToMetadataExecuteQuery(response.GetMetadata()),
 		}, nil
-	}, retry.WithIdempotent(true))
+	}, retry.WithIdempotent(true), retry.WithPolicy(policy))
 	if err != nil {
 		return op, xerrors.WithStackTrace(err)
 	}
@@ -177,7 +183,7 @@ func (c *Client) ExecuteScript(
 
 	request, grpcOpts := executeQueryScriptRequest(a, q, settings)
 
-	op, err = executeScript(ctx, c.queryServiceClient, request, grpcOpts...)
+	op, err = executeScript(ctx, c.queryServiceClient, request, c.config.RetryPolicy(), grpcOpts...)
 	if err != nil {
 		return op, xerrors.WithStackTrace(err)
 	}
@@ -243,7 +249,11 @@ func do(
 
 		err := op(ctx, s)
 		if err != nil {
-			if xerrors.IsOperationError(err) {
+			if mustDelete, ok := retry.PolicyFromOptions(opts...).MustDeleteSession(err); ok {
+				if mustDelete {
+					s.setStatus(statusClosed)
+				}
+			} else if xerrors.IsOperationError(err) {
 				s.setStatus(statusClosed)
 			}
 
@@ -279,7 +289,9 @@ func (c *Client) Do(ctx context.Context, op query.Operation, opts ...options.DoO
 		attempts++
 
 		return op(ctx, s)
-	}, options.ParseDoOpts(c.config.Trace(), opts...).RetryOpts()...)
+	}, options.ParseDoOpts(c.config.Trace(),
+		append([]options.DoOption{options.WithRetryPolicy(c.config.RetryPolicy())}, opts...)...,
+	).RetryOpts()...)
 
 	return err
 }
@@ -351,13 +363,30 @@ func (c *Client) QueryRow(ctx context.Context, q string, opts ...options.Execute
 
 	onDone := trace.QueryOnQueryRow(c.config.Trace(), &ctx,
 		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/v3/internal/query.(*Client).QueryRow"),
-		q,
+		q, fingerprint.Stringer(q),
 	)
 	defer func() {
 		onDone(finalErr)
 	}()
 
-	row, err := clientQueryRow(ctx, c.pool, q, options.ExecuteSettings(opts...), withTrace(c.config.Trace()))
+	settings := options.ExecuteSettings(opts...)
+	if err := checkRowsFormat(settings); err != nil {
+		return nil, err
+	}
+	if tables, ok := settings.ResultCache(); c.resultCacheEnabled(ctx, ok) {
+		row, err := cachedQueryRow(ctx, c.config.ResultCache(), q, settings, tables,
+			func(ctx context.Context) (query.Row, error) {
+				return clientQueryRow(ctx, c.pool, q, settings, withTrace(c.config.Trace()))
+			},
+		)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		return row, nil
+	}
+
+	row, err := clientQueryRow(ctx, c.pool, q, settings, withTrace(c.config.Trace()))
 	if err != nil {
 		return nil, xerrors.WithStackTrace(err)
 	}
@@ -365,10 +394,16 @@ func (c *Client) QueryRow(ctx context.Context, q string, opts ...options.Execute
 	return row, nil
 }
 
+// resultCacheEnabled reports whether result of query requested from result cache. Intercepted
+// queries bypass result cache, so interceptors see (and may rewrite or deny) every call
+func (c *Client) resultCacheEnabled(ctx context.Context, requested bool) bool {
+	return requested && c.config.ResultCache() != nil && !interceptor.Enabled(ctx, c.config.Interceptor())
+}
+
 func clientExec(ctx context.Context, pool sessionPool, q string, opts ...options.Execute) (finalErr error) {
 	settings := options.ExecuteSettings(opts...)
 	err := do(ctx, pool, func(ctx context.Context, s *Session) (err error) {
-		_, r, err := execute(ctx, s.id, s.queryServiceClient, q, settings, withTrace(s.cfg.Trace()))
+		_, r, err := s.execute(ctx, q, settings, withTrace(s.cfg.Trace()))
 		if err != nil {
 			return xerrors.WithStackTrace(err)
 		}
@@ -393,7 +428,7 @@ func (c *Client) Exec(ctx context.Context, q string, opts ...options.Execute) (f
 
 	onDone := trace.QueryOnExec(c.config.Trace(), &ctx,
 		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/v3/internal/query.(*Client).Exec"),
-		q,
+		q, fingerprint.Stringer(q),
 	)
 	defer func() {
 		onDone(finalErr)
@@ -412,7 +447,7 @@ func clientQuery(ctx context.Context, pool sessionPool, q string, opts ...option
 ) {
 	settings := options.ExecuteSettings(opts...)
 	err = do(ctx, pool, func(ctx context.Context, s *Session) (err error) {
-		_, streamResult, err := execute(ctx, s.id, s.queryServiceClient, q,
+		_, streamResult, err := s.execute(ctx, q,
 			options.ExecuteSettings(opts...), withTrace(s.cfg.Trace()),
 		)
 		if err != nil {
@@ -446,12 +481,29 @@ func (c *Client) Query(ctx context.Context, q string, opts ...options.Execute) (
 
 	onDone := trace.QueryOnQuery(c.config.Trace(), &ctx,
 		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/v3/internal/query.(*Client).Query"),
-		q,
+		q, fingerprint.Stringer(q),
 	)
 	defer func() {
 		onDone(err)
 	}()
 
+	settings := options.ExecuteSettings(opts...)
+	if err := checkRowsFormat(settings); err != nil {
+		return nil, err
+	}
+	if tables, ok := settings.ResultCache(); c.resultCacheEnabled(ctx, ok) {
+		r, err = cachedQuery(ctx, c.config.ResultCache(), q, settings, tables,
+			func(ctx context.Context) (query.Result, error) {
+				return clientQuery(ctx, c.pool, q, opts...)
+			},
+		)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		return r, nil
+	}
+
 	r, err = clientQuery(ctx, c.pool, q, opts...)
 	if err != nil {
 		return nil, xerrors.WithStackTrace(err)
@@ -464,7 +516,7 @@ func clientQueryResultSet(
 	ctx context.Context, pool sessionPool, q string, settings executeSettings, resultOpts ...resultOption,
 ) (rs query.ResultSet, finalErr error) {
 	err := do(ctx, pool, func(ctx context.Context, s *Session) error {
-		_, r, err := execute(ctx, s.id, s.queryServiceClient, q, settings, resultOpts...)
+		_, r, err := s.execute(ctx, q, settings, resultOpts...)
 		if err != nil {
 			return xerrors.WithStackTrace(err)
 		}
@@ -492,13 +544,30 @@ func (c *Client) QueryResultSet(
 
 	onDone := trace.QueryOnQueryResultSet(c.config.Trace(), &ctx,
 		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/v3/internal/query.(*Client).QueryResultSet"),
-		q,
+		q, fingerprint.Stringer(q),
 	)
 	defer func() {
 		onDone(finalErr)
 	}()
 
-	rs, err := clientQueryResultSet(ctx, c.pool, q, options.ExecuteSettings(opts...), withTrace(c.config.Trace()))
+	settings := options.ExecuteSettings(opts...)
+	if err := checkRowsFormat(settings); err != nil {
+		return nil, err
+	}
+	if tables, ok := settings.ResultCache(); c.resultCacheEnabled(ctx, ok) {
+		rs, err := cachedQueryResultSet(ctx, c.config.ResultCache(), q, settings, tables,
+			func(ctx context.Context) (query.ResultSet, error) {
+				return clientQueryResultSet(ctx, c.pool, q, settings, withTrace(c.config.Trace()))
+			},
+		)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		return rs, nil
+	}
+
+	rs, err := clientQueryResultSet(ctx, c.pool, q, settings, withTrace(c.config.Trace()))
 	if err != nil {
 		return nil, xerrors.WithStackTrace(err)
 	}
@@ -524,7 +593,7 @@ func (c *Client) DoTx(ctx context.Context, op query.TxOperation, opts ...options
 		attempts++
 
 		return op(ctx, tx)
-	}, c.config.Trace(), opts...)
+	}, c.config.Trace(), append([]options.DoTxOption{options.WithRetryPolicy(c.config.RetryPolicy())}, opts...)...)
 	if err != nil {
 		return xerrors.WithStackTrace(err)
 	}
@@ -561,7 +630,10 @@ func New(ctx context.Context, balancer grpc.ClientConnInterface, cfg *config.Con
 	client := &Client{
 		config:             cfg,
 		queryServiceClient: grpcClient,
-		done:               make(chan struct{}),
+		operationServiceClient: Ydb_Operation_V1.NewOperationServiceClient(
+			conn.WithContextModifier(balancer, conn.WithoutWrapping),
+		),
+		done: make(chan struct{}),
 		pool: newPool(ctx, cfg, func(ctx context.Context) (_ *Session, err error) {
 			var (
 				createCtx    context.Context
@@ -18,11 +18,13 @@ import (
 	"google.golang.org/protobuf/types/known/anypb"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/pool"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/cache"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/config"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/options"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
 	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
@@ -168,6 +170,48 @@ func TestClient(t *testing.T) {
 			require.NoError(t, err)
 			require.Equal(t, 10, counter)
 		})
+		t.Run("SessionInvalidationByRetryPolicy", func(t *testing.T) {
+			deleteSession, keepSession := true, false
+			policy := &retry.Policy{
+				Statuses: map[string]retry.PolicyRule{
+					"OVERLOADED":      {Retry: false, DeleteSession: &deleteSession},
+					"SESSION_EXPIRED": {Retry: false, DeleteSession: &keepSession},
+					"BAD_SESSION":     {Retry: false},
+				},
+			}
+			for _, tt := range []struct {
+				name   string
+				status Ydb.StatusIds_StatusCode
+				closed bool
+			}{
+				{
+					name:   "DeleteByPolicy",
+					status: Ydb.StatusIds_OVERLOADED,
+					closed: true,
+				},
+				{
+					name:   "KeepByPolicy",
+					status: Ydb.StatusIds_SESSION_EXPIRED,
+					closed: false,
+				},
+				{
+					name:   "DefaultRulesWithoutPolicyDeleteSession",
+					status: Ydb.StatusIds_BAD_SESSION,
+					closed: true,
+				},
+			} {
+				t.Run(tt.name, func(t *testing.T) {
+					s := newTestSession("123")
+					err := do(ctx, testPool(ctx, func(ctx context.Context) (*Session, error) {
+						return s, nil
+					}), func(ctx context.Context, s *Session) error {
+						return xerrors.Operation(xerrors.WithStatusCode(tt.status))
+					}, retry.WithPolicy(policy))
+					require.Error(t, err)
+					require.Equal(t, tt.closed, s.status() == statusClosed)
+				})
+			}
+		})
 	})
 	t.Run("DoTx", func(t *testing.T) {
 		t.Run("HappyWay", func(t *testing.T) {
@@ -1258,7 +1302,7 @@ func TestQueryScript(t *testing.T) {
 			},
 			NextFetchToken: "456",
 		}, nil)
-		op, err := executeScript(ctx, service, &Ydb_Query.ExecuteScriptRequest{})
+		op, err := executeScript(ctx, service, &Ydb_Query.ExecuteScriptRequest{}, nil)
 		require.NoError(t, err)
 		require.EqualValues(t, "123", op.ID)
 		r, err := fetchScriptResults(ctx, service, op.ID)
@@ -1284,3 +1328,41 @@ func TestQueryScript(t *testing.T) {
 		})
 	})
 }
+
+func TestArrowFormatRowsHelpers(t *testing.T) {
+	ctx := xtest.Context(t)
+	ctrl := gomock.NewController(t)
+
+	// no calls of query service expected: helpers reject Arrow format before execute
+	s := newTestSessionWithClient("123", NewMockQueryServiceClient(ctrl))
+	c := &Client{
+		config: config.New(config.WithResultCache(cache.New())),
+		pool: testPool(ctx, func(ctx context.Context) (*Session, error) {
+			return s, nil
+		}),
+		done: make(chan struct{}),
+	}
+	arrowFormat := options.WithResultSetFormat(options.ResultSetFormatArrow)
+
+	t.Run("Client", func(t *testing.T) {
+		_, err := c.Query(ctx, "SELECT 1", arrowFormat)
+		require.ErrorIs(t, err, errArrowFormatRowsHelper)
+		_, err = c.QueryResultSet(ctx, "SELECT 1", arrowFormat, options.WithResultCache("t"))
+		require.ErrorIs(t, err, errArrowFormatRowsHelper)
+		_, err = c.QueryRow(ctx, "SELECT 1", arrowFormat)
+		require.ErrorIs(t, err, errArrowFormatRowsHelper)
+	})
+	t.Run("Session", func(t *testing.T) {
+		_, err := s.QueryResultSet(ctx, "SELECT 1", arrowFormat)
+		require.ErrorIs(t, err, errArrowFormatRowsHelper)
+		_, err = s.QueryRow(ctx, "SELECT 1", arrowFormat)
+		require.ErrorIs(t, err, errArrowFormatRowsHelper)
+	})
+	t.Run("Transaction", func(t *testing.T) {
+		tx := &Transaction{s: s}
+		_, err := tx.QueryResultSet(ctx, "SELECT 1", arrowFormat)
+		require.ErrorIs(t, err, errArrowFormatRowsHelper)
+		_, err = tx.QueryRow(ctx, "SELECT 1", arrowFormat)
+		require.ErrorIs(t, err, errArrowFormatRowsHelper)
+	})
+}
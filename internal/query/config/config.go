@@ -4,8 +4,10 @@ import (
 	"os"
 	"time"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/interceptors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/config"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/pool"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/cache"
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
@@ -24,7 +26,11 @@ type Config struct {
 	sessionCreateTimeout time.Duration
 	sessionDeleteTimeout time.Duration
 
+	resultCache *cache.Cache
+
 	trace *trace.Query
+
+	interceptor interceptors.Interceptor
 }
 
 func New(opts ...Option) *Config {
@@ -53,6 +59,11 @@ func (c *Config) Trace() *trace.Query {
 	return c.trace
 }
 
+// Interceptor returns merged interceptors of queries or nil if interceptors not defined
+func (c *Config) Interceptor() interceptors.Interceptor {
+	return c.interceptor
+}
+
 // PoolLimit is an upper bound of pooled sessions.
 // If PoolLimit is less than or equal to zero then the
 // DefaultPoolMaxSize variable is used as a pool limit.
@@ -72,6 +83,11 @@ func (c *Config) SessionDeleteTimeout() time.Duration {
 	return c.sessionDeleteTimeout
 }
 
+// ResultCache returns cache of query results or nil if caching disabled
+func (c *Config) ResultCache() *cache.Cache {
+	return c.resultCache
+}
+
 func (c *Config) UseSessionPool() bool {
 	return c.useSessionPool
 }
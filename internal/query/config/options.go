@@ -3,7 +3,9 @@ package config
 import (
 	"time"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/interceptors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/cache"
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
@@ -23,6 +25,13 @@ func WithTrace(trace *trace.Query, opts ...trace.QueryComposeOption) Option {
 	}
 }
 
+// WithInterceptors appends interceptors of queries to early defined interceptors
+func WithInterceptors(list ...interceptors.Interceptor) Option {
+	return func(c *Config) {
+		c.interceptor = interceptors.Merge(append([]interceptors.Interceptor{c.interceptor}, list...)...)
+	}
+}
+
 // WithPoolLimit defines upper bound of pooled sessions.
 // If poolLimit is less than or equal to zero then the
 // DefaultPoolMaxSize variable is used as a poolLimit.
@@ -55,3 +64,10 @@ func WithSessionDeleteTimeout(deleteTimeout time.Duration) Option {
 		}
 	}
 }
+
+// WithResultCache defines cache of results for queries with options.WithResultCache
+func WithResultCache(c *cache.Cache) Option {
+	return func(cfg *Config) {
+		cfg.resultCache = c
+	}
+}
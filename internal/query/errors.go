@@ -17,4 +17,7 @@ var (
 	errExpectedTxID               = errors.New("expected transaction ID but nil")
 	ErrOptionNotForTxExecute      = errors.New("option is not for execute on transaction")
 	errExecuteOnCompletedTx       = errors.New("execute on completed transaction")
+	errArrowResultSet             = errors.New("rows of result set in Arrow format are not available, read Arrow batches")
+	errNotArrowResultSet          = errors.New("result set is not in Arrow format")
+	errArrowFormatRowsHelper      = errors.New("result sets in Arrow format are not supported by helpers, which read rows (QueryResultSet and QueryRow of client, session and transaction, Query of client), read Arrow batches of Query result of session or transaction") //nolint:lll
 )
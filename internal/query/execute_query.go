@@ -6,12 +6,16 @@ import (
 	"time"
 
 	"github.com/ydb-platform/ydb-go-genproto/Ydb_Query_V1"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Formats"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Operations"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Query"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/types/known/durationpb"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/interceptors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/interceptor"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/options"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/tx"
@@ -25,6 +29,7 @@ import (
 type executeSettings interface {
 	ExecMode() options.ExecMode
 	StatsMode() options.StatsMode
+	ResultSetFormat() options.ResultSetFormat
 	StatsCallback() func(stats stats.QueryStats)
 	TxControl() *query.TransactionControl
 	Syntax() options.Syntax
@@ -75,6 +80,11 @@ func executeQueryRequest(a *allocator.Allocator, sessionID, q string, cfg execut
 	request.Parameters = cfg.Params().ToYDB(a)
 	request.StatsMode = Ydb_Query.StatsMode(cfg.StatsMode())
 	request.ConcurrentResultSets = false
+	request.ResultSetFormat = Ydb.ResultSet_Format(cfg.ResultSetFormat())
+	if cfg.ResultSetFormat() == options.ResultSetFormatArrow {
+		// batches are requested without compression codec, arrow reader decodes IPC messages as is
+		request.ArrowFormatSettings = &Ydb_Formats.ArrowFormatSettings{}
+	}
 
 	return request, cfg.CallOptions()
 }
@@ -126,6 +136,54 @@ func execute(
 	return tx.ID(txID), r, nil
 }
 
+type interceptedSettings struct {
+	executeSettings
+
+	params *params.Parameters
+}
+
+func (s *interceptedSettings) Params() *params.Parameters {
+	return s.params
+}
+
+// execute calls interceptors from session config and executes intercepted query
+func (s *Session) execute(
+	ctx context.Context, q string, settings executeSettings, opts ...resultOption,
+) (
+	_ tx.Identifier, _ *streamResult, finalErr error,
+) {
+	if !interceptor.Enabled(ctx, s.cfg.Interceptor()) {
+		return execute(ctx, s.id, s.queryServiceClient, q, settings, opts...)
+	}
+
+	a := allocator.New()
+	txControl := settings.TxControl().ToYDB(a)
+	call := &interceptors.Call{
+		Client:    interceptors.QueryClient,
+		Query:     q,
+		Params:    settings.Params(),
+		TxControl: interceptor.TxControl(txControl, txControl.GetBeginTx()),
+	}
+	a.Free()
+
+	observer, err := interceptor.Intercept(ctx, s.cfg.Interceptor(), call)
+	if err != nil {
+		return nil, nil, xerrors.WithStackTrace(err)
+	}
+
+	txID, r, err := execute(ctx, s.id, s.queryServiceClient, call.Query,
+		&interceptedSettings{executeSettings: settings, params: call.Params},
+		append(opts, withObserver(observer))...,
+	)
+	if err != nil {
+		observer.Done(err)
+
+		return nil, nil, xerrors.WithStackTrace(err)
+	}
+
+	return txID, r, nil
+}
+
 func readAll(ctx context.Context, r *streamResult) error {
 	defer func() {
 		_ = r.Close(ctx)
@@ -164,6 +222,16 @@ func readResultSet(ctx context.Context, r *streamResult) (_ *resultSet, finalErr
 	return rs, nil
 }
 
+// checkRowsFormat rejects Arrow format of result sets for helpers, which read rows of result sets:
+// rows of result sets in Arrow format are not available
+func checkRowsFormat(settings executeSettings) error {
+	if settings.ResultSetFormat() == options.ResultSetFormatArrow {
+		return xerrors.WithStackTrace(errArrowFormatRowsHelper)
+	}
+
+	return nil
+}
+
 func readMaterializedResultSet(ctx context.Context, r *streamResult) (_ *materializedResultSet, finalErr error) {
 	defer func() {
 		_ = r.Close(ctx)
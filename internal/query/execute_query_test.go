@@ -2,11 +2,14 @@ package query
 
 import (
 	"context"
+	"errors"
 	"io"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Query_V1"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Formats"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Query"
 	"go.uber.org/mock/gomock"
 	"google.golang.org/grpc"
@@ -14,8 +17,10 @@ import (
 	"google.golang.org/grpc/metadata"
 	grpcStatus "google.golang.org/grpc/status"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/interceptors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/config"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/options"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
@@ -966,6 +971,26 @@ func TestExecuteQueryRequest(t *testing.T) {
 				ConcurrentResultSets: false,
 			},
 		},
+		{
+			name: "WithResultSetFormatArrow",
+			opts: []options.Execute{
+				options.WithResultSetFormat(options.ResultSetFormatArrow),
+			},
+			request: &Ydb_Query.ExecuteQueryRequest{
+				SessionId: "WithResultSetFormatArrow",
+				ExecMode:  Ydb_Query.ExecMode_EXEC_MODE_EXECUTE,
+				Query: &Ydb_Query.ExecuteQueryRequest_QueryContent{
+					QueryContent: &Ydb_Query.QueryContent{
+						Syntax: Ydb_Query.Syntax_SYNTAX_YQL_V1,
+						Text:   "WithResultSetFormatArrow",
+					},
+				},
+				StatsMode:            Ydb_Query.StatsMode_STATS_MODE_NONE,
+				ConcurrentResultSets: false,
+				ResultSetFormat:      Ydb.ResultSet_FORMAT_ARROW,
+				ArrowFormatSettings:  &Ydb_Formats.ArrowFormatSettings{},
+			},
+		},
 		{
 			name: "WithGrpcCallOptions",
 			opts: []options.Execute{
@@ -999,3 +1024,101 @@ func TestExecuteQueryRequest(t *testing.T) {
 		})
 	}
 }
+
+func TestSessionExecuteIntercepted(t *testing.T) {
+	t.Run("RewriteAndObserve", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		ctrl := gomock.NewController(t)
+		stream := NewMockQueryService_ExecuteQueryClient(ctrl)
+		stream.EXPECT().Recv().Return(&Ydb_Query.ExecuteQueryResponsePart{
+			Status: Ydb.StatusIds_SUCCESS,
+			ResultSet: &Ydb.ResultSet{
+				Columns: []*Ydb.Column{{
+					Name: "a",
+					Type: &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_UINT64}},
+				}},
+				Rows: []*Ydb.Value{
+					{Items: []*Ydb.Value{{Value: &Ydb.Value_Uint64Value{Uint64Value: 1}}}},
+					{Items: []*Ydb.Value{{Value: &Ydb.Value_Uint64Value{Uint64Value: 2}}}},
+				},
+			},
+		}, nil)
+		stream.EXPECT().Recv().Return(nil, io.EOF)
+		client := NewMockQueryServiceClient(ctrl)
+		client.EXPECT().ExecuteQuery(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, request *Ydb_Query.ExecuteQueryRequest, opts ...grpc.CallOption) (
+				Ydb_Query_V1.QueryService_ExecuteQueryClient, error,
+			) {
+				require.Equal(t, "/* tenant */ SELECT $a", request.GetQueryContent().GetText())
+				require.Contains(t, request.GetParameters(), "$tenant")
+
+				return stream, nil
+			},
+		)
+		var (
+			calls []interceptors.Call
+			rows  []interceptors.Row
+			done  []error
+		)
+		s := &Session{
+			cfg: config.New(config.WithInterceptors(
+				func(ctx context.Context, call *interceptors.Call) (*interceptors.Observer, error) {
+					calls = append(calls, *call)
+					call.Query = "/* tenant */ " + call.Query
+					call.Params = params.Builder{}.Param("$tenant").Text("a").Build()
+
+					return &interceptors.Observer{
+						OnRow: func(row interceptors.Row) {
+							rows = append(rows, row)
+						},
+						OnDone: func(err error) {
+							done = append(done, err)
+						},
+					}, nil
+				},
+			)),
+			id:                 "123",
+			queryServiceClient: client,
+		}
+		_, r, err := s.execute(ctx, "SELECT $a", options.ExecuteSettings(
+			options.WithTxControl(query.SerializableReadWriteTxControl(query.CommitTx())),
+		))
+		require.NoError(t, err)
+		require.Len(t, calls, 1)
+		require.Equal(t, interceptors.QueryClient, calls[0].Client)
+		require.Equal(t, "SELECT $a", calls[0].Query)
+		require.Equal(t, interceptors.TxControl{
+			Isolation: interceptors.SerializableReadWrite,
+			CommitTx:  true,
+		}, calls[0].TxControl)
+		rs, err := r.nextResultSet(ctx)
+		require.NoError(t, err)
+		for {
+			if _, err = rs.nextRow(ctx); err != nil {
+				break
+			}
+		}
+		require.ErrorIs(t, err, io.EOF)
+		require.NoError(t, r.Close(ctx))
+		require.Len(t, rows, 2)
+		require.Equal(t, []string{"a"}, rows[1].Columns)
+		require.Equal(t, "2ul", rows[1].Values[0].Yql())
+		require.Equal(t, []error{nil}, done)
+	})
+	t.Run("Deny", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		ctrl := gomock.NewController(t)
+		errDenied := errors.New("denied")
+		s := &Session{
+			cfg: config.New(config.WithInterceptors(
+				func(ctx context.Context, call *interceptors.Call) (*interceptors.Observer, error) {
+					return nil, errDenied
+				},
+			)),
+			id:                 "123",
+			queryServiceClient: NewMockQueryServiceClient(ctrl),
+		}
+		_, _, err := s.execute(ctx, "SELECT 1", options.ExecuteSettings())
+		require.ErrorIs(t, err, errDenied)
+	})
+}
@@ -1,6 +1,7 @@
 package options
 
 import (
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Query"
 	"google.golang.org/grpc"
 
@@ -18,12 +19,15 @@ var (
 	_ Execute = syntaxOption(0)
 	_ Execute = statsModeOption{}
 	_ Execute = execModeOption(0)
+	_ Execute = resultCacheOption(nil)
+	_ Execute = ResultSetFormat(0)
 )
 
 type (
-	Syntax    Ydb_Query.Syntax
-	ExecMode  Ydb_Query.ExecMode
-	StatsMode Ydb_Query.StatsMode
+	Syntax          Ydb_Query.Syntax
+	ExecMode        Ydb_Query.ExecMode
+	StatsMode       Ydb_Query.StatsMode
+	ResultSetFormat Ydb.ResultSet_Format
 
 	// executeSettings is a holder for execute settings
 	executeSettings struct {
@@ -35,6 +39,8 @@ type (
 		callOptions   []grpc.CallOption
 		txControl     *tx.Control
 		retryOptions  []retry.Option
+		resultCache   *resultCacheOption
+		format        ResultSetFormat
 	}
 
 	// Execute is an interface for execute method options
@@ -57,6 +63,8 @@ type (
 		callback func(stats.QueryStats)
 	}
 	execModeOption = ExecMode
+	// resultCacheOption contains tables which results of query depends on
+	resultCacheOption []string
 )
 
 func (s *executeSettings) RetryOpts() []retry.Option {
@@ -109,6 +117,15 @@ const (
 	ExecModeExecute  = ExecMode(Ydb_Query.ExecMode_EXEC_MODE_EXECUTE)
 )
 
+func (format ResultSetFormat) applyExecuteOption(s *executeSettings) {
+	s.format = format
+}
+
+const (
+	ResultSetFormatValue = ResultSetFormat(Ydb.ResultSet_FORMAT_VALUE)
+	ResultSetFormatArrow = ResultSetFormat(Ydb.ResultSet_FORMAT_ARROW)
+)
+
 const (
 	StatsModeBasic   = StatsMode(Ydb_Query.StatsMode_STATS_MODE_BASIC)
 	StatsModeNone    = StatsMode(Ydb_Query.StatsMode_STATS_MODE_NONE)
@@ -157,6 +174,10 @@ func (s *executeSettings) StatsMode() StatsMode {
 	return s.statsMode
 }
 
+func (s *executeSettings) ResultSetFormat() ResultSetFormat {
+	return s.format
+}
+
 func (s *executeSettings) Params() *params.Parameters {
 	if len(s.params) == 0 {
 		return nil
@@ -186,6 +207,10 @@ func WithExecMode(mode ExecMode) execModeOption {
 	return mode
 }
 
+func WithResultSetFormat(format ResultSetFormat) ResultSetFormat {
+	return format
+}
+
 func WithSyntax(syntax Syntax) syntaxOption {
 	return syntax
 }
@@ -206,6 +231,23 @@ func WithCallOptions(opts ...grpc.CallOption) callOptionsOption {
 	return opts
 }
 
+func (tables resultCacheOption) applyExecuteOption(s *executeSettings) {
+	s.resultCache = &tables
+}
+
+// ResultCache returns tables which results of query depends on and true if result caching requested
+func (s *executeSettings) ResultCache() (tables []string, ok bool) {
+	if s.resultCache == nil {
+		return nil, false
+	}
+
+	return *s.resultCache, true
+}
+
+func WithResultCache(tables ...string) resultCacheOption {
+	return tables
+}
+
 func WithTxControl(txControl *tx.Control) *txControlOption {
 	return (*txControlOption)(txControl)
 }
@@ -27,6 +27,15 @@ type (
 		ResultSet      result.Set
 		NextToken      string
 	}
+	ScriptProgress struct {
+		// Ready reports whether script execution completed (successfully or not)
+		Ready bool
+		// ExecStatus is a status of script execution
+		ExecStatus ExecStatus
+		// Metadata contains statistics of script execution at the moment and meta of result sets
+		Metadata *MetadataExecuteQuery
+	}
+	ExecStatus           Ydb_Query.ExecStatus
 	MetadataExecuteQuery struct {
 		ID     string
 		Script struct {
@@ -44,6 +53,19 @@ type (
 	}
 )
 
+const (
+	ExecStatusUnspecified = ExecStatus(Ydb_Query.ExecStatus_EXEC_STATUS_UNSPECIFIED)
+	ExecStatusStarting    = ExecStatus(Ydb_Query.ExecStatus_EXEC_STATUS_STARTING)
+	ExecStatusAborted     = ExecStatus(Ydb_Query.ExecStatus_EXEC_STATUS_ABORTED)
+	ExecStatusCancelled   = ExecStatus(Ydb_Query.ExecStatus_EXEC_STATUS_CANCELLED)
+	ExecStatusCompleted   = ExecStatus(Ydb_Query.ExecStatus_EXEC_STATUS_COMPLETED)
+	ExecStatusFailed      = ExecStatus(Ydb_Query.ExecStatus_EXEC_STATUS_FAILED)
+)
+
+func (s ExecStatus) String() string {
+	return Ydb_Query.ExecStatus(s).String()
+}
+
 func WithFetchToken(fetchToken string) FetchScriptOption {
 	return func(request *FetchScriptResultsRequest) {
 		request.FetchToken = fetchToken
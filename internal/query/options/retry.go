@@ -96,6 +96,10 @@ func WithTrace(t *trace.Query) TraceOption {
 	return TraceOption{t: t}
 }
 
+func WithRetryPolicy(p *retry.Policy) RetryOptionsOption {
+	return []retry.Option{retry.WithPolicy(p)}
+}
+
 func WithRetryBudget(b budget.Budget) RetryOptionsOption {
 	return []retry.Option{retry.WithBudget(b)}
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/ydb-platform/ydb-go-genproto/Ydb_Query_V1"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Query"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/interceptor"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/result"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stats"
@@ -38,6 +39,7 @@ type (
 		trace          *trace.Query
 		statsCallback  func(queryStats stats.QueryStats)
 		onNextPartErr  []func(err error)
+		observer       *interceptor.Observer
 	}
 	resultOption func(s *streamResult)
 )
@@ -84,6 +86,12 @@ func onNextPartErr(callback func(err error)) resultOption {
 	}
 }
 
+func withObserver(observer *interceptor.Observer) resultOption {
+	return func(s *streamResult) {
+		s.observer = observer
+	}
+}
+
 func newResult(
 	ctx context.Context,
 	stream Ydb_Query_V1.QueryService_ExecuteQueryClient,
@@ -152,6 +160,7 @@ func (r *streamResult) nextPart(ctx context.Context) (
 		part, err = nextPart(r.stream)
 		if err != nil {
 			r.closeOnce()
+			r.observer.Done(err)
 
 			for _, callback := range r.onNextPartErr {
 				callback(err)
@@ -216,7 +225,10 @@ func (r *streamResult) nextResultSet(ctx context.Context) (_ *resultSet, err err
 			if resultSetIndex := r.lastPart.GetResultSetIndex(); resultSetIndex >= nextResultSetIndex {
 				r.resultSetIndex = resultSetIndex
 
-				return newResultSet(r.nextPartFunc(ctx, nextResultSetIndex), r.lastPart), nil
+				rs := newResultSet(r.nextPartFunc(ctx, nextResultSetIndex), r.lastPart)
+				rs.observer = r.observer
+
+				return rs, nil
 			}
 			if r.stream == nil {
 				return nil, xerrors.WithStackTrace(io.EOF)
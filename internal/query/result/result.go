@@ -29,6 +29,15 @@ type (
 		// Rows is experimental API for range iterators available with Go version 1.23+
 		Rows(ctx context.Context) xiter.Seq2[Row, error]
 	}
+	// ArrowSet is a result set of query executed with Arrow result set format
+	ArrowSet interface {
+		// ArrowSchema returns serialized Arrow schema (encapsulated IPC message) of result set
+		// or nil if server returned result set in other format
+		ArrowSchema() []byte
+
+		// NextArrowBatch returns next serialized Arrow record batch (encapsulated IPC message) or io.EOF
+		NextArrowBatch(ctx context.Context) ([]byte, error)
+	}
 	Row interface {
 		Scan(dst ...interface{}) error
 		ScanNamed(dst ...scanner.NamedDestination) error
@@ -0,0 +1,184 @@
+package query
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"sort"
+	"strconv"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Query"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/cache"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/result"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/tx"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+)
+
+const (
+	resultCacheKindResult    = "result"
+	resultCacheKindResultSet = "resultSet"
+	resultCacheKindRow       = "row"
+)
+
+var errResultCacheNotReadOnly = errors.New("result cache supported only for read-only transaction control")
+
+// isReadOnlyTxControl reports whether txControl begins read-only transaction with commit in one request
+func isReadOnlyTxControl(txControl *tx.Control) bool {
+	if txControl == nil || !txControl.Commit {
+		return false
+	}
+
+	a := allocator.New()
+	defer a.Free()
+
+	switch txControl.ToYDB(a).GetBeginTx().GetTxMode().(type) {
+	case *Ydb_Query.TransactionSettings_SnapshotReadOnly,
+		*Ydb_Query.TransactionSettings_StaleReadOnly,
+		*Ydb_Query.TransactionSettings_OnlineReadOnly:
+		return true
+	default:
+		return false
+	}
+}
+
+// resultCacheKey makes key of cached result from query text and canonical encoding of parameters
+func resultCacheKey(kind, q string, settings executeSettings) (string, error) {
+	if !isReadOnlyTxControl(settings.TxControl()) {
+		return "", xerrors.WithStackTrace(errResultCacheNotReadOnly)
+	}
+
+	a := allocator.New()
+	defer a.Free()
+
+	h := sha256.New()
+	for _, s := range []string{kind, strconv.Itoa(int(settings.Syntax())), q} {
+		h.Write([]byte(s))
+		h.Write([]byte{0})
+	}
+	if p := settings.Params(); p != nil {
+		values := p.ToYDB(a)
+		names := make([]string, 0, len(values))
+		for name := range values {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			b, err := proto.MarshalOptions{Deterministic: true}.Marshal(values[name])
+			if err != nil {
+				return "", xerrors.WithStackTrace(err)
+			}
+			h.Write([]byte(name))
+			h.Write([]byte{0})
+			h.Write(b)
+			h.Write([]byte{0})
+		}
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func rowsSize(rows []query.Row) (size int) {
+	for _, r := range rows {
+		if r, ok := r.(*row); ok {
+			size += proto.Size(r.value)
+		}
+	}
+
+	return size
+}
+
+// cloneResultSet makes result set over shared cached rows with own iteration state
+func cloneResultSet(rs *materializedResultSet) *materializedResultSet {
+	return MaterializedResultSet(rs.index, rs.columnNames, rs.columnTypes, rs.rows)
+}
+
+func cachedQuery(ctx context.Context, c *cache.Cache, q string, settings executeSettings, tables []string,
+	fetch func(ctx context.Context) (result.Result, error),
+) (result.Result, error) {
+	key, err := resultCacheKey(resultCacheKindResult, q, settings)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	v, err := c.Do(ctx, key, tables, func(ctx context.Context) (interface{}, int, error) {
+		r, err := fetch(ctx)
+		if err != nil {
+			return nil, 0, xerrors.WithStackTrace(err)
+		}
+
+		var (
+			resultSets []*materializedResultSet
+			size       int
+		)
+		for _, rs := range r.(*materializedResult).resultSets { //nolint:forcetypeassert
+			rs := rs.(*materializedResultSet) //nolint:forcetypeassert
+			resultSets = append(resultSets, rs)
+			size += rowsSize(rs.rows)
+		}
+
+		return resultSets, size, nil
+	})
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	cached := v.([]*materializedResultSet) //nolint:forcetypeassert
+	resultSets := make([]result.Set, len(cached))
+	for i, rs := range cached {
+		resultSets[i] = cloneResultSet(rs)
+	}
+
+	return &materializedResult{resultSets: resultSets}, nil
+}
+
+func cachedQueryResultSet(ctx context.Context, c *cache.Cache, q string, settings executeSettings, tables []string,
+	fetch func(ctx context.Context) (query.ResultSet, error),
+) (query.ResultSet, error) {
+	key, err := resultCacheKey(resultCacheKindResultSet, q, settings)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	v, err := c.Do(ctx, key, tables, func(ctx context.Context) (interface{}, int, error) {
+		rs, err := fetch(ctx)
+		if err != nil {
+			return nil, 0, xerrors.WithStackTrace(err)
+		}
+		materialized := rs.(*materializedResultSet) //nolint:forcetypeassert
+
+		return materialized, rowsSize(materialized.rows), nil
+	})
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return cloneResultSet(v.(*materializedResultSet)), nil //nolint:forcetypeassert
+}
+
+func cachedQueryRow(ctx context.Context, c *cache.Cache, q string, settings executeSettings, tables []string,
+	fetch func(ctx context.Context) (query.Row, error),
+) (query.Row, error) {
+	key, err := resultCacheKey(resultCacheKindRow, q, settings)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	v, err := c.Do(ctx, key, tables, func(ctx context.Context) (interface{}, int, error) {
+		r, err := fetch(ctx)
+		if err != nil {
+			return nil, 0, xerrors.WithStackTrace(err)
+		}
+
+		return r, rowsSize([]query.Row{r}), nil
+	})
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return v.(query.Row), nil //nolint:forcetypeassert
+}
@@ -0,0 +1,159 @@
+package query
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Query_V1"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Query"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/interceptors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/cache"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/tx"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+)
+
+func TestResultCacheKey(t *testing.T) {
+	snapshot := options.WithTxControl(tx.SnapshotReadOnlyTxControl())
+	key := func(t *testing.T, opts ...options.Execute) string {
+		k, err := resultCacheKey(resultCacheKindResultSet, "SELECT $a, $b", options.ExecuteSettings(opts...))
+		require.NoError(t, err)
+
+		return k
+	}
+	ab := key(t, snapshot, options.WithParameters(params.Builder{}.Param("$a").Text("A").Param("$b").Int32(1).Build()))
+	ba := key(t, snapshot, options.WithParameters(params.Builder{}.Param("$b").Int32(1).Param("$a").Text("A").Build()))
+	require.Equal(t, ab, ba, "parameters order not matters")
+	require.NotEqual(t, ab, key(t, snapshot,
+		options.WithParameters(params.Builder{}.Param("$a").Text("A").Param("$b").Int32(2).Build()),
+	))
+	require.NotEqual(t, ab, key(t, options.WithTxControl(tx.StaleReadOnlyTxControl()), options.WithSyntax(options.SyntaxPostgreSQL),
+		options.WithParameters(params.Builder{}.Param("$a").Text("A").Param("$b").Int32(1).Build()),
+	))
+
+	for _, txControl := range []*tx.Control{
+		tx.DefaultTxControl(),
+		tx.SerializableReadWriteTxControl(tx.CommitTx()),
+		tx.NewControl(tx.BeginTx(tx.WithSnapshotReadOnly())),
+	} {
+		_, err := resultCacheKey(resultCacheKindRow, "SELECT 1", options.ExecuteSettings(options.WithTxControl(txControl)))
+		require.ErrorIs(t, err, errResultCacheNotReadOnly)
+	}
+}
+
+func TestCachedQueryResultSet(t *testing.T) {
+	ctx := xtest.Context(t)
+	c := cache.New()
+	settings := options.ExecuteSettings(options.WithTxControl(tx.SnapshotReadOnlyTxControl()))
+	columns := []*Ydb.Column{{Name: "a", Type: &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_INT32}}}}
+	fetches := 0
+	fetch := func(ctx context.Context) (query.ResultSet, error) {
+		fetches++
+
+		return MaterializedResultSet(0, []string{"a"}, []types.Type{types.Int32}, []query.Row{
+			NewRow(columns, &Ydb.Value{Items: []*Ydb.Value{{Value: &Ydb.Value_Int32Value{Int32Value: 1}}}}),
+			NewRow(columns, &Ydb.Value{Items: []*Ydb.Value{{Value: &Ydb.Value_Int32Value{Int32Value: 2}}}}),
+		}), nil
+	}
+	readAll := func(rs query.ResultSet) (values []int32) {
+		for {
+			row, err := rs.NextRow(ctx)
+			if err != nil {
+				require.ErrorIs(t, err, io.EOF)
+
+				return values
+			}
+			var v int32
+			require.NoError(t, row.Scan(&v))
+			values = append(values, v)
+		}
+	}
+
+	for range make([]struct{}, 3) {
+		rs, err := cachedQueryResultSet(ctx, c, "SELECT a FROM t", settings, []string{"t"}, fetch)
+		require.NoError(t, err)
+		require.Equal(t, []int32{1, 2}, readAll(rs))
+	}
+	require.Equal(t, 1, fetches)
+	require.Positive(t, c.Stats().Bytes)
+
+	c.Invalidate("t")
+	_, err := cachedQueryResultSet(ctx, c, "SELECT a FROM t", settings, []string{"t"}, fetch)
+	require.NoError(t, err)
+	require.Equal(t, 2, fetches)
+}
+
+func TestResultCacheBypassedByInterceptor(t *testing.T) {
+	ctx := xtest.Context(t)
+	ctrl := gomock.NewController(t)
+	service := NewMockQueryServiceClient(ctrl)
+	service.EXPECT().ExecuteQuery(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, request *Ydb_Query.ExecuteQueryRequest, opts ...grpc.CallOption) (
+			Ydb_Query_V1.QueryService_ExecuteQueryClient, error,
+		) {
+			require.Equal(t, "/* tenant */ SELECT a FROM t", request.GetQueryContent().GetText())
+			stream := NewMockQueryService_ExecuteQueryClient(ctrl)
+			stream.EXPECT().Recv().Return(&Ydb_Query.ExecuteQueryResponsePart{
+				Status: Ydb.StatusIds_SUCCESS,
+				ResultSet: &Ydb.ResultSet{
+					Columns: []*Ydb.Column{{Name: "a", Type: &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_INT32}}}},
+					Rows: []*Ydb.Value{
+						{Items: []*Ydb.Value{{Value: &Ydb.Value_Int32Value{Int32Value: 1}}}},
+					},
+				},
+			}, nil)
+			stream.EXPECT().Recv().Return(nil, io.EOF)
+
+			return stream, nil
+		},
+	).Times(2)
+
+	resultCache := cache.New()
+	calls := 0
+	cfg := config.New(
+		config.WithResultCache(resultCache),
+		config.WithInterceptors(func(ctx context.Context, call *interceptors.Call) (*interceptors.Observer, error) {
+			calls++
+			call.Query = "/* tenant */ " + call.Query
+
+			return nil, nil //nolint:nilnil
+		}),
+	)
+	c := &Client{
+		config: cfg,
+		pool: testPool(ctx, func(ctx context.Context) (*Session, error) {
+			return &Session{
+				id:                 "123",
+				queryServiceClient: service,
+				statusCode:         statusIdle,
+				cfg:                cfg,
+			}, nil
+		}),
+		done: make(chan struct{}),
+	}
+
+	for range make([]struct{}, 2) {
+		rs, err := c.QueryResultSet(ctx, "SELECT a FROM t",
+			options.WithTxControl(tx.SnapshotReadOnlyTxControl()),
+			options.WithResultCache("t"),
+		)
+		require.NoError(t, err)
+		row, err := rs.NextRow(ctx)
+		require.NoError(t, err)
+		var a int32
+		require.NoError(t, row.Scan(&a))
+		require.EqualValues(t, 1, a)
+	}
+	require.Equal(t, 2, calls)
+	require.Zero(t, resultCache.Stats().Bytes)
+}
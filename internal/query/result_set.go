@@ -8,6 +8,8 @@ import (
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Query"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/interceptor"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/result"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xiter"
@@ -17,6 +19,7 @@ import (
 var (
 	_ query.ResultSet = (*resultSet)(nil)
 	_ query.ResultSet = (*materializedResultSet)(nil)
+	_ result.ArrowSet = (*resultSet)(nil)
 )
 
 type (
@@ -34,6 +37,10 @@ type (
 		currentPart *Ydb_Query.ExecuteQueryResponsePart
 		rowIndex    int
 		done        chan struct{}
+		observer    *interceptor.Observer
+
+		arrowSchema   []byte
+		arrowPartRead bool
 	}
 )
 
@@ -116,10 +123,71 @@ func newResultSet(
 		rowIndex:    -1,
 		columns:     part.GetResultSet().GetColumns(),
 		done:        make(chan struct{}),
+		arrowSchema: arrowSchema(part.GetResultSet()),
+	}
+}
+
+func arrowSchema(rs *Ydb.ResultSet) []byte {
+	if rs.GetFormat() != Ydb.ResultSet_FORMAT_ARROW {
+		return nil
+	}
+
+	return rs.GetArrowFormatMeta().GetSchema()
+}
+
+func (rs *resultSet) ArrowSchema() []byte {
+	return rs.arrowSchema
+}
+
+// NextArrowBatch returns data of next part of result set in Arrow format
+func (rs *resultSet) NextArrowBatch(ctx context.Context) ([]byte, error) {
+	if rs.arrowSchema == nil {
+		return nil, xerrors.WithStackTrace(errNotArrowResultSet)
+	}
+	for {
+		select {
+		case <-rs.done:
+			return nil, io.EOF
+		case <-ctx.Done():
+			return nil, xerrors.WithStackTrace(ctx.Err())
+		default:
+			if !rs.arrowPartRead {
+				rs.arrowPartRead = true
+				if data := rs.currentPart.GetResultSet().GetData(); len(data) > 0 {
+					return data, nil
+				}
+			}
+			part, err := rs.recv()
+			if err != nil {
+				if xerrors.Is(err, io.EOF) {
+					close(rs.done)
+				}
+
+				return nil, xerrors.WithStackTrace(err)
+			}
+			if part == nil {
+				close(rs.done)
+
+				return nil, xerrors.WithStackTrace(io.EOF)
+			}
+			rs.currentPart = part
+			rs.arrowPartRead = false
+			if part.GetResultSet() != nil && rs.index != part.GetResultSetIndex() {
+				close(rs.done)
+
+				return nil, xerrors.WithStackTrace(fmt.Errorf(
+					"received part with result set index = %d, current result set index = %d: %w",
+					rs.index, part.GetResultSetIndex(), errWrongResultSetIndex,
+				))
+			}
+		}
 	}
 }
 
 func (rs *resultSet) nextRow(ctx context.Context) (*row, error) {
+	if rs.arrowSchema != nil {
+		return nil, xerrors.WithStackTrace(errArrowResultSet)
+	}
 	rs.rowIndex++
 	for {
 		select {
@@ -155,7 +223,10 @@ func (rs *resultSet) nextRow(ctx context.Context) (*row, error) {
 			}
 
 			if rs.rowIndex < len(rs.currentPart.GetResultSet().GetRows()) {
-				return NewRow(rs.columns, rs.currentPart.GetResultSet().GetRows()[rs.rowIndex]), nil
+				v := rs.currentPart.GetResultSet().GetRows()[rs.rowIndex]
+				rs.observer.Row(rs.columns, v)
+
+				return NewRow(rs.columns, v), nil
 			}
 		}
 	}
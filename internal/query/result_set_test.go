@@ -8,6 +8,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Formats"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Query"
 	"go.uber.org/mock/gomock"
 	grpcCodes "google.golang.org/grpc/codes"
@@ -960,3 +961,55 @@ func TestResultSetNext(t *testing.T) {
 		require.EqualValues(t, []string{"Uint64", "Utf8"}, types)
 	})
 }
+
+func TestResultSetNextArrowBatch(t *testing.T) {
+	ctx := xtest.Context(t)
+	arrowPart := func(data string) *Ydb_Query.ExecuteQueryResponsePart {
+		return &Ydb_Query.ExecuteQueryResponsePart{
+			Status: Ydb.StatusIds_SUCCESS,
+			ResultSet: &Ydb.ResultSet{
+				Format: Ydb.ResultSet_FORMAT_ARROW,
+				ArrowFormatMeta: &Ydb_Formats.ArrowFormatMeta{
+					Schema: []byte("schema"),
+				},
+				Data: []byte(data),
+			},
+		}
+	}
+	t.Run("ArrowFormat", func(t *testing.T) {
+		parts := []*Ydb_Query.ExecuteQueryResponsePart{arrowPart("second")}
+		rs := newResultSet(func() (*Ydb_Query.ExecuteQueryResponsePart, error) {
+			if len(parts) == 0 {
+				return nil, io.EOF
+			}
+			part := parts[0]
+			parts = parts[1:]
+
+			return part, nil
+		}, arrowPart("first"))
+		require.Equal(t, []byte("schema"), rs.ArrowSchema())
+		_, err := rs.nextRow(ctx)
+		require.ErrorIs(t, err, errArrowResultSet)
+		data, err := rs.NextArrowBatch(ctx)
+		require.NoError(t, err)
+		require.Equal(t, []byte("first"), data)
+		data, err = rs.NextArrowBatch(ctx)
+		require.NoError(t, err)
+		require.Equal(t, []byte("second"), data)
+		_, err = rs.NextArrowBatch(ctx)
+		require.ErrorIs(t, err, io.EOF)
+		_, err = rs.NextArrowBatch(ctx)
+		require.ErrorIs(t, err, io.EOF)
+	})
+	t.Run("ValueFormat", func(t *testing.T) {
+		rs := newResultSet(func() (*Ydb_Query.ExecuteQueryResponsePart, error) {
+			return nil, io.EOF
+		}, &Ydb_Query.ExecuteQueryResponsePart{
+			Status:    Ydb.StatusIds_SUCCESS,
+			ResultSet: &Ydb.ResultSet{},
+		})
+		require.Nil(t, rs.ArrowSchema())
+		_, err := rs.NextArrowBatch(ctx)
+		require.ErrorIs(t, err, errNotArrowResultSet)
+	})
+}
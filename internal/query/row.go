@@ -10,6 +10,7 @@ import (
 var _ query.Row = (*row)(nil)
 
 type row struct {
+	value          *Ydb.Value
 	indexedScanner scanner.IndexedScanner
 	namedScanner   scanner.NamedScanner
 	structScanner  scanner.StructScanner
@@ -19,6 +20,7 @@ func NewRow(columns []*Ydb.Column, v *Ydb.Value) *row {
 	data := scanner.Data(columns, v.GetItems())
 
 	return &row{
+		value:          v,
 		indexedScanner: scanner.Indexed(data),
 		namedScanner:   scanner.Named(data),
 		structScanner:  scanner.Struct(data),
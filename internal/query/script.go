@@ -0,0 +1,280 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Operation_V1"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Operations"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Query"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/backoff"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/operation/service"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xiter"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
+)
+
+var (
+	_ query.Script    = (*script)(nil)
+	_ query.ResultSet = (*scriptResultSet)(nil)
+)
+
+var (
+	errScriptNotReady  = errors.New("script execution not completed")
+	errScriptCancelled = errors.New("script execution cancelled")
+)
+
+type (
+	script struct {
+		id                     string
+		client                 *Client
+		operationServiceClient Ydb_Operation_V1.OperationServiceClient
+		backoff                backoff.Backoff
+
+		mu             sync.Mutex
+		metadata       *options.MetadataExecuteQuery
+		resultSetIndex int
+	}
+	scriptResultSet struct {
+		index     int
+		fetch     func(ctx context.Context, fetchToken string) (*options.FetchScriptResult, error)
+		page      query.ResultSet
+		nextToken string
+	}
+)
+
+func (c *Client) newScript(opID string) *script {
+	return &script{
+		id:                     opID,
+		client:                 c,
+		operationServiceClient: c.operationServiceClient,
+		backoff:                backoff.Slow,
+	}
+}
+
+func (c *Client) StartScript(
+	ctx context.Context, q string, ttl time.Duration, opts ...options.Execute,
+) (query.Script, error) {
+	op, err := c.ExecuteScript(ctx, q, ttl, opts...)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return c.newScript(op.ID), nil
+}
+
+func (c *Client) Script(opID string) query.Script {
+	return c.newScript(opID)
+}
+
+func (s *script) ID() string {
+	return s.id
+}
+
+func (s *script) getOperation(ctx context.Context) (*Ydb_Operations.Operation, *Ydb_Query.ExecuteScriptMetadata, error) {
+	op, err := service.Get(ctx, s.operationServiceClient, s.id, retry.WithPolicy(s.client.config.RetryPolicy()))
+	if err != nil {
+		return nil, nil, xerrors.WithStackTrace(err)
+	}
+
+	var md Ydb_Query.ExecuteScriptMetadata
+	if op.GetMetadata() != nil {
+		if err = op.GetMetadata().UnmarshalTo(&md); err != nil {
+			return nil, nil, xerrors.WithStackTrace(err)
+		}
+	}
+
+	return op, &md, nil
+}
+
+func (s *script) Progress(ctx context.Context) (*options.ScriptProgress, error) {
+	op, md, err := s.getOperation(ctx)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	progress := &options.ScriptProgress{
+		Ready:      op.GetReady(),
+		ExecStatus: options.ExecStatus(md.GetExecStatus()),
+	}
+	if op.GetMetadata() != nil {
+		progress.Metadata = options.ToMetadataExecuteQuery(op.GetMetadata())
+	}
+
+	return progress, nil
+}
+
+// poll returns metadata of completed script execution or errScriptNotReady
+func (s *script) poll(ctx context.Context) (*options.MetadataExecuteQuery, error) {
+	op, md, err := s.getOperation(ctx)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	if !op.GetReady() {
+		return nil, errScriptNotReady
+	}
+	if op.GetStatus() != Ydb.StatusIds_SUCCESS {
+		return nil, xerrors.WithStackTrace(xerrors.Operation(
+			xerrors.FromOperation(op),
+		))
+	}
+	switch md.GetExecStatus() {
+	case Ydb_Query.ExecStatus_EXEC_STATUS_CANCELLED:
+		return nil, xerrors.WithStackTrace(errScriptCancelled)
+	case Ydb_Query.ExecStatus_EXEC_STATUS_STARTING:
+		return nil, errScriptNotReady
+	}
+
+	return options.ToMetadataExecuteQuery(op.GetMetadata()), nil
+}
+
+func (s *script) Wait(ctx context.Context) (*options.MetadataExecuteQuery, error) {
+	s.mu.Lock()
+	metadata := s.metadata
+	s.mu.Unlock()
+
+	if metadata != nil {
+		return metadata, nil
+	}
+
+	for i := 0; ; i++ {
+		metadata, err := s.poll(ctx)
+		if err == nil {
+			s.mu.Lock()
+			s.metadata = metadata
+			s.mu.Unlock()
+
+			return metadata, nil
+		}
+		if !errors.Is(err, errScriptNotReady) {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		timer := time.NewTimer(s.backoff.Delay(i))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+
+			return nil, xerrors.WithStackTrace(ctx.Err())
+		case <-timer.C:
+		}
+	}
+}
+
+func (s *script) Cancel(ctx context.Context) error {
+	err := service.Cancel(ctx, s.operationServiceClient, s.id, retry.WithPolicy(s.client.config.RetryPolicy()))
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+func (s *script) Forget(ctx context.Context) error {
+	err := service.Forget(ctx, s.operationServiceClient, s.id, retry.WithPolicy(s.client.config.RetryPolicy()))
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+func (s *script) NextResultSet(ctx context.Context) (query.ResultSet, error) {
+	metadata, err := s.Wait(ctx)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	s.mu.Lock()
+	index := s.resultSetIndex
+	if index >= len(metadata.ResultSetsMeta) {
+		s.mu.Unlock()
+
+		return nil, xerrors.WithStackTrace(io.EOF)
+	}
+	s.resultSetIndex++
+	s.mu.Unlock()
+
+	rs := &scriptResultSet{
+		index: index,
+		fetch: func(ctx context.Context, fetchToken string) (*options.FetchScriptResult, error) {
+			return s.client.FetchScriptResults(ctx, s.id,
+				options.WithResultSetIndex(int64(index)),
+				options.WithFetchToken(fetchToken),
+			)
+		},
+	}
+	if err = rs.nextPage(ctx); err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return rs, nil
+}
+
+func (s *script) ResultSets(ctx context.Context) xiter.Seq2[query.ResultSet, error] {
+	return func(yield func(query.ResultSet, error) bool) {
+		for {
+			rs, err := s.NextResultSet(ctx)
+			if err != nil {
+				if !xerrors.Is(err, io.EOF) {
+					yield(nil, err)
+				}
+
+				return
+			}
+			if !yield(rs, nil) {
+				return
+			}
+		}
+	}
+}
+
+func (rs *scriptResultSet) nextPage(ctx context.Context) error {
+	r, err := rs.fetch(ctx, rs.nextToken)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	rs.page, rs.nextToken = r.ResultSet, r.NextToken
+
+	return nil
+}
+
+func (rs *scriptResultSet) Index() int {
+	return rs.index
+}
+
+func (rs *scriptResultSet) Columns() []string {
+	return rs.page.Columns()
+}
+
+func (rs *scriptResultSet) ColumnTypes() []query.Type {
+	return rs.page.ColumnTypes()
+}
+
+func (rs *scriptResultSet) NextRow(ctx context.Context) (query.Row, error) {
+	for {
+		row, err := rs.page.NextRow(ctx)
+		if err == nil {
+			return row, nil
+		}
+		if !xerrors.Is(err, io.EOF) || rs.nextToken == "" {
+			return nil, xerrors.WithStackTrace(err)
+		}
+		if err = rs.nextPage(ctx); err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+	}
+}
+
+func (rs *scriptResultSet) Rows(ctx context.Context) xiter.Seq2[query.Row, error] {
+	return rangeRows(ctx, rs)
+}
@@ -0,0 +1,232 @@
+package query
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Operation_V1"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Operations"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Query"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/backoff"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+)
+
+type operationServiceClientStub struct {
+	Ydb_Operation_V1.OperationServiceClient
+
+	operations []*Ydb_Operations.Operation
+	polls      int
+	cancelled  []string
+	forgotten  []string
+}
+
+func (s *operationServiceClientStub) GetOperation(
+	ctx context.Context, in *Ydb_Operations.GetOperationRequest, opts ...grpc.CallOption,
+) (*Ydb_Operations.GetOperationResponse, error) {
+	op := s.operations[s.polls]
+	s.polls++
+
+	return &Ydb_Operations.GetOperationResponse{Operation: op}, nil
+}
+
+func (s *operationServiceClientStub) CancelOperation(
+	ctx context.Context, in *Ydb_Operations.CancelOperationRequest, opts ...grpc.CallOption,
+) (*Ydb_Operations.CancelOperationResponse, error) {
+	s.cancelled = append(s.cancelled, in.GetId())
+
+	return &Ydb_Operations.CancelOperationResponse{Status: Ydb.StatusIds_SUCCESS}, nil
+}
+
+func (s *operationServiceClientStub) ForgetOperation(
+	ctx context.Context, in *Ydb_Operations.ForgetOperationRequest, opts ...grpc.CallOption,
+) (*Ydb_Operations.ForgetOperationResponse, error) {
+	s.forgotten = append(s.forgotten, in.GetId())
+
+	return &Ydb_Operations.ForgetOperationResponse{Status: Ydb.StatusIds_SUCCESS}, nil
+}
+
+func scriptOperation(ready bool, status Ydb.StatusIds_StatusCode, execStatus Ydb_Query.ExecStatus,
+) *Ydb_Operations.Operation {
+	return &Ydb_Operations.Operation{
+		Id:     "123",
+		Ready:  ready,
+		Status: status,
+		Metadata: xtest.Must(anypb.New(&Ydb_Query.ExecuteScriptMetadata{
+			ExecutionId: "123",
+			ExecStatus:  execStatus,
+			ResultSetsMeta: []*Ydb_Query.ResultSetMeta{
+				{
+					Columns: []*Ydb.Column{
+						{
+							Name: "a",
+							Type: &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_INT32}},
+						},
+					},
+				},
+			},
+		})),
+	}
+}
+
+func scriptPage(nextToken string, values ...int32) *Ydb_Query.FetchScriptResultsResponse {
+	rows := make([]*Ydb.Value, len(values))
+	for i, v := range values {
+		rows[i] = &Ydb.Value{Items: []*Ydb.Value{{Value: &Ydb.Value_Int32Value{Int32Value: v}}}}
+	}
+
+	return &Ydb_Query.FetchScriptResultsResponse{
+		Status: Ydb.StatusIds_SUCCESS,
+		ResultSet: &Ydb.ResultSet{
+			Columns: []*Ydb.Column{
+				{
+					Name: "a",
+					Type: &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_INT32}},
+				},
+			},
+			Rows: rows,
+		},
+		NextFetchToken: nextToken,
+	}
+}
+
+func TestScript(t *testing.T) {
+	ctx := xtest.Context(t)
+	newScript := func(service *MockQueryServiceClient, operations *operationServiceClientStub) *script {
+		s := (&Client{
+			config:                 config.New(),
+			queryServiceClient:     service,
+			operationServiceClient: operations,
+		}).newScript("123")
+		s.backoff = backoff.New(backoff.WithSlotDuration(time.Millisecond))
+
+		return s
+	}
+
+	t.Run("HappyWay", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		service := NewMockQueryServiceClient(ctrl)
+		operations := &operationServiceClientStub{
+			operations: []*Ydb_Operations.Operation{
+				scriptOperation(false, Ydb.StatusIds_SUCCESS, Ydb_Query.ExecStatus_EXEC_STATUS_STARTING),
+				scriptOperation(false, Ydb.StatusIds_SUCCESS, Ydb_Query.ExecStatus_EXEC_STATUS_STARTING),
+				scriptOperation(true, Ydb.StatusIds_SUCCESS, Ydb_Query.ExecStatus_EXEC_STATUS_COMPLETED),
+			},
+		}
+		s := newScript(service, operations)
+
+		metadata, err := s.Wait(ctx)
+		require.NoError(t, err)
+		require.Len(t, metadata.ResultSetsMeta, 1)
+		require.Equal(t, 3, operations.polls)
+
+		gomock.InOrder(
+			service.EXPECT().FetchScriptResults(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, in *Ydb_Query.FetchScriptResultsRequest, _ ...grpc.CallOption,
+				) (*Ydb_Query.FetchScriptResultsResponse, error) {
+					require.Equal(t, "123", in.GetOperationId())
+					require.Empty(t, in.GetFetchToken())
+
+					return scriptPage("next", 1, 2), nil
+				}),
+			service.EXPECT().FetchScriptResults(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, in *Ydb_Query.FetchScriptResultsRequest, _ ...grpc.CallOption,
+				) (*Ydb_Query.FetchScriptResultsResponse, error) {
+					require.Equal(t, "next", in.GetFetchToken())
+
+					return scriptPage("", 3), nil
+				}),
+		)
+
+		rs, err := s.NextResultSet(ctx)
+		require.NoError(t, err)
+		require.Equal(t, []string{"a"}, rs.Columns())
+		var values []int32
+		for {
+			row, err := rs.NextRow(ctx)
+			if xerrors.Is(err, io.EOF) {
+				break
+			}
+			require.NoError(t, err)
+			var v int32
+			require.NoError(t, row.Scan(&v))
+			values = append(values, v)
+		}
+		require.Equal(t, []int32{1, 2, 3}, values)
+
+		_, err = s.NextResultSet(ctx)
+		require.ErrorIs(t, err, io.EOF)
+		require.Equal(t, 3, operations.polls, "metadata of completed script cached")
+
+		require.NoError(t, s.Forget(ctx))
+		require.Equal(t, []string{"123"}, operations.forgotten)
+	})
+
+	t.Run("Progress", func(t *testing.T) {
+		operations := &operationServiceClientStub{
+			operations: []*Ydb_Operations.Operation{
+				scriptOperation(false, Ydb.StatusIds_SUCCESS, Ydb_Query.ExecStatus_EXEC_STATUS_STARTING),
+				scriptOperation(true, Ydb.StatusIds_SUCCESS, Ydb_Query.ExecStatus_EXEC_STATUS_COMPLETED),
+			},
+		}
+		s := newScript(NewMockQueryServiceClient(gomock.NewController(t)), operations)
+
+		progress, err := s.Progress(ctx)
+		require.NoError(t, err)
+		require.False(t, progress.Ready)
+		require.Equal(t, options.ExecStatusStarting, progress.ExecStatus)
+		require.Equal(t, "123", progress.Metadata.ID)
+
+		progress, err = s.Progress(ctx)
+		require.NoError(t, err)
+		require.True(t, progress.Ready)
+		require.Equal(t, options.ExecStatusCompleted, progress.ExecStatus)
+		require.Len(t, progress.Metadata.ResultSetsMeta, 1)
+	})
+
+	t.Run("Failed", func(t *testing.T) {
+		s := newScript(NewMockQueryServiceClient(gomock.NewController(t)), &operationServiceClientStub{
+			operations: []*Ydb_Operations.Operation{
+				scriptOperation(true, Ydb.StatusIds_GENERIC_ERROR, Ydb_Query.ExecStatus_EXEC_STATUS_FAILED),
+			},
+		})
+		_, err := s.Wait(ctx)
+		require.True(t, xerrors.IsOperationError(err, Ydb.StatusIds_GENERIC_ERROR))
+	})
+
+	t.Run("Cancelled", func(t *testing.T) {
+		operations := &operationServiceClientStub{
+			operations: []*Ydb_Operations.Operation{
+				scriptOperation(true, Ydb.StatusIds_SUCCESS, Ydb_Query.ExecStatus_EXEC_STATUS_CANCELLED),
+			},
+		}
+		s := newScript(NewMockQueryServiceClient(gomock.NewController(t)), operations)
+		require.NoError(t, s.Cancel(ctx))
+		require.Equal(t, []string{"123"}, operations.cancelled)
+		_, err := s.Wait(ctx)
+		require.ErrorIs(t, err, errScriptCancelled)
+	})
+
+	t.Run("ContextDone", func(t *testing.T) {
+		s := newScript(NewMockQueryServiceClient(gomock.NewController(t)), &operationServiceClientStub{
+			operations: []*Ydb_Operations.Operation{
+				scriptOperation(false, Ydb.StatusIds_SUCCESS, Ydb_Query.ExecStatus_EXEC_STATUS_STARTING),
+			},
+		})
+		s.backoff = backoff.New(backoff.WithSlotDuration(time.Hour))
+		childCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+		defer cancel()
+		_, err := s.Wait(childCtx)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
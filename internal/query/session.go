@@ -7,6 +7,7 @@ import (
 	"github.com/ydb-platform/ydb-go-genproto/Ydb_Query_V1"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Query"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/fingerprint"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/config"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/options"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
@@ -34,12 +35,19 @@ func (s *Session) QueryResultSet(
 	ctx context.Context, q string, opts ...options.Execute,
 ) (rs query.ResultSet, finalErr error) {
 	onDone := trace.QueryOnSessionQueryResultSet(s.cfg.Trace(), &ctx,
-		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/v3/internal/query.(*Session).QueryResultSet"), s, q)
+		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/v3/internal/query.(*Session).QueryResultSet"),
+		s, q, fingerprint.Stringer(q),
+	)
 	defer func() {
 		onDone(finalErr)
 	}()
 
-	_, r, err := execute(ctx, s.id, s.queryServiceClient, q, options.ExecuteSettings(opts...), withTrace(s.cfg.Trace()))
+	settings := options.ExecuteSettings(opts...)
+	if err := checkRowsFormat(settings); err != nil {
+		return nil, err
+	}
+
+	_, r, err := s.execute(ctx, q, settings, withTrace(s.cfg.Trace()))
 	if err != nil {
 		return nil, xerrors.WithStackTrace(err)
 	}
@@ -55,7 +63,7 @@ func (s *Session) QueryResultSet(
 func (s *Session) queryRow(
 	ctx context.Context, q string, settings executeSettings, resultOpts ...resultOption,
 ) (row query.Row, finalErr error) {
-	_, r, err := execute(ctx, s.id, s.queryServiceClient, q, settings, resultOpts...)
+	_, r, err := s.execute(ctx, q, settings, resultOpts...)
 	if err != nil {
 		return nil, xerrors.WithStackTrace(err)
 	}
@@ -70,12 +78,19 @@ func (s *Session) queryRow(
 
 func (s *Session) QueryRow(ctx context.Context, q string, opts ...options.Execute) (_ query.Row, finalErr error) {
 	onDone := trace.QueryOnSessionQueryRow(s.cfg.Trace(), &ctx,
-		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/v3/internal/query.(*Session).QueryRow"), s, q)
+		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/v3/internal/query.(*Session).QueryRow"),
+		s, q, fingerprint.Stringer(q),
+	)
 	defer func() {
 		onDone(finalErr)
 	}()
 
-	row, err := s.queryRow(ctx, q, options.ExecuteSettings(opts...), withTrace(s.cfg.Trace()))
+	settings := options.ExecuteSettings(opts...)
+	if err := checkRowsFormat(settings); err != nil {
+		return nil, err
+	}
+
+	row, err := s.queryRow(ctx, q, settings, withTrace(s.cfg.Trace()))
 	if err != nil {
 		return nil, xerrors.WithStackTrace(err)
 	}
@@ -286,12 +301,14 @@ func (s *Session) Exec(
 	ctx context.Context, q string, opts ...options.Execute,
 ) (finalErr error) {
 	onDone := trace.QueryOnSessionExec(s.cfg.Trace(), &ctx,
-		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/v3/internal/query.(*Session).Exec"), s, q)
+		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/v3/internal/query.(*Session).Exec"),
+		s, q, fingerprint.Stringer(q),
+	)
 	defer func() {
 		onDone(finalErr)
 	}()
 
-	_, r, err := execute(ctx, s.id, s.queryServiceClient, q, options.ExecuteSettings(opts...), withTrace(s.cfg.Trace()))
+	_, r, err := s.execute(ctx, q, options.ExecuteSettings(opts...), withTrace(s.cfg.Trace()))
 	if err != nil {
 		return xerrors.WithStackTrace(err)
 	}
@@ -308,12 +325,14 @@ func (s *Session) Query(
 	ctx context.Context, q string, opts ...options.Execute,
 ) (_ query.Result, finalErr error) {
 	onDone := trace.QueryOnSessionQuery(s.cfg.Trace(), &ctx,
-		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/v3/internal/query.(*Session).Query"), s, q)
+		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/v3/internal/query.(*Session).Query"),
+		s, q, fingerprint.Stringer(q),
+	)
 	defer func() {
 		onDone(finalErr)
 	}()
 
-	_, r, err := execute(ctx, s.id, s.queryServiceClient, q, options.ExecuteSettings(opts...), withTrace(s.cfg.Trace()))
+	_, r, err := s.execute(ctx, q, options.ExecuteSettings(opts...), withTrace(s.cfg.Trace()))
 	if err != nil {
 		return nil, xerrors.WithStackTrace(err)
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Query"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/fingerprint"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/options"
 	queryTx "github.com/ydb-platform/ydb-go-sdk/v3/internal/query/tx"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
@@ -79,7 +80,9 @@ func (tx *Transaction) QueryResultSet(
 	ctx context.Context, q string, opts ...options.Execute,
 ) (rs query.ResultSet, finalErr error) {
 	onDone := trace.QueryOnTxQueryResultSet(tx.s.cfg.Trace(), &ctx,
-		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/v3/internal/query.(*Transaction).QueryResultSet"), tx, q)
+		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/v3/internal/query.(*Transaction).QueryResultSet"),
+		tx, q, fingerprint.Stringer(q),
+	)
 	defer func() {
 		onDone(finalErr)
 	}()
@@ -92,6 +95,9 @@ func (tx *Transaction) QueryResultSet(
 	if err != nil {
 		return nil, xerrors.WithStackTrace(err)
 	}
+	if err = checkRowsFormat(settings); err != nil {
+		return nil, err
+	}
 
 	resultOpts := []resultOption{
 		withTrace(tx.s.cfg.Trace()),
@@ -105,7 +111,7 @@ func (tx *Transaction) QueryResultSet(
 			}),
 		)
 	}
-	txID, r, err := execute(ctx, tx.s.id, tx.s.queryServiceClient, q, settings, resultOpts...)
+	txID, r, err := tx.s.execute(ctx, q, settings, resultOpts...)
 	if err != nil {
 		return nil, xerrors.WithStackTrace(err)
 	}
@@ -134,7 +140,9 @@ func (tx *Transaction) QueryRow(
 	ctx context.Context, q string, opts ...options.Execute,
 ) (row query.Row, finalErr error) {
 	onDone := trace.QueryOnTxQueryRow(tx.s.cfg.Trace(), &ctx,
-		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/v3/internal/query.(*Transaction).QueryRow"), tx, q)
+		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/v3/internal/query.(*Transaction).QueryRow"),
+		tx, q, fingerprint.Stringer(q),
+	)
 	defer func() {
 		onDone(finalErr)
 	}()
@@ -145,6 +153,9 @@ func (tx *Transaction) QueryRow(
 			opts...,
 		)...,
 	)
+	if err := checkRowsFormat(settings); err != nil {
+		return nil, err
+	}
 
 	resultOpts := []resultOption{
 		withTrace(tx.s.cfg.Trace()),
@@ -158,7 +169,7 @@ func (tx *Transaction) QueryRow(
 			}),
 		)
 	}
-	txID, r, err := execute(ctx, tx.s.id, tx.s.queryServiceClient, q, settings, resultOpts...)
+	txID, r, err := tx.s.execute(ctx, q, settings, resultOpts...)
 	if err != nil {
 		return nil, xerrors.WithStackTrace(err)
 	}
@@ -201,7 +212,9 @@ func (tx *Transaction) Exec(ctx context.Context, q string, opts ...options.Execu
 	finalErr error,
 ) {
 	onDone := trace.QueryOnTxExec(tx.s.cfg.Trace(), &ctx,
-		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/v3/internal/query.(*Transaction).Exec"), tx.s, tx, q)
+		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/v3/internal/query.(*Transaction).Exec"),
+		tx.s, tx, q, fingerprint.Stringer(q),
+	)
 	defer func() {
 		onDone(finalErr)
 	}()
@@ -228,7 +241,7 @@ func (tx *Transaction) Exec(ctx context.Context, q string, opts ...options.Execu
 		)
 	}
 
-	txID, r, err := execute(ctx, tx.s.id, tx.s.queryServiceClient, q, settings, resultOpts...)
+	txID, r, err := tx.s.execute(ctx, q, settings, resultOpts...)
 	if err != nil {
 		return xerrors.WithStackTrace(err)
 	}
@@ -288,7 +301,9 @@ func (tx *Transaction) Query(ctx context.Context, q string, opts ...options.Exec
 	_ query.Result, finalErr error,
 ) {
 	onDone := trace.QueryOnTxQuery(tx.s.cfg.Trace(), &ctx,
-		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/v3/internal/query.(*Transaction).Query"), tx.s, tx, q)
+		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/v3/internal/query.(*Transaction).Query"),
+		tx.s, tx, q, fingerprint.Stringer(q),
+	)
 	defer func() {
 		onDone(finalErr)
 	}()
@@ -314,7 +329,7 @@ func (tx *Transaction) Query(ctx context.Context, q string, opts ...options.Exec
 			}),
 		)
 	}
-	txID, r, err := execute(ctx, tx.s.id, tx.s.queryServiceClient, q, settings, resultOpts...)
+	txID, r, err := tx.s.execute(ctx, q, settings, resultOpts...)
 	if err != nil {
 		return nil, xerrors.WithStackTrace(err)
 	}
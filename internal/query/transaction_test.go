@@ -513,6 +513,10 @@ func (s testExecuteSettings) StatsCallback() func(stats stats.QueryStats) {
 	return nil
 }
 
+func (s testExecuteSettings) ResultSetFormat() options.ResultSetFormat {
+	return 0
+}
+
 func (s testExecuteSettings) ExecMode() options.ExecMode {
 	return s.execMode
 }
@@ -64,6 +64,7 @@ func (c *Client) CreateResource(
 		retry.WithIdempotent(true),
 		retry.WithTrace(c.config.TraceRetry()),
 		retry.WithBudget(c.config.RetryBudget()),
+		retry.WithPolicy(c.config.RetryPolicy()),
 	)
 }
 
@@ -114,6 +115,7 @@ func (c *Client) AlterResource(
 		retry.WithIdempotent(true),
 		retry.WithTrace(c.config.TraceRetry()),
 		retry.WithBudget(c.config.RetryBudget()),
+		retry.WithPolicy(c.config.RetryPolicy()),
 	)
 }
 
@@ -164,6 +166,7 @@ func (c *Client) DropResource(
 		retry.WithIdempotent(true),
 		retry.WithTrace(c.config.TraceRetry()),
 		retry.WithBudget(c.config.RetryBudget()),
+		retry.WithPolicy(c.config.RetryPolicy()),
 	)
 }
 
@@ -210,6 +213,7 @@ func (c *Client) ListResource(
 		retry.WithStackTrace(),
 		retry.WithTrace(c.config.TraceRetry()),
 		retry.WithBudget(c.config.RetryBudget()),
+		retry.WithPolicy(c.config.RetryPolicy()),
 	)
 
 	return list, err
@@ -270,6 +274,7 @@ func (c *Client) DescribeResource(
 		retry.WithStackTrace(),
 		retry.WithTrace(c.config.TraceRetry()),
 		retry.WithBudget(c.config.RetryBudget()),
+		retry.WithPolicy(c.config.RetryPolicy()),
 	)
 
 	return
@@ -339,6 +344,7 @@ func (c *Client) AcquireResource(
 		retry.WithStackTrace(),
 		retry.WithTrace(c.config.TraceRetry()),
 		retry.WithBudget(c.config.RetryBudget()),
+		retry.WithPolicy(c.config.RetryPolicy()),
 	)
 }
 
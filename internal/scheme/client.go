@@ -65,6 +65,7 @@ func (c *Client) MakeDirectory(ctx context.Context, path string) (finalErr error
 		retry.WithIdempotent(true),
 		retry.WithTrace(c.config.TraceRetry()),
 		retry.WithBudget(c.config.RetryBudget()),
+		retry.WithPolicy(c.config.RetryPolicy()),
 	)
 }
 
@@ -105,6 +106,7 @@ func (c *Client) RemoveDirectory(ctx context.Context, path string) (finalErr err
 		retry.WithIdempotent(true),
 		retry.WithTrace(c.config.TraceRetry()),
 		retry.WithBudget(c.config.RetryBudget()),
+		retry.WithPolicy(c.config.RetryPolicy()),
 	)
 }
 
@@ -147,6 +149,7 @@ func (c *Client) ListDirectory(ctx context.Context, path string) (d scheme.Direc
 		retry.WithStackTrace(),
 		retry.WithTrace(c.config.TraceRetry()),
 		retry.WithBudget(c.config.RetryBudget()),
+		retry.WithPolicy(c.config.RetryPolicy()),
 	)
 
 	return d, xerrors.WithStackTrace(err)
@@ -211,6 +214,7 @@ func (c *Client) DescribePath(ctx context.Context, path string) (e scheme.Entry,
 		retry.WithStackTrace(),
 		retry.WithTrace(c.config.TraceRetry()),
 		retry.WithBudget(c.config.RetryBudget()),
+		retry.WithPolicy(c.config.RetryPolicy()),
 	)
 
 	return e, xerrors.WithStackTrace(err)
@@ -273,6 +277,7 @@ func (c *Client) ModifyPermissions(
 		retry.WithIdempotent(true),
 		retry.WithTrace(c.config.TraceRetry()),
 		retry.WithBudget(c.config.RetryBudget()),
+		retry.WithPolicy(c.config.RetryPolicy()),
 	)
 }
 
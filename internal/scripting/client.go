@@ -61,6 +61,7 @@ func (c *Client) Execute(
 		retry.WithStackTrace(),
 		retry.WithTrace(c.config.TraceRetry()),
 		retry.WithBudget(c.config.RetryBudget()),
+		retry.WithPolicy(c.config.RetryPolicy()),
 	)
 
 	return r, xerrors.WithStackTrace(err)
@@ -141,6 +142,7 @@ func (c *Client) Explain(
 		retry.WithIdempotent(true),
 		retry.WithTrace(c.config.TraceRetry()),
 		retry.WithBudget(c.config.RetryBudget()),
+		retry.WithPolicy(c.config.RetryPolicy()),
 	)
 
 	return e, xerrors.WithStackTrace(err)
@@ -216,6 +218,7 @@ func (c *Client) StreamExecute(
 		retry.WithStackTrace(),
 		retry.WithTrace(c.config.TraceRetry()),
 		retry.WithBudget(c.config.RetryBudget()),
+		retry.WithPolicy(c.config.RetryPolicy()),
 	)
 
 	return r, xerrors.WithStackTrace(err)
@@ -9,6 +9,7 @@ import (
 	"github.com/jonboulle/clockwork"
 	"google.golang.org/grpc"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/endpoint"
 	metaHeaders "github.com/ydb-platform/ydb-go-sdk/v3/internal/meta"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/table/config"
@@ -312,10 +313,14 @@ func (c *Client) internalPoolCreateSession(ctx context.Context) (s *session, err
 		})
 	}()
 
-	s, err = c.createSession(
-		meta.WithAllowFeatures(ctx,
+	// session pinned to node by context must not be moved to other node by server-side balancer
+	if _, pinned := endpoint.ContextNodeID(ctx); !pinned {
+		ctx = meta.WithAllowFeatures(ctx,
 			metaHeaders.HintSessionBalancer,
-		),
+		)
+	}
+
+	s, err = c.createSession(ctx,
 		withCreateSessionOnCreate(func(s *session) {
 			c.mu.WithLock(func() {
 				c.index[s] = sessionInfo{
@@ -399,7 +404,7 @@ func (c *Client) internalPoolGet(ctx context.Context, opts ...getOption) (s *ses
 			return nil, xerrors.WithStackTrace(errClosedClient)
 		}
 
-		s = tryGetIdleSession(c)
+		s = tryGetIdleSession(ctx, c)
 		if s != nil {
 			if !s.isReady() {
 				closeInvalidSession(ctx, s)
@@ -443,9 +448,20 @@ func (c *Client) internalPoolGet(ctx context.Context, opts ...getOption) (s *ses
 	)
 }
 
-func tryGetIdleSession(c *Client) *session {
+// tryGetIdleSession returns first idle session. If context pinned to node, tryGetIdleSession
+// prefers idle session on this node or returns nil for create new session on this node while
+// pool is not full
+func tryGetIdleSession(ctx context.Context, c *Client) *session {
 	var s *session
 	c.mu.WithLock(func() {
+		if nodeID, pinned := endpoint.ContextNodeID(ctx); pinned {
+			if s = c.internalPoolRemoveIdleOnNode(nodeID); s != nil {
+				return
+			}
+			if c.createInProgress+len(c.index) < c.limit {
+				return
+			}
+		}
 		s = c.internalPoolRemoveFirstIdle()
 	})
 
@@ -834,6 +850,20 @@ func (c *Client) internalPoolRemoveFirstIdle() *session {
 	return s
 }
 
+// removes first idle session on node from idle same as internalPoolRemoveFirstIdle.
+// c.mu must be held.
+func (c *Client) internalPoolRemoveIdleOnNode(nodeID uint32) *session {
+	for el := c.idle.Front(); el != nil; el = el.Next() {
+		if s := el.Value; s.NodeID() == nodeID {
+			c.index[s] = c.internalPoolRemoveIdle(s)
+
+			return s
+		}
+	}
+
+	return nil
+}
+
 // c.mu must be held.
 func (c *Client) internalPoolNotify(s *session) (notified bool) {
 	for el := c.waitQ.Front(); el != nil; el = c.waitQ.Front() {
@@ -20,6 +20,7 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/closer"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/endpoint"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/table/config"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
@@ -671,6 +672,62 @@ func TestSessionPoolGetPut(t *testing.T) {
 	assertCreated(2)
 }
 
+func TestSessionPoolGetOnNode(t *testing.T) {
+	var nodeIDCounter uint32
+	p := newClientWithStubBuilder(
+		t,
+		testutil.NewBalancer(
+			testutil.WithInvokeHandlers(
+				testutil.InvokeHandlers{
+					testutil.TableCreateSession: func(interface{}) (proto.Message, error) {
+						nodeIDCounter++
+
+						return &Ydb_Table.CreateSessionResult{
+							SessionId: testutil.SessionID(testutil.WithNodeID(nodeIDCounter)),
+						}, nil
+					},
+					testutil.TableDeleteSession: func(interface{}) (proto.Message, error) {
+						return nil, nil
+					},
+				},
+			),
+		),
+		0,
+		config.WithSizeLimit(3),
+	)
+	defer func() {
+		_ = p.Close(context.Background())
+	}()
+
+	s1 := mustGetSession(t, p)
+	s2 := mustGetSession(t, p)
+	require.Equal(t, uint32(1), s1.NodeID())
+	require.Equal(t, uint32(2), s2.NodeID())
+	mustPutSession(t, p, s1)
+	mustPutSession(t, p, s2)
+
+	t.Run("IdleSessionOnNode", func(t *testing.T) {
+		s, err := p.Get(endpoint.WithNodeID(context.Background(), 2))
+		require.NoError(t, err)
+		require.Same(t, s2, s)
+		mustPutSession(t, p, s)
+	})
+	t.Run("NewSessionOnNode", func(t *testing.T) {
+		s, err := p.Get(endpoint.WithNodeID(context.Background(), 3))
+		require.NoError(t, err)
+		require.Equal(t, uint32(3), s.NodeID())
+		require.Equal(t, uint32(3), nodeIDCounter)
+		mustPutSession(t, p, s)
+	})
+	t.Run("FullPool", func(t *testing.T) {
+		s, err := p.Get(endpoint.WithNodeID(context.Background(), 4))
+		require.NoError(t, err)
+		require.Equal(t, uint32(3), nodeIDCounter)
+		require.NotEqual(t, uint32(4), s.NodeID())
+		mustPutSession(t, p, s)
+	})
+}
+
 func TestSessionPoolCloseIdleSessions(t *testing.T) {
 	xtest.TestManyTimes(t, func(t testing.TB) {
 		var (
@@ -5,6 +5,7 @@ import (
 
 	"github.com/jonboulle/clockwork"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/interceptors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/config"
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
@@ -134,6 +135,13 @@ func WithDeleteTimeout(deleteTimeout time.Duration) Option {
 	}
 }
 
+// WithInterceptors appends interceptors of queries to early defined interceptors
+func WithInterceptors(list ...interceptors.Interceptor) Option {
+	return func(c *Config) {
+		c.interceptor = interceptors.Merge(append([]interceptors.Interceptor{c.interceptor}, list...)...)
+	}
+}
+
 // WithTrace appends table trace to early defined traces
 func WithTrace(trace *trace.Table, opts ...trace.TableComposeOption) Option {
 	return func(c *Config) {
@@ -169,6 +177,8 @@ type Config struct {
 
 	trace *trace.Table
 
+	interceptor interceptors.Interceptor
+
 	clock clockwork.Clock
 }
 
@@ -177,6 +187,11 @@ func (c *Config) Trace() *trace.Table {
 	return c.trace
 }
 
+// Interceptor returns merged interceptors of queries or nil if interceptors not defined
+func (c *Config) Interceptor() interceptors.Interceptor {
+	return c.interceptor
+}
+
 // Clock defines clock
 func (c *Config) Clock() clockwork.Clock {
 	return c.clock
@@ -30,6 +30,15 @@ var (
 
 	// errParamsRequired returned by a Client instance to indicate that required params is not defined
 	errParamsRequired = xerrors.Wrap(errors.New("params required"))
+
+	// errBulkUpsertNoData returned by BulkUpsert if neither rows nor pre-serialized data defined
+	errBulkUpsertNoData = xerrors.Wrap(errors.New("bulk upsert requires rows or data in CSV or Arrow format"))
+
+	// errBulkUpsertRowsAndData returned by BulkUpsert if both rows and pre-serialized data defined
+	errBulkUpsertRowsAndData = xerrors.Wrap(errors.New("bulk upsert requires only one of rows or pre-serialized data"))
+
+	// errPreparedQueryRewritten returned by prepared statement if interceptor rewrites text of query
+	errPreparedQueryRewritten = xerrors.Wrap(errors.New("text of prepared query cannot be rewritten by interceptor"))
 )
 
 func isCreateSessionErrorRetriable(err error) bool {
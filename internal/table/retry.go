@@ -66,6 +66,8 @@ func retryBackoff(
 	op table.Operation,
 	opts ...retry.Option,
 ) error {
+	policy := retry.PolicyFromOptions(opts...)
+
 	return retry.Retry(ctx,
 		func(ctx context.Context) (err error) {
 			var s *session
@@ -80,7 +82,7 @@ func retryBackoff(
 			}()
 
 			if err = op(ctx, s); err != nil {
-				s.checkError(err)
+				s.checkError(err, policy)
 
 				return xerrors.WithStackTrace(err)
 			}
@@ -100,6 +102,7 @@ func (c *Client) retryOptions(opts ...table.Option) *table.Options {
 		RetryOptions: []retry.Option{
 			retry.WithTrace(c.config.TraceRetry()),
 			retry.WithBudget(c.config.RetryBudget()),
+			retry.WithPolicy(c.config.RetryPolicy()),
 		},
 	}
 	for _, opt := range opts {
@@ -574,3 +574,73 @@ var (
 	errUnexpectedSession = xerrors.Wrap(fmt.Errorf("unexpected session"))
 	errSessionOverflow   = xerrors.Wrap(fmt.Errorf("session overflow"))
 )
+
+func TestDoSessionInvalidationByRetryPolicy(t *testing.T) {
+	deleteSession, keepSession := true, false
+	policy := &retry.Policy{
+		Statuses: map[string]retry.PolicyRule{
+			"OVERLOADED":      {Retry: true, Backoff: retry.BackoffNone, DeleteSession: &deleteSession},
+			"SESSION_EXPIRED": {Retry: true, Backoff: retry.BackoffNone, DeleteSession: &keepSession},
+			"BAD_SESSION":     {Retry: true, Backoff: retry.BackoffNone},
+		},
+	}
+	for _, tt := range []struct {
+		name    string
+		err     error
+		closing bool
+	}{
+		{
+			name:    "DeleteByPolicy",
+			err:     xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_OVERLOADED)),
+			closing: true,
+		},
+		{
+			name:    "KeepByPolicy",
+			err:     xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_SESSION_EXPIRED)),
+			closing: false,
+		},
+		{
+			name:    "DefaultRulesWithoutPolicyDeleteSession",
+			err:     xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_BAD_SESSION)),
+			closing: true,
+		},
+		{
+			name:    "DefaultRules",
+			err:     xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_SESSION_BUSY)),
+			closing: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			s := simpleSession(t)
+			attempts := 0
+			p := SessionProviderFunc{
+				OnGet: func(ctx context.Context) (*session, error) {
+					return s, nil
+				},
+				OnPut: func(ctx context.Context, s *session) error {
+					return nil
+				},
+			}
+			err := retryBackoff(context.Background(), p,
+				func(ctx context.Context, _ table.Session) error {
+					attempts++
+					if attempts == 1 {
+						return tt.err
+					}
+
+					return nil
+				},
+				retry.WithPolicy(policy),
+			)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if attempts != 2 {
+				t.Fatalf("unexpected attempts: %d", attempts)
+			}
+			if s.isClosing() != tt.closing {
+				t.Fatalf("unexpected closing status: %v", s.isClosing())
+			}
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_TableStats"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/interceptor"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stats"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsync"
@@ -62,6 +63,8 @@ func (r *unaryResult) Err() error {
 // Close closes the result, preventing further iteration.
 func (r *unaryResult) Close() error {
 	if r.closed.CompareAndSwap(false, true) {
+		r.observer.Done(r.Err())
+
 		return nil
 	}
 
@@ -104,6 +107,13 @@ func WithMarkTruncatedAsRetryable() option {
 	}
 }
 
+// WithObserver notifies observer of intercepted call about rows and finish of reading of result
+func WithObserver(observer *interceptor.Observer) option {
+	return func(r *baseResult) {
+		r.valueScanner.observer = observer
+	}
+}
+
 func NewStream(
 	ctx context.Context,
 	recv func(ctx context.Context) (*Ydb.ResultSet, *Ydb_TableStats.QueryStats, error),
@@ -154,6 +164,8 @@ func (r *unaryResult) NextResultSetErr(ctx context.Context, columns ...string) (
 		return xerrors.WithStackTrace(errAlreadyClosed)
 	}
 	if !r.HasNextResultSet() {
+		r.observer.Done(r.Err())
+
 		return io.EOF
 	}
 	r.Reset(r.sets[r.nextSet], columns...)
@@ -175,6 +187,7 @@ func (r *streamResult) nextResultSetErr(ctx context.Context, columns ...string)
 	}
 	s, stats, err := r.recv(ctx)
 	if err != nil {
+		r.observer.Done(err)
 		r.Reset(nil)
 		if xerrors.Is(err, io.EOF) {
 			return err
@@ -230,6 +243,8 @@ func (r *baseResult) Stats() stats.QueryStats {
 // Close closes the result, preventing further iteration.
 func (r *streamResult) Close() (err error) {
 	if r.closed.CompareAndSwap(false, true) {
+		r.observer.Done(r.Err())
+
 		return r.close(r.Err())
 	}
 
@@ -12,6 +12,7 @@ import (
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/decimal"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/interceptor"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/scanner"
 	internalTypes "github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
@@ -33,6 +34,7 @@ type valueScanner struct {
 	nextItem                 int
 	ignoreTruncated          bool
 	markTruncatedAsRetryable bool
+	observer                 *interceptor.Observer
 
 	columnIndexes []int
 
@@ -94,6 +96,7 @@ func (s *valueScanner) NextRow() bool {
 		return false
 	}
 	s.row = s.set.GetRows()[s.nextRow]
+	s.observer.Row(s.set.GetColumns(), s.row)
 	s.nextRow++
 	s.nextItem = 0
 	s.stack.reset()
@@ -16,10 +16,13 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/interceptors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/conn"
 	balancerContext "github.com/ydb-platform/ydb-go-sdk/v3/internal/endpoint"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/feature"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/fingerprint"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/interceptor"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/meta"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/operation"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
@@ -415,6 +418,7 @@ func processTableStats(resStats *Ydb_Table.TableStats) *options.TableStats {
 	for i, v := range resStats.GetPartitionStats() {
 		partStats[i].RowsEstimate = v.GetRowsEstimate()
 		partStats[i].StoreSize = v.GetStoreSize()
+		partStats[i].LeaderNodeID = v.GetLeaderNodeId()
 	}
 
 	var creationTime, modificationTime time.Time
@@ -513,10 +517,17 @@ func (s *session) DropTable(
 	return xerrors.WithStackTrace(err)
 }
 
-func (s *session) checkError(err error) {
+func (s *session) checkError(err error, policy *retry.Policy) {
 	if err == nil {
 		return
 	}
+	if mustDelete, ok := policy.MustDeleteSession(err); ok {
+		if mustDelete {
+			s.SetStatus(table.SessionClosing)
+		}
+
+		return
+	}
 	if m := retry.Check(err); m.MustDeleteSession() {
 		s.SetStatus(table.SessionClosing)
 	}
@@ -696,7 +707,7 @@ func (s *session) Explain(
 		onDone   = trace.TableOnSessionQueryExplain(
 			s.config.Trace(), &ctx,
 			stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/v3/internal/table.(*session).Explain"),
-			s, query,
+			s, query, fingerprint.Stringer(query),
 		)
 	)
 	defer func() {
@@ -745,7 +756,7 @@ func (s *session) Prepare(ctx context.Context, queryText string) (_ table.Statem
 		onDone   = trace.TableOnSessionQueryPrepare(
 			s.config.Trace(), &ctx,
 			stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/v3/internal/table.(*session).Prepare"),
-			s, queryText,
+			s, queryText, fingerprint.Stringer(queryText),
 		)
 	)
 	defer func() {
@@ -796,6 +807,11 @@ func (s *session) Execute(
 ) (
 	txr table.Transaction, r result.Result, err error,
 ) {
+	query, parameters, observer, err := s.intercept(ctx, txControl, query, parameters)
+	if err != nil {
+		return nil, nil, xerrors.WithStackTrace(err)
+	}
+
 	var (
 		a       = allocator.New()
 		q       = queryFromText(query)
@@ -829,7 +845,7 @@ func (s *session) Execute(
 		s.config.Trace(), &ctx,
 		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/v3/internal/table.(*session).Execute"),
 		s, q, parameters,
-		request.QueryCachePolicy.GetKeepInCache(),
+		request.QueryCachePolicy.GetKeepInCache(), fingerprint.DataQuery{Query: q},
 	)
 	defer func() {
 		onDone(txr, false, r, err)
@@ -837,10 +853,40 @@ func (s *session) Execute(
 
 	result, err := s.executeDataQuery(ctx, a, request.ExecuteDataQueryRequest, callOptions...)
 	if err != nil {
+		observer.Done(err)
+
 		return nil, nil, xerrors.WithStackTrace(err)
 	}
 
-	return s.executeQueryResult(result, request.TxControl, request.IgnoreTruncated)
+	return s.executeQueryResult(result, request.TxControl, request.IgnoreTruncated, observer)
+}
+
+// intercept calls interceptors of queries from client config
+func (s *session) intercept(
+	ctx context.Context,
+	txControl *table.TransactionControl,
+	query string,
+	parameters *params.Parameters,
+) (
+	_ string, _ *params.Parameters, _ *interceptor.Observer, err error,
+) {
+	if !interceptor.Enabled(ctx, s.config.Interceptor()) {
+		return query, parameters, nil, nil
+	}
+
+	call := &interceptors.Call{
+		Client:    interceptors.TableClient,
+		Query:     query,
+		Params:    parameters,
+		TxControl: interceptor.TxControl(txControl.Desc(), txControl.Desc().GetBeginTx()),
+	}
+
+	observer, err := interceptor.Intercept(ctx, s.config.Interceptor(), call)
+	if err != nil {
+		return "", nil, nil, xerrors.WithStackTrace(err)
+	}
+
+	return call.Query, call.Params, observer, nil
 }
 
 // executeQueryResult returns Transaction and result built from received
@@ -849,6 +895,7 @@ func (s *session) executeQueryResult(
 	res *Ydb_Table.ExecuteQueryResult,
 	txControl *Ydb_Table.TransactionControl,
 	ignoreTruncated bool,
+	observer *interceptor.Observer,
 ) (
 	table.Transaction, result.Result, error,
 ) {
@@ -867,6 +914,7 @@ func (s *session) executeQueryResult(
 		res.GetResultSets(),
 		res.GetQueryStats(),
 		scanner.WithIgnoreTruncated(ignoreTruncated),
+		scanner.WithObserver(observer),
 	), nil
 }
 
@@ -1187,13 +1235,18 @@ func (s *session) StreamExecuteScanQuery(
 	parameters *params.Parameters,
 	opts ...options.ExecuteScanQueryOption,
 ) (_ result.StreamResult, err error) {
+	query, parameters, observer, err := s.intercept(ctx, nil, query, parameters)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
 	var (
 		a      = allocator.New()
 		q      = queryFromText(query)
 		onDone = trace.TableOnSessionQueryStreamExecute(
 			s.config.Trace(), &ctx,
 			stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/v3/internal/table.(*session).StreamExecuteScanQuery"),
-			s, q, parameters,
+			s, q, parameters, fingerprint.DataQuery{Query: q},
 		)
 		request = Ydb_Table.ExecuteScanQueryRequest{
 			Query:      q.toYDB(a),
@@ -1219,6 +1272,7 @@ func (s *session) StreamExecuteScanQuery(
 	stream, err = s.tableService.StreamExecuteScanQuery(ctx, &request, callOptions...)
 	if err != nil {
 		cancel()
+		observer.Done(err)
 
 		return nil, xerrors.WithStackTrace(err)
 	}
@@ -1251,6 +1305,7 @@ func (s *session) StreamExecuteScanQuery(
 		},
 		scanner.WithIgnoreTruncated(s.config.IgnoreTruncated()),
 		scanner.WithMarkTruncatedAsRetryable(),
+		scanner.WithObserver(observer),
 	)
 }
 
@@ -1272,25 +1327,37 @@ func (s *session) BulkUpsert(ctx context.Context, table string, rows value.Value
 		onDone(err)
 	}()
 
+	request := &Ydb_Table.BulkUpsertRequest{
+		Table: table,
+		OperationParams: operation.Params(
+			ctx,
+			s.config.OperationTimeout(),
+			s.config.OperationCancelAfter(),
+			operation.ModeSync,
+		),
+	}
+
+	if rows != nil {
+		request.Rows = value.ToYDB(rows, a)
+	}
+
 	for _, opt := range opts {
 		if opt != nil {
 			callOptions = append(callOptions, opt.ApplyBulkUpsertOption()...)
+			if formatOpt, has := opt.(options.BulkUpsertFormatOption); has {
+				formatOpt.ApplyBulkUpsertFormatOption((*options.BulkUpsertDesc)(request))
+			}
 		}
 	}
 
-	_, err = s.tableService.BulkUpsert(ctx,
-		&Ydb_Table.BulkUpsertRequest{
-			Table: table,
-			Rows:  value.ToYDB(rows, a),
-			OperationParams: operation.Params(
-				ctx,
-				s.config.OperationTimeout(),
-				s.config.OperationCancelAfter(),
-				operation.ModeSync,
-			),
-		},
-		callOptions...,
-	)
+	switch {
+	case request.GetRows() == nil && request.GetDataFormat() == nil:
+		return xerrors.WithStackTrace(errBulkUpsertNoData)
+	case request.GetRows() != nil && request.GetDataFormat() != nil:
+		return xerrors.WithStackTrace(errBulkUpsertRowsAndData)
+	}
+
+	_, err = s.tableService.BulkUpsert(ctx, request, callOptions...)
 	if err != nil {
 		return xerrors.WithStackTrace(err)
 	}
@@ -8,6 +8,8 @@ import (
 	"google.golang.org/grpc"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/fingerprint"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/interceptor"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/operation"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
@@ -32,6 +34,16 @@ func (s *statement) Execute(
 ) (
 	txr table.Transaction, r result.Result, err error,
 ) {
+	text, parameters, observer, err := s.session.intercept(ctx, txControl, s.query.YQL(), parameters)
+	if err != nil {
+		return nil, nil, xerrors.WithStackTrace(err)
+	}
+	if text != s.query.YQL() {
+		observer.Done(errPreparedQueryRewritten)
+
+		return nil, nil, xerrors.WithStackTrace(errPreparedQueryRewritten)
+	}
+
 	var (
 		a       = allocator.New()
 		request = options.ExecuteDataQueryDesc{
@@ -64,29 +76,31 @@ func (s *statement) Execute(
 		s.session.config.Trace(), &ctx,
 		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/v3/internal/table.(*statement).Execute"),
 		s.session, s.query, parameters,
-		request.QueryCachePolicy.GetKeepInCache(),
+		request.QueryCachePolicy.GetKeepInCache(), fingerprint.DataQuery{Query: s.query},
 	)
 	defer func() {
 		onDone(txr, true, r, err)
 	}()
 
-	return s.execute(ctx, a, &request, request.TxControl, callOptions...)
+	return s.execute(ctx, a, &request, request.TxControl, observer, callOptions...)
 }
 
 // execute executes prepared query without any tracing.
 func (s *statement) execute(
 	ctx context.Context, a *allocator.Allocator,
 	request *options.ExecuteDataQueryDesc, txControl *Ydb_Table.TransactionControl,
-	callOptions ...grpc.CallOption,
+	observer *interceptor.Observer, callOptions ...grpc.CallOption,
 ) (
 	txr table.Transaction, r result.Result, err error,
 ) {
 	res, err := s.session.executeDataQuery(ctx, a, request.ExecuteDataQueryRequest, callOptions...)
 	if err != nil {
+		observer.Done(err)
+
 		return nil, nil, xerrors.WithStackTrace(err)
 	}
 
-	return s.session.executeQueryResult(res, txControl, request.IgnoreTruncated)
+	return s.session.executeQueryResult(res, txControl, request.IgnoreTruncated, observer)
 }
 
 func (s *statement) NumInput() int {
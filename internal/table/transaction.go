@@ -8,6 +8,7 @@ import (
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/fingerprint"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/operation"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
@@ -64,7 +65,7 @@ func (tx *transaction) Execute(
 	onDone := trace.TableOnTxExecute(
 		tx.s.config.Trace(), &ctx,
 		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/v3/internal/table.(*transaction).Execute"),
-		tx.s, tx, queryFromText(query), parameters,
+		tx.s, tx, queryFromText(query), parameters, fingerprint.Stringer(query),
 	)
 	defer func() {
 		onDone(r, err)
@@ -106,7 +107,7 @@ func (tx *transaction) ExecuteStatement(
 	onDone := trace.TableOnTxExecuteStatement(
 		tx.s.config.Trace(), &ctx,
 		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/v3/internal/table.(*transaction).ExecuteStatement"),
-		tx.s, tx, val.query, parameters,
+		tx.s, tx, val.query, parameters, fingerprint.DataQuery{Query: val.query},
 	)
 	defer func() {
 		onDone(r, err)
@@ -91,6 +91,7 @@ func (c *Client) Alter(ctx context.Context, path string, opts ...topicoptions.Al
 			retry.WithIdempotent(true),
 			retry.WithTrace(c.cfg.TraceRetry()),
 			retry.WithBudget(c.cfg.RetryBudget()),
+			retry.WithPolicy(c.cfg.RetryPolicy()),
 		)
 	}
 
@@ -124,6 +125,7 @@ func (c *Client) Create(
 			retry.WithIdempotent(true),
 			retry.WithTrace(c.cfg.TraceRetry()),
 			retry.WithBudget(c.cfg.RetryBudget()),
+			retry.WithPolicy(c.cfg.RetryPolicy()),
 		)
 	}
 
@@ -162,6 +164,55 @@ func (c *Client) Describe(
 			retry.WithIdempotent(true),
 			retry.WithTrace(c.cfg.TraceRetry()),
 			retry.WithBudget(c.cfg.RetryBudget()),
+			retry.WithPolicy(c.cfg.RetryPolicy()),
+		)
+	} else {
+		err = call(ctx)
+	}
+
+	if err != nil {
+		return res, err
+	}
+
+	res.FromRaw(&rawRes)
+
+	return res, nil
+}
+
+// DescribeConsumer describe topic consumer
+func (c *Client) DescribeConsumer(
+	ctx context.Context,
+	path, consumer string,
+	opts ...topicoptions.DescribeConsumerOption,
+) (res topictypes.TopicConsumerDescription, _ error) {
+	req := rawtopic.DescribeConsumerRequest{
+		OperationParams: c.defaultOperationParams,
+		Path:            path,
+		Consumer:        consumer,
+	}
+
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&req)
+		}
+	}
+
+	var rawRes rawtopic.DescribeConsumerResult
+
+	call := func(ctx context.Context) (describeErr error) {
+		rawRes, describeErr = c.rawClient.DescribeConsumer(ctx, req)
+
+		return describeErr
+	}
+
+	var err error
+
+	if c.cfg.AutoRetry() {
+		err = retry.Retry(ctx, call,
+			retry.WithIdempotent(true),
+			retry.WithTrace(c.cfg.TraceRetry()),
+			retry.WithBudget(c.cfg.RetryBudget()),
+			retry.WithPolicy(c.cfg.RetryPolicy()),
 		)
 	} else {
 		err = call(ctx)
@@ -199,6 +250,7 @@ func (c *Client) Drop(ctx context.Context, path string, opts ...topicoptions.Dro
 			retry.WithIdempotent(true),
 			retry.WithTrace(c.cfg.TraceRetry()),
 			retry.WithBudget(c.cfg.RetryBudget()),
+			retry.WithPolicy(c.cfg.RetryPolicy()),
 		)
 	}
 
@@ -0,0 +1,141 @@
+package topicreadercommon
+
+import (
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopiccommon"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsync"
+)
+
+// PartitionKey identify partition of topic independent of partition sessions
+type PartitionKey struct {
+	Topic       string
+	PartitionID int64
+}
+
+// PublicSeekPosition is position in partition for start read from
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type PublicSeekPosition struct {
+	offset    int64
+	writtenAt time.Time
+	byTime    bool
+}
+
+// NewSeekPositionOffset create position for start read from the message with offset
+func NewSeekPositionOffset(offset int64) PublicSeekPosition {
+	return PublicSeekPosition{offset: offset}
+}
+
+// NewSeekPositionTime create position for start read from first message written at t or later
+func NewSeekPositionTime(t time.Time) PublicSeekPosition {
+	return PublicSeekPosition{writtenAt: t, byTime: true}
+}
+
+func (p PublicSeekPosition) Offset() (offset rawtopiccommon.Offset, ok bool) {
+	if p.byTime {
+		return 0, false
+	}
+	offset.FromInt64(p.offset)
+
+	return offset, true
+}
+
+func (p PublicSeekPosition) WrittenAt() (t time.Time, ok bool) {
+	return p.writtenAt, p.byTime
+}
+
+// PartitionControl store partitions states, requested by user: pause flags and positions for start read from.
+// It shared between all streams of one reader and keep the state over reconnections.
+type PartitionControl struct {
+	m xsync.Mutex
+
+	paused    map[PartitionKey]bool
+	positions map[PartitionKey]PublicSeekPosition
+
+	// dropped mark positions, saved by OnDropPaused
+	dropped map[PartitionKey]bool
+}
+
+func NewPartitionControl() *PartitionControl {
+	return &PartitionControl{
+		paused:    make(map[PartitionKey]bool),
+		positions: make(map[PartitionKey]PublicSeekPosition),
+		dropped:   make(map[PartitionKey]bool),
+	}
+}
+
+func (c *PartitionControl) Pause(key PartitionKey) {
+	c.m.WithLock(func() {
+		c.paused[key] = true
+	})
+}
+
+// Resume remove pause flag of the partition
+func (c *PartitionControl) Resume(key PartitionKey) {
+	c.m.WithLock(func() {
+		delete(c.paused, key)
+	})
+}
+
+func (c *PartitionControl) IsPaused(key PartitionKey) (paused bool) {
+	c.m.WithLock(func() {
+		paused = c.paused[key]
+	})
+
+	return paused
+}
+
+// Seek set position for next partition session of the partition
+func (c *PartitionControl) Seek(key PartitionKey, pos PublicSeekPosition) {
+	c.m.WithLock(func() {
+		c.positions[key] = pos
+		delete(c.dropped, key)
+	})
+}
+
+// OnDropPaused save offset of first dropped message of paused partition for re-read it after resume.
+// Offset ignored if user set start position of the partition. Messages may be dropped in any order,
+// so position of dropped messages moves back to the least offset.
+func (c *PartitionControl) OnDropPaused(key PartitionKey, offset rawtopiccommon.Offset) {
+	c.m.WithLock(func() {
+		pos, ok := c.positions[key]
+		if ok && !c.dropped[key] {
+			return
+		}
+		if posOffset, _ := pos.Offset(); ok && posOffset <= offset {
+			return
+		}
+		c.positions[key] = NewSeekPositionOffset(offset.ToInt64())
+		c.dropped[key] = true
+	})
+}
+
+// HasStartPosition return true if the partition has start position for next partition session
+func (c *PartitionControl) HasStartPosition(key PartitionKey) (ok bool) {
+	c.m.WithLock(func() {
+		_, ok = c.positions[key]
+	})
+
+	return ok
+}
+
+// Restore set position back for next partition session, if user doesn't set new position of the partition
+func (c *PartitionControl) Restore(key PartitionKey, pos PublicSeekPosition) {
+	c.m.WithLock(func() {
+		if _, ok := c.positions[key]; !ok {
+			c.positions[key] = pos
+		}
+	})
+}
+
+// TakeStartPosition return and remove start position of the partition
+func (c *PartitionControl) TakeStartPosition(key PartitionKey) (pos PublicSeekPosition, ok bool) {
+	c.m.WithLock(func() {
+		pos, ok = c.positions[key]
+		delete(c.positions, key)
+		delete(c.dropped, key)
+	})
+
+	return pos, ok
+}
@@ -0,0 +1,59 @@
+package topicreadercommon
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopiccommon"
+)
+
+func TestPartitionControl(t *testing.T) {
+	key := PartitionKey{Topic: "/test", PartitionID: 1}
+
+	t.Run("PauseResume", func(t *testing.T) {
+		c := NewPartitionControl()
+		require.False(t, c.IsPaused(key))
+
+		c.Pause(key)
+		require.True(t, c.IsPaused(key))
+		require.False(t, c.IsPaused(PartitionKey{Topic: "/test", PartitionID: 2}))
+
+		c.Resume(key)
+		require.False(t, c.IsPaused(key))
+	})
+	t.Run("ResumeAfterDrop", func(t *testing.T) {
+		c := NewPartitionControl()
+		c.Pause(key)
+		c.OnDropPaused(key, 20)
+		c.OnDropPaused(key, 10)
+		c.OnDropPaused(key, 30)
+		c.Resume(key)
+		require.True(t, c.HasStartPosition(key))
+
+		pos, ok := c.TakeStartPosition(key)
+		require.True(t, ok)
+		offset, ok := pos.Offset()
+		require.True(t, ok)
+		require.Equal(t, rawtopiccommon.Offset(10), offset)
+
+		_, ok = c.TakeStartPosition(key)
+		require.False(t, ok)
+	})
+	t.Run("SeekOverrideDropped", func(t *testing.T) {
+		c := NewPartitionControl()
+		c.Pause(key)
+		c.OnDropPaused(key, 10)
+		c.Seek(key, NewSeekPositionTime(time.Unix(1, 0)))
+		c.OnDropPaused(key, 5)
+
+		pos, ok := c.TakeStartPosition(key)
+		require.True(t, ok)
+		_, ok = pos.Offset()
+		require.False(t, ok)
+		writtenAt, ok := pos.WrittenAt()
+		require.True(t, ok)
+		require.Equal(t, time.Unix(1, 0), writtenAt)
+	})
+}
@@ -71,6 +71,20 @@ func (c *PartitionSessionStorage) GetAll() []*PartitionSession {
 	return res
 }
 
+// FindActive return not removed session of the partition
+func (c *PartitionSessionStorage) FindActive(key PartitionKey) (*PartitionSession, bool) {
+	c.m.RLock()
+	defer c.m.RUnlock()
+
+	for _, info := range c.sessions {
+		if info.Session != nil && info.RemoveTime.IsZero() && info.Session.PartitionKey() == key {
+			return info.Session, true
+		}
+	}
+
+	return nil, false
+}
+
 func (c *PartitionSessionStorage) Remove(id rawtopicreader.PartitionSessionID) (*PartitionSession, error) {
 	now := time.Now()
 	c.m.Lock()
@@ -23,6 +23,9 @@ type PartitionSession struct {
 
 	lastReceivedOffsetEndVal atomic.Int64
 	committedOffsetVal       atomic.Int64
+	partitionOffsetsStart    rawtopiccommon.Offset
+
+	seek seekFilter
 }
 
 func NewPartitionSession(
@@ -102,6 +105,20 @@ func (s *PartitionSession) SetLastReceivedMessageOffset(v rawtopiccommon.Offset)
 	s.lastReceivedOffsetEndVal.Store(v.ToInt64())
 }
 
+// PartitionOffsetsStart return offset of first message of the partition, available on start of the session
+func (s *PartitionSession) PartitionOffsetsStart() rawtopiccommon.Offset {
+	return s.partitionOffsetsStart
+}
+
+// SetPartitionOffsetsStart must be called before use session by other goroutines
+func (s *PartitionSession) SetPartitionOffsetsStart(offset rawtopiccommon.Offset) {
+	s.partitionOffsetsStart = offset
+}
+
+func (s *PartitionSession) PartitionKey() PartitionKey {
+	return PartitionKey{Topic: s.Topic, PartitionID: s.PartitionID}
+}
+
 func (s *PartitionSession) ToPublic() PublicPartitionSession {
 	return PublicPartitionSession{
 		PartitionSessionID: s.ClientPartitionSessionID,
@@ -0,0 +1,138 @@
+package topicreadercommon
+
+import (
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopiccommon"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopicreader"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsync"
+)
+
+// seekFilter skip messages of partition session before seek position
+type seekFilter struct {
+	m xsync.Mutex
+
+	// skip messages with offset less than skipOffsetBefore, 0 mean no skip
+	skipOffsetBefore rawtopiccommon.Offset
+
+	// skip batches written before skipWrittenBefore, zero mean no skip
+	skipWrittenBefore time.Time
+
+	// messages from checkWrittenFrom offset to first received message may be written after skipWrittenBefore,
+	// first received batch checks it
+	checkWrittenFrom    rawtopiccommon.Offset
+	checkWrittenEnabled bool
+
+	lastReceivedWrittenAt time.Time
+
+	// partition session wait restart by server, all messages are skipped
+	restarting      bool
+	rewind          bool
+	rewindWrittenAt time.Time
+}
+
+// SkipMessagesBefore set filter for skip messages with offset less than offset
+func (s *PartitionSession) SkipMessagesBefore(offset rawtopiccommon.Offset) {
+	s.seek.m.WithLock(func() {
+		s.seek.skipOffsetBefore = offset
+	})
+}
+
+// SkipMessagesWrittenBefore set filter for skip messages, written before t, until first message written at t or later.
+//
+// If checkFrom is not nil - messages from checkFrom offset are unknown for the reader and may be written at t
+// or later. If first received message written at t or later and its offset greater than checkFrom - partition
+// session must be rewound to first message written at t or later (see TakeRewind).
+func (s *PartitionSession) SkipMessagesWrittenBefore(t time.Time, checkFrom *rawtopiccommon.Offset) {
+	s.seek.m.WithLock(func() {
+		s.seek.skipWrittenBefore = t
+		s.seek.checkWrittenEnabled = checkFrom != nil
+		if checkFrom != nil {
+			s.seek.checkWrittenFrom = *checkFrom
+		}
+	})
+}
+
+// LastReceivedWrittenAt return write time of last received messages batch, zero if nothing received
+func (s *PartitionSession) LastReceivedWrittenAt() (writtenAt time.Time) {
+	s.seek.m.WithLock(func() {
+		writtenAt = s.seek.lastReceivedWrittenAt
+	})
+
+	return writtenAt
+}
+
+// StartRestart mark session as waiting restart by server: all next messages of the session are skipped
+// and commits to the session are rejected
+func (s *PartitionSession) StartRestart() {
+	s.seek.m.WithLock(func() {
+		s.seek.restarting = true
+	})
+	s.Close()
+}
+
+// TakeRewind return and remove time for rewind of partition, requested by filter of messages
+func (s *PartitionSession) TakeRewind() (writtenAt time.Time, ok bool) {
+	s.seek.m.WithLock(func() {
+		if s.seek.rewind {
+			writtenAt, ok = s.seek.rewindWrittenAt, true
+			s.seek.rewind = false
+		}
+	})
+
+	return writtenAt, ok
+}
+
+// filterBatch remove messages before seek position from the batch and return true if whole batch must be skipped
+func (s *PartitionSession) filterBatch(b *rawtopicreader.Batch) (skip bool) {
+	s.seek.m.WithLock(func() {
+		skip = s.seek.filterBatchNeedLock(b)
+	})
+
+	return skip
+}
+
+func (f *seekFilter) filterBatchNeedLock(b *rawtopicreader.Batch) bool {
+	if f.restarting {
+		return true
+	}
+
+	if f.checkWrittenEnabled && len(b.MessageData) > 0 {
+		f.checkWrittenEnabled = false
+		if !b.WrittenAt.Before(f.skipWrittenBefore) && b.MessageData[0].Offset > f.checkWrittenFrom {
+			// previous messages may be written after seek time too, need read them
+			f.rewind = true
+			f.rewindWrittenAt = f.skipWrittenBefore
+			f.restarting = true
+
+			return true
+		}
+	}
+
+	if !f.skipWrittenBefore.IsZero() {
+		if b.WrittenAt.Before(f.skipWrittenBefore) {
+			return true
+		}
+
+		// messages in partition ordered by write time, filter not needed anymore
+		f.skipWrittenBefore = time.Time{}
+	}
+
+	if f.skipOffsetBefore > 0 && len(b.MessageData) > 0 {
+		skipped := 0
+		for skipped < len(b.MessageData) && b.MessageData[skipped].Offset < f.skipOffsetBefore {
+			skipped++
+		}
+		b.MessageData = b.MessageData[skipped:]
+		if len(b.MessageData) == 0 {
+			return true
+		}
+
+		// messages in partition ordered by offset, filter not needed anymore
+		f.skipOffsetBefore = 0
+	}
+
+	f.lastReceivedWrittenAt = b.WrittenAt
+
+	return false
+}
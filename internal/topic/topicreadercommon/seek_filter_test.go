@@ -0,0 +1,77 @@
+package topicreadercommon
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopiccommon"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopicreader"
+)
+
+func TestSeekFilter(t *testing.T) {
+	newSession := func() *PartitionSession {
+		return NewPartitionSession(context.Background(), "/test", 1, 2, "connection-id", 3, 4, 20)
+	}
+
+	t.Run("Offset", func(t *testing.T) {
+		session := newSession()
+		session.SkipMessagesBefore(22)
+
+		batch := rawtopicreader.Batch{MessageData: []rawtopicreader.MessageData{{Offset: 20}, {Offset: 21}}}
+		require.True(t, session.filterBatch(&batch))
+
+		batch = rawtopicreader.Batch{MessageData: []rawtopicreader.MessageData{{Offset: 21}, {Offset: 22}}}
+		require.False(t, session.filterBatch(&batch))
+		require.Len(t, batch.MessageData, 1)
+		require.Equal(t, rawtopiccommon.Offset(22), batch.MessageData[0].Offset)
+
+		// filter disabled after the position
+		batch = rawtopicreader.Batch{MessageData: []rawtopicreader.MessageData{{Offset: 10}}}
+		require.False(t, session.filterBatch(&batch))
+	})
+	t.Run("TimeBeforeReceived", func(t *testing.T) {
+		session := newSession()
+		checkFrom := rawtopiccommon.Offset(5)
+		session.SkipMessagesWrittenBefore(time.Unix(100, 0), &checkFrom)
+
+		batch := rawtopicreader.Batch{
+			WrittenAt:   time.Unix(200, 0),
+			MessageData: []rawtopicreader.MessageData{{Offset: 20}},
+		}
+		require.True(t, session.filterBatch(&batch))
+
+		writtenAt, ok := session.TakeRewind()
+		require.True(t, ok)
+		require.Equal(t, time.Unix(100, 0), writtenAt)
+
+		_, ok = session.TakeRewind()
+		require.False(t, ok)
+
+		// all messages skipped until restart of the session
+		batch = rawtopicreader.Batch{WrittenAt: time.Unix(300, 0), MessageData: []rawtopicreader.MessageData{{Offset: 21}}}
+		require.True(t, session.filterBatch(&batch))
+	})
+	t.Run("TimeAfterReceived", func(t *testing.T) {
+		session := newSession()
+		checkFrom := rawtopiccommon.Offset(5)
+		session.SkipMessagesWrittenBefore(time.Unix(100, 0), &checkFrom)
+
+		batch := rawtopicreader.Batch{
+			WrittenAt:   time.Unix(50, 0),
+			MessageData: []rawtopicreader.MessageData{{Offset: 20}},
+		}
+		require.True(t, session.filterBatch(&batch))
+		batch = rawtopicreader.Batch{
+			WrittenAt:   time.Unix(150, 0),
+			MessageData: []rawtopicreader.MessageData{{Offset: 21}},
+		}
+		require.False(t, session.filterBatch(&batch))
+		require.Equal(t, time.Unix(150, 0), session.LastReceivedWrittenAt())
+
+		_, ok := session.TakeRewind()
+		require.False(t, ok)
+	})
+}
@@ -13,6 +13,7 @@ func ReadRawBatchesToPublicBatches(
 	}
 
 	var batches []*PublicBatch
+	skipped := false
 	for pIndex := range msg.PartitionData {
 		p := &msg.PartitionData[pIndex]
 
@@ -23,6 +24,11 @@ func ReadRawBatchesToPublicBatches(
 		}
 
 		for bIndex := range p.Batches {
+			if session.filterBatch(&p.Batches[bIndex]) {
+				skipped = true
+
+				continue
+			}
 			batch, err := NewBatchFromStream(decoders, session, p.Batches[bIndex])
 			if err != nil {
 				return nil, err
@@ -31,6 +37,11 @@ func ReadRawBatchesToPublicBatches(
 		}
 	}
 
+	if skipped && len(batches) == 0 {
+		// all messages skipped, caller must free bytes of the response
+		return nil, nil
+	}
+
 	if err := splitBytesByMessagesInBatches(batches, msg.BytesSize); err != nil {
 		return nil, err
 	}
@@ -15,4 +15,8 @@ type batchedStreamReader interface {
 	Commit(ctx context.Context, commitRange topicreadercommon.CommitRange) error
 	CloseWithError(ctx context.Context, err error) error
 	PopBatchTx(ctx context.Context, tx tx.Transaction, opts ReadMessageBatchOptions) (*topicreadercommon.PublicBatch, error) //nolint:lll
+	PausePartitions(ctx context.Context, keys []topicreadercommon.PartitionKey) error
+	ResumePartitions(ctx context.Context, keys []topicreadercommon.PartitionKey) error
+	RestartPartitions(ctx context.Context, keys []topicreadercommon.PartitionKey) error
+	Assignments() []topicreadercommon.PublicPartitionSession
 }
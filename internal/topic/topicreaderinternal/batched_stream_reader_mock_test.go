@@ -38,6 +38,44 @@ func (m *MockbatchedStreamReader) EXPECT() *MockbatchedStreamReaderMockRecorder
 	return m.recorder
 }
 
+// Assignments mocks base method.
+func (m *MockbatchedStreamReader) Assignments() []topicreadercommon.PublicPartitionSession {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Assignments")
+	ret0, _ := ret[0].([]topicreadercommon.PublicPartitionSession)
+	return ret0
+}
+
+// Assignments indicates an expected call of Assignments.
+func (mr *MockbatchedStreamReaderMockRecorder) Assignments() *MockbatchedStreamReaderAssignmentsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Assignments", reflect.TypeOf((*MockbatchedStreamReader)(nil).Assignments))
+	return &MockbatchedStreamReaderAssignmentsCall{Call: call}
+}
+
+// MockbatchedStreamReaderAssignmentsCall wrap *gomock.Call
+type MockbatchedStreamReaderAssignmentsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockbatchedStreamReaderAssignmentsCall) Return(arg0 []topicreadercommon.PublicPartitionSession) *MockbatchedStreamReaderAssignmentsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockbatchedStreamReaderAssignmentsCall) Do(f func() []topicreadercommon.PublicPartitionSession) *MockbatchedStreamReaderAssignmentsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockbatchedStreamReaderAssignmentsCall) DoAndReturn(f func() []topicreadercommon.PublicPartitionSession) *MockbatchedStreamReaderAssignmentsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // CloseWithError mocks base method.
 func (m *MockbatchedStreamReader) CloseWithError(ctx context.Context, err error) error {
 	m.ctrl.T.Helper()
@@ -114,6 +152,44 @@ func (c *MockbatchedStreamReaderCommitCall) DoAndReturn(f func(context.Context,
 	return c
 }
 
+// PausePartitions mocks base method.
+func (m *MockbatchedStreamReader) PausePartitions(ctx context.Context, keys []topicreadercommon.PartitionKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PausePartitions", ctx, keys)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PausePartitions indicates an expected call of PausePartitions.
+func (mr *MockbatchedStreamReaderMockRecorder) PausePartitions(ctx, keys any) *MockbatchedStreamReaderPausePartitionsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PausePartitions", reflect.TypeOf((*MockbatchedStreamReader)(nil).PausePartitions), ctx, keys)
+	return &MockbatchedStreamReaderPausePartitionsCall{Call: call}
+}
+
+// MockbatchedStreamReaderPausePartitionsCall wrap *gomock.Call
+type MockbatchedStreamReaderPausePartitionsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockbatchedStreamReaderPausePartitionsCall) Return(arg0 error) *MockbatchedStreamReaderPausePartitionsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockbatchedStreamReaderPausePartitionsCall) Do(f func(context.Context, []topicreadercommon.PartitionKey) error) *MockbatchedStreamReaderPausePartitionsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockbatchedStreamReaderPausePartitionsCall) DoAndReturn(f func(context.Context, []topicreadercommon.PartitionKey) error) *MockbatchedStreamReaderPausePartitionsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // PopBatchTx mocks base method.
 func (m *MockbatchedStreamReader) PopBatchTx(ctx context.Context, tx tx.Transaction, opts ReadMessageBatchOptions) (*topicreadercommon.PublicBatch, error) {
 	m.ctrl.T.Helper()
@@ -192,6 +268,82 @@ func (c *MockbatchedStreamReaderReadMessageBatchCall) DoAndReturn(f func(context
 	return c
 }
 
+// RestartPartitions mocks base method.
+func (m *MockbatchedStreamReader) RestartPartitions(ctx context.Context, keys []topicreadercommon.PartitionKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestartPartitions", ctx, keys)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestartPartitions indicates an expected call of RestartPartitions.
+func (mr *MockbatchedStreamReaderMockRecorder) RestartPartitions(ctx, keys any) *MockbatchedStreamReaderRestartPartitionsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestartPartitions", reflect.TypeOf((*MockbatchedStreamReader)(nil).RestartPartitions), ctx, keys)
+	return &MockbatchedStreamReaderRestartPartitionsCall{Call: call}
+}
+
+// MockbatchedStreamReaderRestartPartitionsCall wrap *gomock.Call
+type MockbatchedStreamReaderRestartPartitionsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockbatchedStreamReaderRestartPartitionsCall) Return(arg0 error) *MockbatchedStreamReaderRestartPartitionsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockbatchedStreamReaderRestartPartitionsCall) Do(f func(context.Context, []topicreadercommon.PartitionKey) error) *MockbatchedStreamReaderRestartPartitionsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockbatchedStreamReaderRestartPartitionsCall) DoAndReturn(f func(context.Context, []topicreadercommon.PartitionKey) error) *MockbatchedStreamReaderRestartPartitionsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ResumePartitions mocks base method.
+func (m *MockbatchedStreamReader) ResumePartitions(ctx context.Context, keys []topicreadercommon.PartitionKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResumePartitions", ctx, keys)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResumePartitions indicates an expected call of ResumePartitions.
+func (mr *MockbatchedStreamReaderMockRecorder) ResumePartitions(ctx, keys any) *MockbatchedStreamReaderResumePartitionsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumePartitions", reflect.TypeOf((*MockbatchedStreamReader)(nil).ResumePartitions), ctx, keys)
+	return &MockbatchedStreamReaderResumePartitionsCall{Call: call}
+}
+
+// MockbatchedStreamReaderResumePartitionsCall wrap *gomock.Call
+type MockbatchedStreamReaderResumePartitionsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockbatchedStreamReaderResumePartitionsCall) Return(arg0 error) *MockbatchedStreamReaderResumePartitionsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockbatchedStreamReaderResumePartitionsCall) Do(f func(context.Context, []topicreadercommon.PartitionKey) error) *MockbatchedStreamReaderResumePartitionsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockbatchedStreamReaderResumePartitionsCall) DoAndReturn(f func(context.Context, []topicreadercommon.PartitionKey) error) *MockbatchedStreamReaderResumePartitionsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // WaitInit mocks base method.
 func (m *MockbatchedStreamReader) WaitInit(ctx context.Context) error {
 	m.ctrl.T.Helper()
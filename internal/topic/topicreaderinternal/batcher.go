@@ -100,6 +100,35 @@ func (b *batcher) addNeedLock(session *topicreadercommon.PartitionSession, item
 	return nil
 }
 
+// DropBatches remove messages batches of the session from the buffer and return them.
+// Raw server messages of the session keep in the buffer.
+func (b *batcher) DropBatches(session *topicreadercommon.PartitionSession) (dropped []*topicreadercommon.PublicBatch) {
+	b.m.Lock()
+	defer b.m.Unlock()
+
+	items, ok := b.messages[session]
+	if !ok {
+		return nil
+	}
+
+	rest := make(batcherMessageOrderItems, 0, len(items))
+	for i := range items {
+		if items[i].IsBatch() {
+			dropped = append(dropped, items[i].Batch)
+		} else {
+			rest = append(rest, items[i])
+		}
+	}
+
+	if rest.IsEmpty() {
+		delete(b.messages, session)
+	} else {
+		b.messages[session] = rest
+	}
+
+	return dropped
+}
+
 type batcherGetOptions struct {
 	MinCount        int
 	MaxCount        int
@@ -56,6 +56,40 @@ func TestBatcher_PushBatch(t *testing.T) {
 	require.Equal(t, expected, b.messages)
 }
 
+func TestBatcher_DropBatches(t *testing.T) {
+	session1 := &topicreadercommon.PartitionSession{}
+	session2 := &topicreadercommon.PartitionSession{}
+
+	m11 := topicreadercommon.MessageWithSetCommitRangeForTest(&topicreadercommon.PublicMessage{
+		WrittenAt: testTime(1),
+	}, topicreadercommon.CommitRange{PartitionSession: session1})
+	m21 := topicreadercommon.MessageWithSetCommitRangeForTest(&topicreadercommon.PublicMessage{
+		WrittenAt: testTime(2),
+	}, topicreadercommon.CommitRange{PartitionSession: session2})
+
+	batch1 := mustNewBatch(session1, []*topicreadercommon.PublicMessage{m11})
+	batch2 := mustNewBatch(session2, []*topicreadercommon.PublicMessage{m21})
+	stopMessage := &rawtopicreader.StopPartitionSessionRequest{}
+
+	b := newBatcher()
+	require.NoError(t, b.PushBatches(batch1))
+	require.NoError(t, b.PushRawMessage(session1, stopMessage))
+	require.NoError(t, b.PushBatches(batch2))
+
+	require.Equal(t, []*topicreadercommon.PublicBatch{batch1}, b.DropBatches(session1))
+	require.Empty(t, b.DropBatches(&topicreadercommon.PartitionSession{}))
+
+	expected := batcherMessagesMap{
+		session1: batcherMessageOrderItems{newBatcherItemRawMessage(stopMessage)},
+		session2: batcherMessageOrderItems{newBatcherItemBatch(batch2)},
+	}
+	require.Equal(t, expected, b.messages)
+
+	require.Equal(t, []*topicreadercommon.PublicBatch{batch2}, b.DropBatches(session2))
+	_, has := b.messages[session2]
+	require.False(t, has)
+}
+
 func TestBatcher_PushRawMessage(t *testing.T) {
 	t.Run("Empty", func(t *testing.T) {
 		b := newBatcher()
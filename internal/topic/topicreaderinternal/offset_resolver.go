@@ -0,0 +1,89 @@
+package topicreaderinternal
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopiccommon"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopicreader"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/topic/topicreadercommon"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// offsetByTimeBufferBytes is size of read request of offset resolver, the resolver need first message only
+const offsetByTimeBufferBytes = 1024
+
+// offsetByTimeResolver return offset of first message of the partition, written at t or later
+type offsetByTimeResolver func(
+	ctx context.Context,
+	key topicreadercommon.PartitionKey,
+	t time.Time,
+) (rawtopiccommon.Offset, error)
+
+// newOffsetByTimeResolver create resolver, which find offset by server: it opens separate stream without
+// consumer for the partition with read_from filter and return offset of first received message.
+// Resolver doesn't commit anything.
+//
+// The partition must contain message written at t or later, else resolver waits it until ctx done.
+func newOffsetByTimeResolver(connector TopicSteamReaderConnect) offsetByTimeResolver {
+	return func(
+		ctx context.Context,
+		key topicreadercommon.PartitionKey,
+		t time.Time,
+	) (rawtopiccommon.Offset, error) {
+		ctx, cancel := xcontext.WithCancel(ctx)
+		defer cancel()
+
+		stream, err := connector(ctx)
+		if err != nil {
+			return 0, err
+		}
+		defer func() {
+			_ = stream.CloseSend()
+		}()
+
+		initMessage := topicreadercommon.CreateInitMessage("", []*topicreadercommon.PublicReadSelector{{
+			Path:       key.Topic,
+			Partitions: []int64{key.PartitionID},
+			ReadFrom:   t,
+		}})
+		if err = stream.Send(initMessage); err != nil {
+			return 0, err
+		}
+
+		for {
+			msg, err := stream.Recv()
+			if err != nil {
+				return 0, err
+			}
+
+			if status := msg.StatusData(); !status.Status.IsSuccess() {
+				return 0, xerrors.WithStackTrace(fmt.Errorf(
+					"ydb: bad status on resolve offset by time: %v (%v)", status.Status, status.Issues,
+				))
+			}
+
+			switch m := msg.(type) {
+			case *rawtopicreader.InitResponse:
+				err = stream.Send(&rawtopicreader.ReadRequest{BytesSize: offsetByTimeBufferBytes})
+			case *rawtopicreader.StartPartitionSessionRequest:
+				err = stream.Send(&rawtopicreader.StartPartitionSessionResponse{
+					PartitionSessionID: m.PartitionSession.PartitionSessionID,
+				})
+			case *rawtopicreader.ReadResponse:
+				for i := range m.PartitionData {
+					for _, batch := range m.PartitionData[i].Batches {
+						if len(batch.MessageData) > 0 {
+							return batch.MessageData[0].Offset, nil
+						}
+					}
+				}
+			}
+			if err != nil {
+				return 0, err
+			}
+		}
+	}
+}
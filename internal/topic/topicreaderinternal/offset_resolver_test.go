@@ -0,0 +1,77 @@
+package topicreaderinternal
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopiccommon"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopicreader"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawydb"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/topic/topicreadercommon"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+)
+
+func TestOffsetByTimeResolver(t *testing.T) {
+	ctx := xtest.Context(t)
+	mc := gomock.NewController(t)
+	stream := NewMockRawTopicReaderStream(mc)
+
+	key := topicreadercommon.PartitionKey{Topic: "/test", PartitionID: 5}
+	writtenAt := time.Unix(100, 0)
+
+	initRequest := &rawtopicreader.InitRequest{
+		TopicsReadSettings: []rawtopicreader.TopicReadSettings{{
+			Path:         key.Topic,
+			PartitionsID: []int64{key.PartitionID},
+		}},
+	}
+	initRequest.TopicsReadSettings[0].ReadFrom.HasValue = true
+	initRequest.TopicsReadSettings[0].ReadFrom.Value = writtenAt
+
+	serverMessages := []rawtopicreader.ServerMessage{
+		&rawtopicreader.InitResponse{SessionID: "resolver"},
+		&rawtopicreader.StartPartitionSessionRequest{
+			PartitionSession: rawtopicreader.PartitionSession{
+				PartitionSessionID: 7,
+				Path:               key.Topic,
+				PartitionID:        key.PartitionID,
+			},
+			CommittedOffset: 20,
+		},
+		&rawtopicreader.ReadResponse{
+			PartitionData: []rawtopicreader.PartitionData{{
+				PartitionSessionID: 7,
+				Batches: []rawtopicreader.Batch{{
+					WrittenAt:   writtenAt.Add(time.Second),
+					MessageData: []rawtopicreader.MessageData{{Offset: 42}, {Offset: 43}},
+				}},
+			}},
+		},
+	}
+	for _, msg := range serverMessages {
+		msg.SetStatus(rawydb.StatusSuccess)
+	}
+
+	gomock.InOrder(
+		stream.EXPECT().Send(initRequest).Return(nil),
+		stream.EXPECT().Recv().Return(serverMessages[0], nil),
+		stream.EXPECT().Send(&rawtopicreader.ReadRequest{BytesSize: offsetByTimeBufferBytes}).Return(nil),
+		stream.EXPECT().Recv().Return(serverMessages[1], nil),
+		stream.EXPECT().Send(&rawtopicreader.StartPartitionSessionResponse{PartitionSessionID: 7}).Return(nil),
+		stream.EXPECT().Recv().Return(serverMessages[2], nil),
+		stream.EXPECT().CloseSend().Return(nil),
+	)
+
+	resolve := newOffsetByTimeResolver(func(ctx context.Context) (RawTopicReaderStream, error) {
+		return stream, nil
+	})
+
+	// offset resolved by server without commit: no commit messages expected
+	offset, err := resolve(ctx, key, writtenAt)
+	require.NoError(t, err)
+	require.Equal(t, rawtopiccommon.Offset(42), offset)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopiccommon"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/topic/topicreadercommon"
 )
 
 // PublicGetPartitionStartOffsetResponse allow to set start offset for read messages for the partition
@@ -32,3 +33,36 @@ type PublicGetPartitionStartOffsetFunc func(
 	ctx context.Context,
 	req PublicGetPartitionStartOffsetRequest,
 ) (res PublicGetPartitionStartOffsetResponse, err error)
+
+// PublicPartitionAssignedInfo info about partition session, started by server for the reader
+type PublicPartitionAssignedInfo struct {
+	PartitionSession topicreadercommon.PublicPartitionSession
+	CommittedOffset  int64
+
+	// PartitionOffsets is range of offsets of messages, stored in the partition now
+	PartitionOffsetsStart int64
+	PartitionOffsetsEnd   int64
+}
+
+// PublicOnPartitionAssignedFunc callback function for handle start partition session
+// The reader doesn't start read the partition until the callback returns.
+// If the callback returns error - the reader reconnects to the server (or closes for non retryable errors).
+type PublicOnPartitionAssignedFunc func(ctx context.Context, info PublicPartitionAssignedInfo) error
+
+// PublicPartitionRevokedInfo info about stopped partition session
+type PublicPartitionRevokedInfo struct {
+	PartitionSession topicreadercommon.PublicPartitionSession
+
+	// Graceful mean about server is waiting for finish work with the partition.
+	// If the field is false - the partition can be assigned to other reader already.
+	Graceful bool
+
+	// CommittedOffset known by the reader at stop of partition session
+	CommittedOffset int64
+}
+
+// PublicOnPartitionRevokedFunc callback function for handle stop partition session
+// Graceful stop of the partition is confirmed to the server after the callback returns.
+// The callback also called with Graceful=false for all assigned partitions, when the reader loses
+// connection to the server or is closed.
+type PublicOnPartitionRevokedFunc func(ctx context.Context, info PublicPartitionRevokedInfo) error
@@ -41,6 +41,7 @@ type Reader struct {
 	reader             batchedStreamReader
 	defaultBatchConfig ReadMessageBatchOptions
 	tracer             *trace.Topic
+	partitions         *topicreadercommon.PartitionControl
 	readerID           int64
 }
 
@@ -95,7 +96,11 @@ func NewReader(
 			return nil, err
 		}
 
-		return newTopicStreamReader(client, readerID, stream, cfg.topicStreamReaderConfig)
+		streamCfg := cfg.topicStreamReaderConfig
+		streamCfg.retryPolicy = cfg.RetryPolicy()
+		streamCfg.resolveOffsetByTime = newOffsetByTimeResolver(connector)
+
+		return newTopicStreamReader(client, readerID, stream, streamCfg)
 	}
 
 	res := Reader{
@@ -108,6 +113,7 @@ func NewReader(
 		),
 		defaultBatchConfig: cfg.DefaultBatchConfig,
 		tracer:             cfg.Trace,
+		partitions:         cfg.PartitionControl,
 		readerID:           readerID,
 	}
 
@@ -179,6 +185,56 @@ func (r *Reader) ReadMessageBatch(
 	}
 }
 
+// PausePartitions stop read messages of the partitions
+//
+// Buffered messages of the partitions are dropped and will be read again after ResumePartitions.
+// Server doesn't send messages of paused partitions.
+func (r *Reader) PausePartitions(ctx context.Context, topic string, partitionIDs ...int64) error {
+	keys := partitionKeys(topic, partitionIDs)
+	for _, key := range keys {
+		r.partitions.Pause(key)
+	}
+
+	return r.reader.PausePartitions(ctx, keys)
+}
+
+// ResumePartitions continue read messages of paused partitions from first not delivered message
+func (r *Reader) ResumePartitions(ctx context.Context, topic string, partitionIDs ...int64) error {
+	keys := partitionKeys(topic, partitionIDs)
+	for _, key := range keys {
+		r.partitions.Resume(key)
+	}
+
+	return r.reader.ResumePartitions(ctx, keys)
+}
+
+// Seek apply position of the partition to active partition session or save it for next partition session
+func (r *Reader) Seek(
+	ctx context.Context,
+	topic string,
+	partitionID int64,
+	pos topicreadercommon.PublicSeekPosition,
+) error {
+	key := topicreadercommon.PartitionKey{Topic: topic, PartitionID: partitionID}
+	r.partitions.Seek(key, pos)
+
+	return r.reader.RestartPartitions(ctx, []topicreadercommon.PartitionKey{key})
+}
+
+// Assignments return partition sessions, assigned to the reader now
+func (r *Reader) Assignments() []topicreadercommon.PublicPartitionSession {
+	return r.reader.Assignments()
+}
+
+func partitionKeys(topic string, partitionIDs []int64) []topicreadercommon.PartitionKey {
+	keys := make([]topicreadercommon.PartitionKey, len(partitionIDs))
+	for i, id := range partitionIDs {
+		keys[i] = topicreadercommon.PartitionKey{Topic: topic, PartitionID: id}
+	}
+
+	return keys
+}
+
 func (r *Reader) getBatchOptions(opts []PublicReadBatchOption) ReadMessageBatchOptions {
 	readOptions := r.defaultBatchConfig.clone()
 
@@ -36,6 +36,8 @@ var (
 	errCantCommitWithoutConsumer     = xerrors.Wrap(errors.New("ydb: reader can't commit messages without consumer"))
 	errBufferSize                    = xerrors.Wrap(errors.New("ydb: buffer of topic reader must be greater than zero, see option topicoptions.WithReaderBufferSizeBytes")) //nolint:lll
 	errTopicSelectorsEmpty           = xerrors.Wrap(errors.New("ydb: topic selector for topic reader is empty, see arguments on topic starts"))                             //nolint:lll
+	errRestartPartitions             = xerrors.Wrap(errors.New("ydb: restart partition sessions for read from new position"))                                               //nolint:lll
+	errPausePartitions               = xerrors.Wrap(errors.New("ydb: restart partition sessions for stop read of paused partitions"))                                       //nolint:lll
 )
 
 var clientSessionCounter atomic.Int64
@@ -51,6 +53,7 @@ type topicStreamReaderImpl struct {
 	freeBytes           chan int
 	restBufferSizeBytes atomic.Int64
 	sessionController   topicreadercommon.PartitionSessionStorage
+	assignedSessions    xsync.Map[partitionSessionID, *topicreadercommon.PartitionSession]
 	backgroundWorkers   background.Worker
 
 	rawMessagesFromBuffer chan rawtopicreader.ServerMessage
@@ -62,6 +65,9 @@ type topicStreamReaderImpl struct {
 	readConnectionID string
 	readerID         int64
 
+	pausedStartsMutex xsync.Mutex
+	pausedStarts      map[topicreadercommon.PartitionKey]partitionSessionStart
+
 	m       xsync.RWMutex
 	err     error
 	started bool
@@ -80,8 +86,22 @@ type topicStreamReaderConfig struct {
 	ReadSelectors                   []*topicreadercommon.PublicReadSelector
 	Trace                           *trace.Topic
 	GetPartitionStartOffsetCallback PublicGetPartitionStartOffsetFunc
+	OnPartitionAssigned             PublicOnPartitionAssignedFunc
+	OnPartitionRevoked              PublicOnPartitionRevokedFunc
 	CommitMode                      PublicCommitMode
 	Decoders                        topicreadercommon.DecoderMap
+	PartitionControl                *topicreadercommon.PartitionControl
+
+	retryPolicy         *retry.Policy
+	resolveOffsetByTime offsetByTimeResolver
+}
+
+// partitionSessionStart is start of partition session before confirm to server. Start of the partition session
+// is not confirmed while the partition paused: server doesn't send messages of the partition session until confirm.
+type partitionSessionStart struct {
+	session      *topicreadercommon.PartitionSession
+	readOffset   *int64
+	commitOffset *int64
 }
 
 func newTopicStreamReaderConfig() topicStreamReaderConfig {
@@ -94,6 +114,7 @@ func newTopicStreamReaderConfig() topicStreamReaderConfig {
 		CommitterBatchTimeLag: time.Second,
 		Decoders:              topicreadercommon.NewDecoderMap(),
 		Trace:                 &trace.Topic{},
+		PartitionControl:      topicreadercommon.NewPartitionControl(),
 	}
 }
 
@@ -167,6 +188,7 @@ func newTopicStreamReaderStopped(
 		readConnectionID:      "preinitID-" + readerConnectionID.String(),
 		readerID:              readerID,
 		rawMessagesFromBuffer: make(chan rawtopicreader.ServerMessage, 1),
+		pausedStarts:          make(map[topicreadercommon.PartitionKey]partitionSessionStart),
 	}
 
 	res.backgroundWorkers = *background.NewWorker(stopPump, "topic-reader-stream-background")
@@ -246,7 +268,7 @@ func (r *topicStreamReaderImpl) commitWithTransaction(
 		err = r.topicClient.UpdateOffsetsInTransaction(ctx, req)
 
 		return err
-	})
+	}, retry.WithPolicy(r.cfg.retryPolicy))
 	if updateOffesetInTransactionErr == nil {
 		r.addOnTransactionCompletedHandler(ctx, tx, batch, updateOffesetInTransactionErr)
 	} else {
@@ -266,7 +288,7 @@ func (r *topicStreamReaderImpl) commitWithTransaction(
 			}()
 
 			return tx.Rollback(ctx)
-		})
+		}, retry.WithPolicy(r.cfg.retryPolicy))
 
 		_ = r.CloseWithError(xcontext.ValueOnly(ctx), xerrors.WithStackTrace(xerrors.Retryable(
 			fmt.Errorf("ydb: failed add topic offsets in transaction: %w", updateOffesetInTransactionErr),
@@ -398,6 +420,12 @@ func (r *topicStreamReaderImpl) consumeMessagesUntilBatch(
 
 		switch {
 		case item.IsBatch():
+			if r.cfg.PartitionControl.IsPaused(topicreadercommon.BatchGetPartitionSession(item.Batch).PartitionKey()) {
+				r.dropPausedBatch(item.Batch)
+
+				continue
+			}
+
 			return item.Batch, nil
 		case item.IsRawMessage():
 			r.sendRawMessageToChannelUnblocked(item.RawMessage)
@@ -407,6 +435,247 @@ func (r *topicStreamReaderImpl) consumeMessagesUntilBatch(
 	}
 }
 
+// dropPausedBatch remove messages of paused partition from the buffer.
+// The messages will be read again after resume partition.
+func (r *topicStreamReaderImpl) dropPausedBatch(batch *topicreadercommon.PublicBatch) {
+	commitRange := topicreadercommon.GetCommitRange(batch)
+	r.cfg.PartitionControl.OnDropPaused(commitRange.PartitionSession.PartitionKey(), commitRange.CommitOffsetStart)
+	r.freeBufferFromMessages(batch)
+}
+
+// PausePartitions stop read of the partitions, which paused in the partition control.
+//
+// Server can't stop partition session by client request, so buffered messages of active sessions of the partitions
+// are dropped and the stream restarts. New stream doesn't confirm start of partition sessions of paused partitions
+// until resume, server doesn't send messages of the sessions and doesn't spend read quota for them.
+func (r *topicStreamReaderImpl) PausePartitions(ctx context.Context, keys []topicreadercommon.PartitionKey) error {
+	needRestart := false
+	for _, key := range keys {
+		session, ok := r.sessionController.FindActive(key)
+		if !ok || r.isPausedStart(session) {
+			continue
+		}
+
+		// messages after last received will be read again after resume
+		r.cfg.PartitionControl.OnDropPaused(key, session.LastReceivedMessageOffset()+1)
+		for _, batch := range r.batcher.DropBatches(session) {
+			r.dropPausedBatch(batch)
+		}
+		needRestart = true
+	}
+
+	if !needRestart {
+		return nil
+	}
+
+	reason := xerrors.WithStackTrace(xerrors.Retryable(errPausePartitions))
+	_ = r.CloseWithError(ctx, reason)
+
+	return reason
+}
+
+// ResumePartitions confirm start of partition sessions, started while pause of the partitions, and apply
+// positions of the partitions to active sessions (see RestartPartitions)
+func (r *topicStreamReaderImpl) ResumePartitions(ctx context.Context, keys []topicreadercommon.PartitionKey) error {
+	var restart []topicreadercommon.PartitionKey
+	for _, key := range keys {
+		start, ok := r.takePausedStart(key)
+		if !ok {
+			restart = append(restart, key)
+
+			continue
+		}
+		if _, err := r.confirmPartitionSession(start); err != nil {
+			return err
+		}
+	}
+
+	return r.RestartPartitions(ctx, restart)
+}
+
+// storePausedStart save start of partition session for confirm on resume and return true,
+// if the partition is paused now
+func (r *topicStreamReaderImpl) storePausedStart(start partitionSessionStart) (paused bool) {
+	key := start.session.PartitionKey()
+	r.pausedStartsMutex.WithLock(func() {
+		// check under the lock for sync with takePausedStart after resume
+		paused = r.cfg.PartitionControl.IsPaused(key)
+		if paused {
+			r.pausedStarts[key] = start
+		}
+	})
+
+	return paused
+}
+
+func (r *topicStreamReaderImpl) takePausedStart(key topicreadercommon.PartitionKey) (
+	start partitionSessionStart,
+	ok bool,
+) {
+	r.pausedStartsMutex.WithLock(func() {
+		start, ok = r.pausedStarts[key]
+		delete(r.pausedStarts, key)
+	})
+
+	return start, ok
+}
+
+func (r *topicStreamReaderImpl) isPausedStart(session *topicreadercommon.PartitionSession) (paused bool) {
+	r.pausedStartsMutex.WithLock(func() {
+		start, ok := r.pausedStarts[session.PartitionKey()]
+		paused = ok && start.session == session
+	})
+
+	return paused
+}
+
+func (r *topicStreamReaderImpl) removePausedStart(session *topicreadercommon.PartitionSession) {
+	r.pausedStartsMutex.WithLock(func() {
+		key := session.PartitionKey()
+		if start, ok := r.pausedStarts[key]; ok && start.session == session {
+			delete(r.pausedStarts, key)
+		}
+	})
+}
+
+// RestartPartitions apply positions, saved in the partition control, to active sessions of the partitions.
+// Other partitions of the stream continue reading.
+//
+// Position after received messages applied by skip messages before it. Position before received messages
+// applied by restart of the partition session: committed offset of the consumer moves back by CommitOffset
+// call outside of the read session and server restarts the partition session, new partition session
+// starts read from the position. Offset of position by time resolved by server before restart.
+// Reader without consumer can't move committed offset, so it restarts the stream with all partition sessions.
+func (r *topicStreamReaderImpl) RestartPartitions(ctx context.Context, keys []topicreadercommon.PartitionKey) error {
+	for _, key := range keys {
+		session, ok := r.sessionController.FindActive(key)
+		if !ok || r.isPausedStart(session) {
+			// position will be applied on start (or confirm of start) of the partition session
+			continue
+		}
+
+		if r.cfg.ReadWithoutConsumer {
+			if !r.cfg.PartitionControl.HasStartPosition(key) {
+				continue
+			}
+
+			reason := xerrors.WithStackTrace(xerrors.Retryable(errRestartPartitions))
+			_ = r.CloseWithError(ctx, reason)
+
+			return reason
+		}
+
+		if err := r.seekActiveSession(ctx, session); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *topicStreamReaderImpl) seekActiveSession(ctx context.Context, session *topicreadercommon.PartitionSession) error {
+	key := session.PartitionKey()
+	pos, ok := r.cfg.PartitionControl.TakeStartPosition(key)
+	if !ok {
+		return nil
+	}
+
+	lastReceived := session.LastReceivedMessageOffset()
+	lastReceivedWrittenAt := session.LastReceivedWrittenAt()
+
+	// buffered messages are before any position after received messages and will be read again after restart
+	// of the partition session
+	commitFrom := lastReceived + 1
+	for _, batch := range r.batcher.DropBatches(session) {
+		commitFrom = min(commitFrom, topicreadercommon.GetCommitRange(batch).CommitOffsetStart)
+		r.freeBufferFromMessages(batch)
+	}
+
+	if offset, ok := pos.Offset(); ok {
+		if offset > lastReceived {
+			// commit of first received message commit dropped and skipped messages too
+			session.SetLastReceivedMessageOffset(commitFrom - 1)
+			session.SkipMessagesBefore(offset)
+
+			return nil
+		}
+
+		r.cfg.PartitionControl.Restore(key, pos)
+
+		return r.rewindPartition(ctx, session, min(offset, session.CommittedOffset()))
+	}
+
+	writtenAt, _ := pos.WrittenAt()
+	if lastReceivedWrittenAt.Before(writtenAt) {
+		// all received messages written before the position
+		session.SetLastReceivedMessageOffset(commitFrom - 1)
+		checkFrom := lastReceived + 1
+		session.SkipMessagesWrittenBefore(writtenAt, &checkFrom)
+
+		return nil
+	}
+
+	// received messages written at the position or later, position is before them
+	return r.rewindPartitionToTime(ctx, session, writtenAt)
+}
+
+// rewindPartitionToTime restart partition session from first message, written at t or later.
+// Offset of the message resolved by server (see newOffsetByTimeResolver), committed offset of the consumer moves
+// back to the message only. If offset can't be resolved - the stream restarts and new partition session
+// applies the position again.
+func (r *topicStreamReaderImpl) rewindPartitionToTime(
+	ctx context.Context,
+	session *topicreadercommon.PartitionSession,
+	t time.Time,
+) error {
+	key := session.PartitionKey()
+
+	// messages of the session after the time must not be delivered while resolve offset
+	session.StartRestart()
+	for _, batch := range r.batcher.DropBatches(session) {
+		r.freeBufferFromMessages(batch)
+	}
+
+	offset, err := r.cfg.resolveOffsetByTime(ctx, key, t)
+	if err != nil {
+		r.cfg.PartitionControl.Restore(key, topicreadercommon.NewSeekPositionTime(t))
+		reason := xerrors.WithStackTrace(xerrors.Retryable(fmt.Errorf(
+			"ydb: failed to resolve offset of topic %q partition %v by time: %w", key.Topic, key.PartitionID, err,
+		)))
+		_ = r.CloseWithError(ctx, reason)
+
+		return reason
+	}
+
+	r.cfg.PartitionControl.Restore(key, topicreadercommon.NewSeekPositionOffset(offset.ToInt64()))
+
+	return r.rewindPartition(ctx, session, min(offset, session.CommittedOffset()))
+}
+
+// rewindPartition move committed offset of the consumer to the offset by CommitOffset call outside of the read
+// session. Server restarts partition session after the commit, messages of the session are skipped until restart.
+// Position of the partition for new partition session must be saved in the partition control before call.
+func (r *topicStreamReaderImpl) rewindPartition(
+	ctx context.Context,
+	session *topicreadercommon.PartitionSession,
+	offset rawtopiccommon.Offset,
+) error {
+	session.StartRestart()
+	for _, batch := range r.batcher.DropBatches(session) {
+		r.freeBufferFromMessages(batch)
+	}
+
+	return r.topicClient.CommitOffset(ctx, &rawtopic.CommitOffsetRequest{
+		OperationParams: rawydb.OperationParams{
+			OperationMode: rawydb.OperationParamsModeSync,
+		},
+		Path:        session.Topic,
+		PartitionID: session.PartitionID,
+		Consumer:    r.cfg.Consumer,
+		Offset:      offset,
+	})
+}
+
 func (r *topicStreamReaderImpl) sendRawMessageToChannelUnblocked(msg rawtopicreader.ServerMessage) {
 	select {
 	case r.rawMessagesFromBuffer <- msg:
@@ -481,6 +750,12 @@ func (r *topicStreamReaderImpl) onStopPartitionSessionRequestFromBuffer(
 		onDone(err)
 	}()
 
+	session.SetCommittedOffsetForward(msg.CommittedOffset)
+	r.removePausedStart(session)
+	if err = r.notifyPartitionRevoked(session, msg.Graceful); err != nil {
+		return err
+	}
+
 	if msg.Graceful {
 		session.Close()
 		resp := &rawtopicreader.StopPartitionSessionResponse{
@@ -505,6 +780,35 @@ func (r *topicStreamReaderImpl) onStopPartitionSessionRequestFromBuffer(
 	return nil
 }
 
+// notifyPartitionRevoked call revoked callback once for assigned partition session
+func (r *topicStreamReaderImpl) notifyPartitionRevoked(session *topicreadercommon.PartitionSession, graceful bool) error {
+	if _, ok := r.assignedSessions.Extract(session.StreamPartitionSessionID); !ok {
+		return nil
+	}
+
+	if r.cfg.OnPartitionRevoked == nil {
+		return nil
+	}
+
+	return r.cfg.OnPartitionRevoked(xcontext.ValueOnly(session.Context()), PublicPartitionRevokedInfo{
+		PartitionSession: session.ToPublic(),
+		Graceful:         graceful,
+		CommittedOffset:  session.CommittedOffset().ToInt64(),
+	})
+}
+
+// Assignments return partition sessions, assigned to the stream
+func (r *topicStreamReaderImpl) Assignments() []topicreadercommon.PublicPartitionSession {
+	var res []topicreadercommon.PublicPartitionSession
+	r.assignedSessions.Range(func(_ partitionSessionID, session *topicreadercommon.PartitionSession) bool {
+		res = append(res, session.ToPublic())
+
+		return true
+	})
+
+	return res
+}
+
 func (r *topicStreamReaderImpl) onPartitionSessionStatusResponseFromBuffer(
 	ctx context.Context,
 	m *rawtopicreader.PartitionSessionStatusResponse,
@@ -771,6 +1075,10 @@ func (r *topicStreamReaderImpl) freeBufferFromMessages(batch *topicreadercommon.
 	for messageIndex := range batch.Messages {
 		size += topicreadercommon.MessageGetBufferBytesAccount(batch.Messages[messageIndex])
 	}
+	r.freeBuffer(size)
+}
+
+func (r *topicStreamReaderImpl) freeBuffer(size int) {
 	select {
 	case r.freeBytes <- size:
 	case <-r.ctx.Done():
@@ -804,6 +1112,15 @@ func (r *topicStreamReaderImpl) onReadResponse(msg *rawtopicreader.ReadResponse)
 		return err2
 	}
 
+	r.rewindPartitionsBySeekFilter(msg)
+
+	if len(batches) == 0 {
+		// all messages of the response skipped by seek filter
+		r.freeBuffer(msg.BytesSize)
+
+		return nil
+	}
+
 	for i := range batches {
 		if err := r.batcher.PushBatches(batches[i]); err != nil {
 			return err
@@ -813,6 +1130,25 @@ func (r *topicStreamReaderImpl) onReadResponse(msg *rawtopicreader.ReadResponse)
 	return nil
 }
 
+// rewindPartitionsBySeekFilter restart partition sessions which need read messages before received for seek to time
+func (r *topicStreamReaderImpl) rewindPartitionsBySeekFilter(msg *rawtopicreader.ReadResponse) {
+	for i := range msg.PartitionData {
+		session, err := r.sessionController.Get(msg.PartitionData[i].PartitionSessionID)
+		if err != nil {
+			continue
+		}
+		writtenAt, ok := session.TakeRewind()
+		if !ok {
+			continue
+		}
+		r.backgroundWorkers.Start("rewindPartition", func(ctx context.Context) {
+			if err := r.rewindPartitionToTime(ctx, session, writtenAt); err != nil {
+				_ = r.CloseWithError(ctx, xerrors.WithStackTrace(err))
+			}
+		})
+	}
+}
+
 func (r *topicStreamReaderImpl) CloseWithError(ctx context.Context, reason error) (closeErr error) {
 	onDone := trace.TopicOnReaderClose(r.cfg.Trace, r.readConnectionID, reason)
 	defer onDone(closeErr)
@@ -834,6 +1170,13 @@ func (r *topicStreamReaderImpl) CloseWithError(ctx context.Context, reason error
 
 	closeErr = r.committer.Close(ctx, reason)
 
+	// partitions lost with the stream
+	r.assignedSessions.Range(func(_ partitionSessionID, session *topicreadercommon.PartitionSession) bool {
+		_ = r.notifyPartitionRevoked(session, false)
+
+		return true
+	})
+
 	batcherErr := r.batcher.Close(reason)
 	if closeErr == nil {
 		closeErr = batcherErr
@@ -904,6 +1247,7 @@ func (r *topicStreamReaderImpl) onStartPartitionSessionRequest(m *rawtopicreader
 		clientSessionCounter.Add(1),
 		m.CommittedOffset,
 	)
+	session.SetPartitionOffsetsStart(m.PartitionOffsets.Start)
 	if err := r.sessionController.Add(session); err != nil {
 		return err
 	}
@@ -931,10 +1275,6 @@ func (r *topicStreamReaderImpl) onStartPartitionSessionRequestFromBuffer(
 		)
 	)
 
-	respMessage := &rawtopicreader.StartPartitionSessionResponse{
-		PartitionSessionID: session.StreamPartitionSessionID,
-	}
-
 	var forceOffset *int64
 	var commitOffset *int64
 
@@ -942,6 +1282,18 @@ func (r *topicStreamReaderImpl) onStartPartitionSessionRequestFromBuffer(
 		onDone(forceOffset, commitOffset, err)
 	}()
 
+	if r.cfg.OnPartitionAssigned != nil {
+		if err = r.cfg.OnPartitionAssigned(session.Context(), PublicPartitionAssignedInfo{
+			PartitionSession:      session.ToPublic(),
+			CommittedOffset:       m.CommittedOffset.ToInt64(),
+			PartitionOffsetsStart: m.PartitionOffsets.Start.ToInt64(),
+			PartitionOffsetsEnd:   m.PartitionOffsets.End.ToInt64(),
+		}); err != nil {
+			return err
+		}
+	}
+	r.assignedSessions.Set(session.StreamPartitionSessionID, session)
+
 	if r.cfg.GetPartitionStartOffsetCallback != nil {
 		req := PublicGetPartitionStartOffsetRequest{
 			Topic:       session.Topic,
@@ -957,13 +1309,80 @@ func (r *topicStreamReaderImpl) onStartPartitionSessionRequestFromBuffer(
 		}
 	}
 
-	respMessage.ReadOffset.FromInt64Pointer(forceOffset)
 	if r.cfg.CommitMode.commitsEnabled() {
 		commitOffset = forceOffset
-		respMessage.CommitOffset.FromInt64Pointer(commitOffset)
 	}
 
-	return r.send(respMessage)
+	start := partitionSessionStart{session: session, readOffset: forceOffset, commitOffset: commitOffset}
+	if r.storePausedStart(start) {
+		// start of the session will be confirmed on resume of the partition
+		return nil
+	}
+
+	forceOffset, err = r.confirmPartitionSession(start)
+
+	return err
+}
+
+// confirmPartitionSession apply start position of the partition to the session and send response for start
+// of the partition session to server. Returns offset for start read from.
+func (r *topicStreamReaderImpl) confirmPartitionSession(start partitionSessionStart) (*int64, error) {
+	session := start.session
+	forceOffset := start.readOffset
+
+	if pos, ok := r.cfg.PartitionControl.TakeStartPosition(session.PartitionKey()); ok {
+		readOffset, rewind := r.applySeekPosition(session, pos)
+		if rewind != nil {
+			offset := *rewind
+			r.cfg.PartitionControl.Restore(session.PartitionKey(), pos)
+			r.backgroundWorkers.Start("rewindPartition", func(ctx context.Context) {
+				if err := r.rewindPartition(ctx, session, offset); err != nil {
+					_ = r.CloseWithError(ctx, xerrors.WithStackTrace(err))
+				}
+			})
+		}
+		if readOffset != nil {
+			// seek doesn't commit messages before read offset, they will be committed with first received message
+			forceOffset = readOffset
+		}
+	}
+
+	respMessage := &rawtopicreader.StartPartitionSessionResponse{
+		PartitionSessionID: session.StreamPartitionSessionID,
+	}
+	respMessage.ReadOffset.FromInt64Pointer(forceOffset)
+	respMessage.CommitOffset.FromInt64Pointer(start.commitOffset)
+
+	return forceOffset, r.send(respMessage)
+}
+
+// applySeekPosition prepare new session for read from the position and return offset for start read from.
+// Server can't start read before committed offset, so position before committed offset returned as rewind offset:
+// the partition session must be restarted after move committed offset back.
+func (r *topicStreamReaderImpl) applySeekPosition(
+	session *topicreadercommon.PartitionSession,
+	pos topicreadercommon.PublicSeekPosition,
+) (readOffset *int64, rewind *rawtopiccommon.Offset) {
+	if writtenAt, ok := pos.WrittenAt(); ok {
+		var checkFrom *rawtopiccommon.Offset
+		if !r.cfg.ReadWithoutConsumer {
+			// messages before committed offset may be written after the time too
+			start := session.PartitionOffsetsStart()
+			checkFrom = &start
+		}
+		session.SkipMessagesWrittenBefore(writtenAt, checkFrom)
+
+		return nil, nil
+	}
+
+	offset, _ := pos.Offset()
+	if offset < session.CommittedOffset() && !r.cfg.ReadWithoutConsumer {
+		return nil, &offset
+	}
+
+	res := offset.ToInt64()
+
+	return &res, nil
 }
 
 func (r *topicStreamReaderImpl) onStopPartitionSessionRequest(m *rawtopicreader.StopPartitionSessionRequest) error {
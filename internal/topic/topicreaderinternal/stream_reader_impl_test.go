@@ -980,6 +980,410 @@ func TestTopicStreamReadImpl_CommitWithBadSession(t *testing.T) {
 	})
 }
 
+func TestTopicStreamReaderImpl_PausePartitions(t *testing.T) {
+	e := newTopicReaderTestEnv(t)
+	e.Start()
+
+	initialBufferSize := e.reader.restBufferSizeBytes.Load()
+	messageSize := initialBufferSize - 1
+
+	e.stream.EXPECT().Send(&rawtopicreader.ReadRequest{BytesSize: int(messageSize)}).MaxTimes(1)
+
+	messageReaded := make(empty.Chan)
+	e.SendFromServerAndSetNextCallback(&rawtopicreader.ReadResponse{
+		BytesSize: int(messageSize),
+		PartitionData: []rawtopicreader.PartitionData{
+			{
+				PartitionSessionID: e.partitionSessionID,
+				Batches: []rawtopicreader.Batch{
+					{
+						MessageData: []rawtopicreader.MessageData{
+							{Offset: 20},
+							{Offset: 21},
+						},
+					},
+				},
+			},
+		},
+	}, func() {
+		close(messageReaded)
+	})
+	<-messageReaded
+	require.Equal(t, int64(1), e.reader.restBufferSizeBytes.Load())
+
+	key := e.partitionSession.PartitionKey()
+	e.reader.cfg.PartitionControl.Pause(key)
+	err := e.reader.PausePartitions(e.ctx, []topicreadercommon.PartitionKey{key})
+
+	// server can't stop partition session by client request, the stream restarts for stop read of the partition
+	require.ErrorIs(t, err, errPausePartitions)
+	require.NotNil(t, xerrors.RetryableError(err))
+	e.reader.m.WithRLock(func() {
+		require.True(t, e.reader.closed)
+	})
+
+	xtest.SpinWaitCondition(t, nil, func() bool {
+		return initialBufferSize == e.reader.restBufferSizeBytes.Load()
+	})
+
+	e.reader.cfg.PartitionControl.Resume(key)
+	pos, ok := e.reader.cfg.PartitionControl.TakeStartPosition(key)
+	require.True(t, ok)
+	offset, ok := pos.Offset()
+	require.True(t, ok)
+	require.Equal(t, rawtopiccommon.Offset(20), offset)
+}
+
+func TestTopicStreamReaderImpl_PausedPartitionStart(t *testing.T) {
+	e := newTopicReaderTestEnv(t)
+	e.Start()
+
+	const sessionID = 16
+	key := topicreadercommon.PartitionKey{Topic: "/test", PartitionID: 6}
+
+	// paused by previous stream after drop of message with offset 12
+	e.reader.cfg.PartitionControl.Pause(key)
+	e.reader.cfg.PartitionControl.OnDropPaused(key, 12)
+
+	// raw messages handled while read messages
+	readCtx, cancel := context.WithCancel(e.ctx)
+	defer cancel()
+	go func() {
+		_, _ = e.reader.ReadMessageBatch(readCtx, newReadMessageBatchOptions())
+	}()
+
+	// no response expected while pause: server doesn't send messages of not confirmed partition session
+	e.SendFromServer(&rawtopicreader.StartPartitionSessionRequest{
+		PartitionSession: rawtopicreader.PartitionSession{
+			PartitionSessionID: sessionID,
+			Path:               key.Topic,
+			PartitionID:        key.PartitionID,
+		},
+		CommittedOffset: 10,
+	})
+	xtest.SpinWaitCondition(t, nil, func() bool {
+		session, err := e.reader.sessionController.Get(sessionID)
+
+		return err == nil && e.reader.isPausedStart(session)
+	})
+
+	readOffset := int64(12)
+	startResponse := &rawtopicreader.StartPartitionSessionResponse{PartitionSessionID: sessionID}
+	startResponse.ReadOffset.FromInt64Pointer(&readOffset)
+	startResponse.CommitOffset.FromInt64Pointer(nil)
+	e.stream.EXPECT().Send(startResponse).Return(nil)
+
+	e.reader.cfg.PartitionControl.Resume(key)
+	require.NoError(t, e.reader.ResumePartitions(e.ctx, []topicreadercommon.PartitionKey{key}))
+
+	session, err := e.reader.sessionController.Get(sessionID)
+	require.NoError(t, err)
+	require.False(t, e.reader.isPausedStart(session))
+	e.reader.m.WithRLock(func() {
+		require.False(t, e.reader.closed)
+	})
+}
+
+func TestTopicStreamReaderImpl_ApplySeekPosition(t *testing.T) {
+	newSession := func() *topicreadercommon.PartitionSession {
+		return topicreadercommon.NewPartitionSession(
+			context.Background(), "/test", 1, 2, "connection-id", 3, 4, 20,
+		)
+	}
+	reader := &topicStreamReaderImpl{}
+
+	t.Run("OffsetForward", func(t *testing.T) {
+		session := newSession()
+		readOffset, rewind := reader.applySeekPosition(session, topicreadercommon.NewSeekPositionOffset(30))
+		require.Nil(t, rewind)
+		require.NotNil(t, readOffset)
+		require.Equal(t, int64(30), *readOffset)
+
+		// skipped messages must not be committed by seek
+		require.Equal(t, rawtopiccommon.Offset(20), session.CommittedOffset())
+		require.Equal(t, rawtopiccommon.Offset(19), session.LastReceivedMessageOffset())
+	})
+	t.Run("OffsetBeforeCommitted", func(t *testing.T) {
+		session := newSession()
+		readOffset, rewind := reader.applySeekPosition(session, topicreadercommon.NewSeekPositionOffset(10))
+		require.Nil(t, readOffset)
+		require.NotNil(t, rewind)
+		require.Equal(t, rawtopiccommon.Offset(10), *rewind)
+	})
+	t.Run("Time", func(t *testing.T) {
+		session := newSession()
+		readOffset, rewind := reader.applySeekPosition(session, topicreadercommon.NewSeekPositionTime(time.Unix(100, 0)))
+		require.Nil(t, readOffset)
+		require.Nil(t, rewind)
+	})
+}
+
+func TestTopicStreamReaderImpl_SeekToTimeSkipMessages(t *testing.T) {
+	e := newTopicReaderTestEnv(t)
+	e.Start()
+
+	e.partitionSession.SkipMessagesWrittenBefore(time.Unix(100, 0), nil)
+
+	e.SendFromServer(&rawtopicreader.ReadResponse{
+		PartitionData: []rawtopicreader.PartitionData{
+			{
+				PartitionSessionID: e.partitionSessionID,
+				Batches: []rawtopicreader.Batch{
+					{
+						WrittenAt:   time.Unix(99, 0),
+						MessageData: []rawtopicreader.MessageData{{Offset: 20}, {Offset: 21}},
+					},
+					{
+						WrittenAt:   time.Unix(100, 0),
+						MessageData: []rawtopicreader.MessageData{{Offset: 22}},
+					},
+				},
+			},
+		},
+	})
+
+	batch, err := e.reader.ReadMessageBatch(e.ctx, newReadMessageBatchOptions())
+	require.NoError(t, err)
+	require.Len(t, batch.Messages, 1)
+	require.Equal(t, int64(22), batch.Messages[0].Offset)
+
+	// skipped messages committed with the first received message
+	commitRange := topicreadercommon.GetCommitRange(batch)
+	require.Equal(t, rawtopiccommon.Offset(20), commitRange.CommitOffsetStart)
+	require.Equal(t, rawtopiccommon.Offset(23), commitRange.CommitOffsetEnd)
+}
+
+func TestTopicStreamReaderImpl_SeekForward(t *testing.T) {
+	e := newTopicReaderTestEnv(t)
+	e.Start()
+	require.True(t, e.reader.cfg.CommitMode.commitsEnabled())
+
+	key := e.partitionSession.PartitionKey()
+	e.reader.cfg.PartitionControl.Seek(key, topicreadercommon.NewSeekPositionOffset(30))
+
+	// no CommitOffset call expected: forward seek doesn't commit skipped messages and doesn't restart session
+	require.NoError(t, e.reader.RestartPartitions(e.ctx, []topicreadercommon.PartitionKey{key}))
+	require.NoError(t, e.partitionSession.Context().Err())
+	require.Equal(t, rawtopiccommon.Offset(20), e.partitionSession.CommittedOffset())
+
+	_, ok := e.reader.cfg.PartitionControl.TakeStartPosition(key)
+	require.False(t, ok)
+
+	e.SendFromServer(&rawtopicreader.ReadResponse{
+		PartitionData: []rawtopicreader.PartitionData{
+			{
+				PartitionSessionID: e.partitionSessionID,
+				Batches: []rawtopicreader.Batch{
+					{
+						MessageData: []rawtopicreader.MessageData{{Offset: 20}, {Offset: 25}},
+					},
+					{
+						MessageData: []rawtopicreader.MessageData{{Offset: 29}, {Offset: 30}, {Offset: 31}},
+					},
+				},
+			},
+		},
+	})
+
+	batch, err := e.reader.ReadMessageBatch(e.ctx, newReadMessageBatchOptions())
+	require.NoError(t, err)
+	require.Len(t, batch.Messages, 2)
+	require.Equal(t, int64(30), batch.Messages[0].Offset)
+	require.Equal(t, int64(31), batch.Messages[1].Offset)
+
+	// skipped messages committed only with commit of first received message
+	commitRange := topicreadercommon.GetCommitRange(batch)
+	require.Equal(t, rawtopiccommon.Offset(20), commitRange.CommitOffsetStart)
+	require.Equal(t, rawtopiccommon.Offset(32), commitRange.CommitOffsetEnd)
+	require.Equal(t, rawtopiccommon.Offset(20), e.partitionSession.CommittedOffset())
+}
+
+func TestTopicStreamReaderImpl_SeekBackwardBeforeCommitted(t *testing.T) {
+	e := newTopicReaderTestEnv(t)
+	e.Start()
+
+	e.SendFromServer(&rawtopicreader.ReadResponse{
+		PartitionData: []rawtopicreader.PartitionData{
+			{
+				PartitionSessionID: e.partitionSessionID,
+				Batches: []rawtopicreader.Batch{
+					{
+						MessageData: []rawtopicreader.MessageData{{Offset: 20}, {Offset: 21}},
+					},
+				},
+			},
+		},
+	})
+	_, err := e.reader.ReadMessageBatch(e.ctx, newReadMessageBatchOptions())
+	require.NoError(t, err)
+
+	key := e.partitionSession.PartitionKey()
+	e.reader.cfg.PartitionControl.Seek(key, topicreadercommon.NewSeekPositionOffset(5))
+
+	e.TopicClient.EXPECT().CommitOffset(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, req *rawtopic.CommitOffsetRequest) error {
+			require.Equal(t, "/test", req.Path)
+			require.Equal(t, e.partitionSession.PartitionID, req.PartitionID)
+			require.Equal(t, rawtopiccommon.Offset(5), req.Offset)
+
+			return nil
+		},
+	)
+	require.NoError(t, e.reader.RestartPartitions(e.ctx, []topicreadercommon.PartitionKey{key}))
+
+	// only the partition session restarts, the stream continues work
+	require.Error(t, e.partitionSession.Context().Err())
+	e.reader.m.WithRLock(func() {
+		require.False(t, e.reader.closed)
+	})
+
+	// messages of old partition session skipped until restart
+	e.SendFromServer(&rawtopicreader.ReadResponse{
+		BytesSize: 10,
+		PartitionData: []rawtopicreader.PartitionData{
+			{
+				PartitionSessionID: e.partitionSessionID,
+				Batches: []rawtopicreader.Batch{
+					{
+						MessageData: []rawtopicreader.MessageData{{Offset: 22}},
+					},
+				},
+			},
+		},
+	})
+	xtest.SpinWaitCondition(t, nil, func() bool {
+		return e.reader.restBufferSizeBytes.Load() == e.initialBufferSizeBytes
+	})
+
+	// new partition session read from the position
+	pos, ok := e.reader.cfg.PartitionControl.TakeStartPosition(key)
+	require.True(t, ok)
+	offset, ok := pos.Offset()
+	require.True(t, ok)
+	require.Equal(t, rawtopiccommon.Offset(5), offset)
+}
+
+func TestTopicStreamReaderImpl_SeekToTimeBeforeReceived(t *testing.T) {
+	e := newTopicReaderTestEnv(t)
+	e.Start()
+
+	key := e.partitionSession.PartitionKey()
+	e.reader.cfg.resolveOffsetByTime = func(
+		ctx context.Context,
+		resolveKey topicreadercommon.PartitionKey,
+		writtenAt time.Time,
+	) (rawtopiccommon.Offset, error) {
+		require.Equal(t, key, resolveKey)
+		require.Equal(t, time.Unix(100, 0), writtenAt)
+
+		return 15, nil
+	}
+
+	e.SendFromServer(&rawtopicreader.ReadResponse{
+		PartitionData: []rawtopicreader.PartitionData{
+			{
+				PartitionSessionID: e.partitionSessionID,
+				Batches: []rawtopicreader.Batch{
+					{
+						WrittenAt:   time.Unix(200, 0),
+						MessageData: []rawtopicreader.MessageData{{Offset: 20}, {Offset: 21}},
+					},
+				},
+			},
+		},
+	})
+	_, err := e.reader.ReadMessageBatch(e.ctx, newReadMessageBatchOptions())
+	require.NoError(t, err)
+
+	e.reader.cfg.PartitionControl.Seek(key, topicreadercommon.NewSeekPositionTime(time.Unix(100, 0)))
+
+	// committed offset moves back to resolved offset only, not to start of the partition
+	e.TopicClient.EXPECT().CommitOffset(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, req *rawtopic.CommitOffsetRequest) error {
+			require.Equal(t, rawtopiccommon.Offset(15), req.Offset)
+
+			return nil
+		},
+	)
+	require.NoError(t, e.reader.RestartPartitions(e.ctx, []topicreadercommon.PartitionKey{key}))
+	require.Error(t, e.partitionSession.Context().Err())
+
+	// new partition session read from resolved offset without client side filter
+	pos, ok := e.reader.cfg.PartitionControl.TakeStartPosition(key)
+	require.True(t, ok)
+	offset, ok := pos.Offset()
+	require.True(t, ok)
+	require.Equal(t, rawtopiccommon.Offset(15), offset)
+}
+
+func TestTopicStreamReaderImpl_PartitionAssignCallbacks(t *testing.T) {
+	e := newTopicReaderTestEnv(t)
+
+	const sessionID = 16
+
+	var (
+		events       []string
+		assignedInfo PublicPartitionAssignedInfo
+		revokedInfo  PublicPartitionRevokedInfo
+	)
+	e.reader.cfg.OnPartitionAssigned = func(ctx context.Context, info PublicPartitionAssignedInfo) error {
+		assignedInfo = info
+		events = append(events, "assigned")
+
+		return nil
+	}
+	e.reader.cfg.OnPartitionRevoked = func(ctx context.Context, info PublicPartitionRevokedInfo) error {
+		revokedInfo = info
+		events = append(events, "revoked")
+
+		return nil
+	}
+	e.Start()
+
+	readUntilSent := func(msg rawtopicreader.ServerMessage, expectedResponse rawtopicreader.ClientMessage) {
+		readCtx, cancel := context.WithCancel(e.ctx)
+		e.stream.EXPECT().Send(expectedResponse).DoAndReturn(func(_ rawtopicreader.ClientMessage) error {
+			events = append(events, "sent")
+			cancel()
+
+			return nil
+		})
+		e.SendFromServer(msg)
+		_, err := e.reader.ReadMessageBatch(readCtx, newReadMessageBatchOptions())
+		require.ErrorIs(t, err, context.Canceled)
+	}
+
+	startResponse := &rawtopicreader.StartPartitionSessionResponse{PartitionSessionID: sessionID}
+	startResponse.ReadOffset.FromInt64Pointer(nil)
+	startResponse.CommitOffset.FromInt64Pointer(nil)
+	readUntilSent(&rawtopicreader.StartPartitionSessionRequest{
+		PartitionSession: rawtopicreader.PartitionSession{
+			PartitionSessionID: sessionID,
+			Path:               "/test",
+			PartitionID:        6,
+		},
+		CommittedOffset: 10,
+	}, startResponse)
+
+	assignments := e.reader.Assignments()
+	require.Len(t, assignments, 1)
+	require.Equal(t, "/test", assignments[0].TopicPath)
+	require.Equal(t, int64(6), assignments[0].PartitionID)
+
+	readUntilSent(&rawtopicreader.StopPartitionSessionRequest{
+		PartitionSessionID: sessionID,
+		Graceful:           true,
+		CommittedOffset:    15,
+	}, &rawtopicreader.StopPartitionSessionResponse{PartitionSessionID: sessionID})
+
+	require.Empty(t, e.reader.Assignments())
+	require.Equal(t, []string{"assigned", "sent", "revoked", "sent"}, events)
+	require.Equal(t, int64(6), assignedInfo.PartitionSession.PartitionID)
+	require.Equal(t, int64(10), assignedInfo.CommittedOffset)
+	require.True(t, revokedInfo.Graceful)
+	require.Equal(t, int64(15), revokedInfo.CommittedOffset)
+}
+
 type streamEnv struct {
 	TopicClient             *MockTopicClient
 	ctx                     context.Context //nolint:containedctx
@@ -195,6 +195,84 @@ func (r *readerReconnector) Commit(
 	return err
 }
 
+func (r *readerReconnector) PausePartitions(ctx context.Context, keys []topicreadercommon.PartitionKey) error {
+	stream, err := r.stream(ctx)
+	if err != nil {
+		if r.isRetriableError(err) {
+			// pause will be applied to the new stream
+			return nil
+		}
+
+		return err
+	}
+
+	err = stream.PausePartitions(ctx, keys)
+	if r.isRetriableError(err) {
+		// new stream doesn't read paused partitions
+		r.fireReconnectOnRetryableError(stream, err)
+
+		return nil
+	}
+
+	return err
+}
+
+func (r *readerReconnector) ResumePartitions(ctx context.Context, keys []topicreadercommon.PartitionKey) error {
+	stream, err := r.stream(ctx)
+	if err != nil {
+		if r.isRetriableError(err) {
+			// new stream will read resumed partitions
+			return nil
+		}
+
+		return err
+	}
+
+	err = stream.ResumePartitions(ctx, keys)
+	if r.isRetriableError(err) {
+		r.fireReconnectOnRetryableError(stream, err)
+
+		return nil
+	}
+
+	return err
+}
+
+func (r *readerReconnector) RestartPartitions(ctx context.Context, keys []topicreadercommon.PartitionKey) error {
+	stream, err := r.stream(ctx)
+	if err != nil {
+		if r.isRetriableError(err) {
+			// new stream will start partition sessions from new positions
+			return nil
+		}
+
+		return err
+	}
+
+	err = stream.RestartPartitions(ctx, keys)
+	if r.isRetriableError(err) {
+		r.fireReconnectOnRetryableError(stream, err)
+
+		return nil
+	}
+
+	return err
+}
+
+func (r *readerReconnector) Assignments() []topicreadercommon.PublicPartitionSession {
+	var stream batchedStreamReader
+	r.m.WithRLock(func() {
+		if r.streamErr == nil {
+			stream = r.streamVal
+		}
+	})
+	if stream == nil {
+		return nil
+	}
+
+	return stream.Assignments()
+}
+
 func (r *readerReconnector) CloseWithError(ctx context.Context, reason error) error {
 	var closeErr error
 	r.closeOnce.Do(func() {
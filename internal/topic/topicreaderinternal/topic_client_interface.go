@@ -10,5 +10,6 @@ import (
 
 // TopicClient is part of rawtopic.Client
 type TopicClient interface {
+	CommitOffset(ctx context.Context, req *rawtopic.CommitOffsetRequest) error
 	UpdateOffsetsInTransaction(ctx context.Context, req *rawtopic.UpdateOffsetsInTransactionRequest) error
 }
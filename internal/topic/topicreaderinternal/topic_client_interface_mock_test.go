@@ -37,6 +37,44 @@ func (m *MockTopicClient) EXPECT() *MockTopicClientMockRecorder {
 	return m.recorder
 }
 
+// CommitOffset mocks base method.
+func (m *MockTopicClient) CommitOffset(ctx context.Context, req *rawtopic.CommitOffsetRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CommitOffset", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CommitOffset indicates an expected call of CommitOffset.
+func (mr *MockTopicClientMockRecorder) CommitOffset(ctx, req any) *MockTopicClientCommitOffsetCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitOffset", reflect.TypeOf((*MockTopicClient)(nil).CommitOffset), ctx, req)
+	return &MockTopicClientCommitOffsetCall{Call: call}
+}
+
+// MockTopicClientCommitOffsetCall wrap *gomock.Call
+type MockTopicClientCommitOffsetCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTopicClientCommitOffsetCall) Return(arg0 error) *MockTopicClientCommitOffsetCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTopicClientCommitOffsetCall) Do(f func(context.Context, *rawtopic.CommitOffsetRequest) error) *MockTopicClientCommitOffsetCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTopicClientCommitOffsetCall) DoAndReturn(f func(context.Context, *rawtopic.CommitOffsetRequest) error) *MockTopicClientCommitOffsetCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // UpdateOffsetsInTransaction mocks base method.
 func (m *MockTopicClient) UpdateOffsetsInTransaction(ctx context.Context, req *rawtopic.UpdateOffsetsInTransactionRequest) error {
 	m.ctrl.T.Helper()
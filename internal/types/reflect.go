@@ -0,0 +1,160 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/decimal"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+const (
+	defaultDecimalPrecision = 22
+	defaultDecimalScale     = 9
+)
+
+var (
+	ErrUnsupportedGoType = errors.New("unsupported go type")
+
+	typeOfTime     = reflect.TypeOf(time.Time{})
+	typeOfDuration = reflect.TypeOf(time.Duration(0))
+	typeOfUUID     = reflect.TypeOf(uuid.UUID{})
+	typeOfDecimal  = reflect.TypeOf(decimal.Decimal{})
+	typeOfBytes    = reflect.TypeOf([]byte(nil))
+
+	goTypesCache sync.Map // reflect.Type -> Type
+)
+
+// FieldName returns the YDB name of struct field: value of tag (if defined) or name of field
+func FieldName(f reflect.StructField, tagName string) string { //nolint:gocritic
+	if name, has := f.Tag.Lookup(tagName); has && name != "" {
+		return name
+	}
+
+	return f.Name
+}
+
+// FromGoType infers YDB type from go type
+//
+// Pointers are mapped to Optional types, slices and arrays (except []byte and [16]byte) - to List types,
+// maps - to Dict types, structs - to Struct types with member names from `sql` tag.
+// time.Time maps to Timestamp, time.Duration - to Interval, uuid.UUID and [16]byte - to UUID,
+// decimal values - to Decimal(22,9).
+// Inferred types are cached per go type.
+func FromGoType(t reflect.Type) (Type, error) {
+	if cached, has := goTypesCache.Load(t); has {
+		return cached.(Type), nil //nolint:forcetypeassert
+	}
+
+	tt, err := fromGoType(t)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	goTypesCache.Store(t, tt)
+
+	return tt, nil
+}
+
+//nolint:gocyclo,funlen
+func fromGoType(t reflect.Type) (Type, error) {
+	switch t {
+	case typeOfTime:
+		return Timestamp, nil
+	case typeOfDuration:
+		return Interval, nil
+	case typeOfUUID:
+		return UUID, nil
+	case typeOfDecimal:
+		return NewDecimal(defaultDecimalPrecision, defaultDecimalScale), nil
+	case typeOfBytes:
+		return Bytes, nil
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer:
+		inner, err := fromGoType(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+
+		return NewOptional(inner), nil
+	case reflect.Bool:
+		return Bool, nil
+	case reflect.Int8:
+		return Int8, nil
+	case reflect.Uint8:
+		return Uint8, nil
+	case reflect.Int16:
+		return Int16, nil
+	case reflect.Uint16:
+		return Uint16, nil
+	case reflect.Int32, reflect.Int:
+		return Int32, nil
+	case reflect.Uint32, reflect.Uint:
+		return Uint32, nil
+	case reflect.Int64:
+		return Int64, nil
+	case reflect.Uint64:
+		return Uint64, nil
+	case reflect.Float32:
+		return Float, nil
+	case reflect.Float64:
+		return Double, nil
+	case reflect.String:
+		return Text, nil
+	case reflect.Array:
+		if t.Len() == 16 && t.Elem().Kind() == reflect.Uint8 { //nolint:gomnd
+			return UUID, nil
+		}
+
+		fallthrough
+	case reflect.Slice:
+		item, err := fromGoType(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+
+		return NewList(item), nil
+	case reflect.Map:
+		k, err := fromGoType(t.Key())
+		if err != nil {
+			return nil, err
+		}
+		v, err := fromGoType(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+
+		return NewDict(k, v), nil
+	case reflect.Struct:
+		fields := make([]StructField, 0, t.NumField())
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			if !f.IsExported() {
+				continue
+			}
+			name := FieldName(f, "sql")
+			if name == "-" {
+				continue
+			}
+			ft, err := fromGoType(f.Type)
+			if err != nil {
+				return nil, fmt.Errorf("struct field '%s': %w", f.Name, err)
+			}
+			fields = append(fields, StructField{
+				Name: name,
+				T:    ft,
+			})
+		}
+
+		return NewStruct(fields...), nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedGoType, t.String())
+	}
+}
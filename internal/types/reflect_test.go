@@ -0,0 +1,63 @@
+package types
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/decimal"
+)
+
+func TestFromGoType(t *testing.T) {
+	type nested struct {
+		A int64  `sql:"a"`
+		B string `sql:"-"`
+		C *bool
+	}
+	for _, tt := range []struct {
+		v   interface{}
+		exp Type
+	}{
+		{v: true, exp: Bool},
+		{v: int8(0), exp: Int8},
+		{v: uint8(0), exp: Uint8},
+		{v: int16(0), exp: Int16},
+		{v: uint16(0), exp: Uint16},
+		{v: 0, exp: Int32},
+		{v: uint(0), exp: Uint32},
+		{v: int64(0), exp: Int64},
+		{v: uint64(0), exp: Uint64},
+		{v: float32(0), exp: Float},
+		{v: float64(0), exp: Double},
+		{v: "", exp: Text},
+		{v: []byte(nil), exp: Bytes},
+		{v: time.Time{}, exp: Timestamp},
+		{v: time.Duration(0), exp: Interval},
+		{v: uuid.UUID{}, exp: UUID},
+		{v: [16]byte{}, exp: UUID},
+		{v: decimal.Decimal{}, exp: NewDecimal(22, 9)},
+		{v: (*string)(nil), exp: NewOptional(Text)},
+		{v: []int64(nil), exp: NewList(Int64)},
+		{v: map[string]uint64(nil), exp: NewDict(Text, Uint64)},
+		{
+			v: nested{},
+			exp: NewStruct(
+				StructField{Name: "a", T: Int64},
+				StructField{Name: "C", T: NewOptional(Bool)},
+			),
+		},
+	} {
+		t.Run(reflect.TypeOf(tt.v).String(), func(t *testing.T) {
+			typ, err := FromGoType(reflect.TypeOf(tt.v))
+			require.NoError(t, err)
+			require.True(t, Equal(tt.exp, typ), "%s != %s", tt.exp.Yql(), typ.Yql())
+		})
+	}
+	t.Run("Unsupported", func(t *testing.T) {
+		_, err := FromGoType(reflect.TypeOf(func() {}))
+		require.ErrorIs(t, err, ErrUnsupportedGoType)
+	})
+}
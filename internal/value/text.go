@@ -0,0 +1,219 @@
+package value
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/decimal"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+const (
+	textDateLayout      = "2006-01-02"
+	textTimestampLayout = time.RFC3339Nano
+)
+
+var ErrUnsupportedTextType = errors.New("type not supported in text representation")
+
+// ToText returns text representation of value with primitive, decimal or optional type.
+//
+// Text representation is lossless and can be parsed back with FromText:
+// numbers formats as decimal numbers, dates and times - in RFC3339 format (in UTC),
+// intervals - as count of microseconds, binary strings and yson - in standard base64 encoding,
+// UUIDs - in canonical form, decimals - as decimal fractions.
+// Null values returns with null flag.
+func ToText(v Value) (s string, null bool, _ error) {
+	a := allocator.New()
+	defer a.Free()
+
+	tv := ToYDB(v, a)
+
+	return protoToText(types.TypeFromYDB(tv.GetType()), tv.GetValue())
+}
+
+//nolint:gocyclo,funlen
+func protoToText(t types.Type, v *Ydb.Value) (string, bool, error) {
+	switch tt := t.(type) {
+	case types.Optional:
+		switch x := v.GetValue().(type) {
+		case *Ydb.Value_NullFlagValue:
+			return "", true, nil
+		case *Ydb.Value_NestedValue:
+			return protoToText(tt.InnerType(), x.NestedValue)
+		default:
+			return protoToText(tt.InnerType(), v)
+		}
+	case *types.Decimal:
+		return decimal.Format(
+			decimal.FromInt128(BigEndianUint128(v.GetHigh_128(), v.GetLow_128()), tt.Precision(), tt.Scale()),
+			tt.Precision(), tt.Scale(),
+		), false, nil
+	case types.Primitive:
+		switch tt {
+		case types.Bool:
+			return strconv.FormatBool(v.GetBoolValue()), false, nil
+		case types.Int8, types.Int16, types.Int32:
+			return strconv.FormatInt(int64(v.GetInt32Value()), 10), false, nil
+		case types.Uint8, types.Uint16, types.Uint32:
+			return strconv.FormatUint(uint64(v.GetUint32Value()), 10), false, nil
+		case types.Int64, types.Interval:
+			return strconv.FormatInt(v.GetInt64Value(), 10), false, nil
+		case types.Uint64:
+			return strconv.FormatUint(v.GetUint64Value(), 10), false, nil
+		case types.Float:
+			return strconv.FormatFloat(float64(v.GetFloatValue()), 'g', -1, 32), false, nil
+		case types.Double:
+			return strconv.FormatFloat(v.GetDoubleValue(), 'g', -1, 64), false, nil
+		case types.Date:
+			return DateToTime(v.GetUint32Value()).UTC().Format(textDateLayout), false, nil
+		case types.Datetime:
+			return DatetimeToTime(v.GetUint32Value()).UTC().Format(textTimestampLayout), false, nil
+		case types.Timestamp:
+			return TimestampToTime(v.GetUint64Value()).UTC().Format(textTimestampLayout), false, nil
+		case types.Text, types.JSON, types.JSONDocument, types.DyNumber,
+			types.TzDate, types.TzDatetime, types.TzTimestamp:
+			return v.GetTextValue(), false, nil
+		case types.Bytes:
+			return base64.StdEncoding.EncodeToString(v.GetBytesValue()), false, nil
+		case types.YSON:
+			if x, ok := v.GetValue().(*Ydb.Value_TextValue); ok {
+				return base64.StdEncoding.EncodeToString([]byte(x.TextValue)), false, nil
+			}
+
+			return base64.StdEncoding.EncodeToString(v.GetBytesValue()), false, nil
+		case types.UUID:
+			return uuid.UUID(BigEndianUint128(v.GetHigh_128(), v.GetLow_128())).String(), false, nil
+		}
+	}
+
+	return "", false, xerrors.WithStackTrace(fmt.Errorf("%w: %s", ErrUnsupportedTextType, t.Yql()))
+}
+
+// FromText parses not null value of type t from text representation made with ToText
+//
+//nolint:gocyclo,funlen
+func FromText(t types.Type, s string) (Value, error) {
+	switch tt := t.(type) {
+	case types.Optional:
+		v, err := FromText(tt.InnerType(), s)
+		if err != nil {
+			return nil, err
+		}
+
+		return OptionalValue(v), nil
+	case *types.Decimal:
+		v, err := decimal.Parse(s, tt.Precision(), tt.Scale())
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		return DecimalValueFromBigInt(v, tt.Precision(), tt.Scale()), nil
+	case types.Primitive:
+		switch tt {
+		case types.Bool:
+			v, err := strconv.ParseBool(s)
+
+			return wrapTextParse(BoolValue(v), err)
+		case types.Int8:
+			v, err := strconv.ParseInt(s, 10, 8)
+
+			return wrapTextParse(Int8Value(int8(v)), err)
+		case types.Int16:
+			v, err := strconv.ParseInt(s, 10, 16)
+
+			return wrapTextParse(Int16Value(int16(v)), err)
+		case types.Int32:
+			v, err := strconv.ParseInt(s, 10, 32)
+
+			return wrapTextParse(Int32Value(int32(v)), err)
+		case types.Int64:
+			v, err := strconv.ParseInt(s, 10, 64)
+
+			return wrapTextParse(Int64Value(v), err)
+		case types.Interval:
+			v, err := strconv.ParseInt(s, 10, 64)
+
+			return wrapTextParse(IntervalValue(v), err)
+		case types.Uint8:
+			v, err := strconv.ParseUint(s, 10, 8)
+
+			return wrapTextParse(Uint8Value(uint8(v)), err)
+		case types.Uint16:
+			v, err := strconv.ParseUint(s, 10, 16)
+
+			return wrapTextParse(Uint16Value(uint16(v)), err)
+		case types.Uint32:
+			v, err := strconv.ParseUint(s, 10, 32)
+
+			return wrapTextParse(Uint32Value(uint32(v)), err)
+		case types.Uint64:
+			v, err := strconv.ParseUint(s, 10, 64)
+
+			return wrapTextParse(Uint64Value(v), err)
+		case types.Float:
+			v, err := strconv.ParseFloat(s, 32)
+
+			return wrapTextParse(FloatValue(float32(v)), err)
+		case types.Double:
+			v, err := strconv.ParseFloat(s, 64)
+
+			return wrapTextParse(DoubleValue(v), err)
+		case types.Date:
+			v, err := time.Parse(textDateLayout, s)
+
+			return wrapTextParse(DateValueFromTime(v), err)
+		case types.Datetime:
+			v, err := time.Parse(textTimestampLayout, s)
+
+			return wrapTextParse(DatetimeValueFromTime(v), err)
+		case types.Timestamp:
+			v, err := time.Parse(textTimestampLayout, s)
+
+			return wrapTextParse(TimestampValueFromTime(v), err)
+		case types.Text:
+			return TextValue(s), nil
+		case types.JSON:
+			return JSONValue(s), nil
+		case types.JSONDocument:
+			return JSONDocumentValue(s), nil
+		case types.DyNumber:
+			return DyNumberValue(s), nil
+		case types.TzDate:
+			return TzDateValue(s), nil
+		case types.TzDatetime:
+			return TzDatetimeValue(s), nil
+		case types.TzTimestamp:
+			return TzTimestampValue(s), nil
+		case types.Bytes:
+			v, err := base64.StdEncoding.DecodeString(s)
+
+			return wrapTextParse(BytesValue(v), err)
+		case types.YSON:
+			v, err := base64.StdEncoding.DecodeString(s)
+
+			return wrapTextParse(YSONValue(v), err)
+		case types.UUID:
+			v, err := uuid.Parse(s)
+
+			return wrapTextParse(UUIDValue(v), err)
+		}
+	}
+
+	return nil, xerrors.WithStackTrace(fmt.Errorf("%w: %s", ErrUnsupportedTextType, t.Yql()))
+}
+
+func wrapTextParse(v Value, err error) (Value, error) {
+	if err != nil {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("cannot parse %s value: %w", v.Type().Yql(), err))
+	}
+
+	return v, nil
+}
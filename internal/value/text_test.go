@@ -0,0 +1,82 @@
+package value
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/decimal"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+)
+
+func TestTextRoundTrip(t *testing.T) {
+	ts := time.Date(2024, 5, 17, 12, 34, 56, 789000, time.UTC)
+	for _, tt := range []struct {
+		v    Value
+		text string
+	}{
+		{v: BoolValue(true), text: "true"},
+		{v: Int8Value(-8), text: "-8"},
+		{v: Int16Value(-16), text: "-16"},
+		{v: Int32Value(-32), text: "-32"},
+		{v: Int64Value(-64), text: "-64"},
+		{v: Uint8Value(8), text: "8"},
+		{v: Uint16Value(16), text: "16"},
+		{v: Uint32Value(32), text: "32"},
+		{v: Uint64Value(18446744073709551615), text: "18446744073709551615"},
+		{v: FloatValue(1.5), text: "1.5"},
+		{v: DoubleValue(-2.25), text: "-2.25"},
+		{v: DateValueFromTime(ts), text: "2024-05-17"},
+		{v: DatetimeValueFromTime(ts), text: "2024-05-17T12:34:56Z"},
+		{v: TimestampValueFromTime(ts), text: "2024-05-17T12:34:56.000789Z"},
+		{v: IntervalValueFromDuration(time.Second), text: "1000000"},
+		{v: TextValue("привет"), text: "привет"},
+		{v: BytesValue([]byte{0, 1, 2}), text: "AAEC"},
+		{v: YSONValue([]byte("{a=1}")), text: "e2E9MX0="},
+		{v: JSONValue(`{"a":1}`), text: `{"a":1}`},
+		{v: JSONDocumentValue(`{"a":1}`), text: `{"a":1}`},
+		{
+			v:    UUIDValue([16]byte{0x6b, 0xa7, 0xb8, 0x10, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}),
+			text: "6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+		},
+		{v: DecimalValueFromBigInt(mustParseDecimal(t, "-123.456"), 22, 9), text: "-123.456000000"},
+		{v: OptionalValue(Uint64Value(42)), text: "42"},
+	} {
+		t.Run(tt.v.Yql(), func(t *testing.T) {
+			text, null, err := ToText(tt.v)
+			require.NoError(t, err)
+			require.False(t, null)
+			require.Equal(t, tt.text, text)
+			v, err := FromText(tt.v.Type(), text)
+			require.NoError(t, err)
+			require.Equal(t, tt.v.Yql(), v.Yql())
+		})
+	}
+	t.Run("Null", func(t *testing.T) {
+		_, null, err := ToText(NullValue(types.Text))
+		require.NoError(t, err)
+		require.True(t, null)
+	})
+	t.Run("Unsupported", func(t *testing.T) {
+		_, _, err := ToText(ListValue(Int32Value(1)))
+		require.ErrorIs(t, err, ErrUnsupportedTextType)
+		_, err = FromText(types.NewList(types.Int32), "[1]")
+		require.ErrorIs(t, err, ErrUnsupportedTextType)
+	})
+	t.Run("BadText", func(t *testing.T) {
+		_, err := FromText(types.Uint8, "256")
+		require.Error(t, err)
+		_, err = FromText(types.Date, "17.05.2024")
+		require.Error(t, err)
+	})
+}
+
+func mustParseDecimal(t *testing.T, s string) *big.Int {
+	t.Helper()
+	v, err := decimal.Parse(s, 22, 9)
+	require.NoError(t, err)
+
+	return v
+}
@@ -1454,6 +1454,16 @@ func (v *optionalValue) toYDB(a *allocator.Allocator) *Ydb.Value {
 	return vv
 }
 
+// UnwrapOptional returns inner value of optional value v or false if v is NULL.
+// Non-optional value v returns as is
+func UnwrapOptional(v Value) (_ Value, ok bool) {
+	if optional, isOptional := v.(*optionalValue); isOptional {
+		return optional.value, optional.value != nil
+	}
+
+	return v, true
+}
+
 func OptionalValue(v Value) *optionalValue {
 	return &optionalValue{
 		innerType: types.NewOptional(v.Type()),
@@ -2429,7 +2439,7 @@ func ZeroValue(t types.Type) Value {
 		}
 	case *types.Dict:
 		return &dictValue{
-			t: t.ValueType(),
+			t: t,
 		}
 	case *types.EmptyDict:
 		return &dictValue{
@@ -0,0 +1,17 @@
+package xcontext
+
+import "context"
+
+type ctxRetryLabelKey struct{}
+
+func WithRetryLabel(ctx context.Context, label string) context.Context {
+	return context.WithValue(ctx, ctxRetryLabelKey{}, label)
+}
+
+func RetryLabel(ctx context.Context) string {
+	if label, ok := ctx.Value(ctxRetryLabelKey{}).(string); ok {
+		return label
+	}
+
+	return ""
+}
@@ -156,6 +156,16 @@ func IsOperationError(err error, codes ...Ydb.StatusIds_StatusCode) bool {
 	return false
 }
 
+// OperationStatus returns status code of operationError from err
+func OperationStatus(err error) (code Ydb.StatusIds_StatusCode, ok bool) {
+	var op *operationError
+	if !errors.As(err, &op) {
+		return code, false
+	}
+
+	return op.code, true
+}
+
 const issueCodeTransactionLocksInvalidated = 2001
 
 func IsOperationErrorTransactionLocksInvalidated(err error) (isTLI bool) {
@@ -151,6 +151,21 @@ func IsTransportError(err error, codes ...grpcCodes.Code) bool {
 	return false
 }
 
+// TransportCode returns grpc code of transportError or grpc status error from err
+func TransportCode(err error) (code grpcCodes.Code, ok bool) {
+	if err == nil {
+		return code, false
+	}
+	if t := (*transportError)(nil); errors.As(err, &t) {
+		return t.status.Code(), true
+	}
+	if t, has := grpcStatus.FromError(err); has {
+		return t.Code(), true
+	}
+
+	return code, false
+}
+
 // Transport returns a new transport error with given options
 func Transport(err error, opts ...teOpt) error {
 	if err == nil {
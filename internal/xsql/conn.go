@@ -11,6 +11,8 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/interceptors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/interceptor"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/scheme/helpers"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
@@ -224,10 +226,15 @@ func (c *conn) executeDataQuery(ctx context.Context, query string, args []driver
 		return nil, xerrors.WithStackTrace(err)
 	}
 
-	_, res, err := c.session.Execute(ctx,
-		txControl(ctx, c.defaultTxControl),
-		normalizedQuery, &parameters, c.dataQueryOptions(ctx)...,
+	txc := txControl(ctx, c.defaultTxControl)
+	ctx, normalizedQuery, parameters, err = c.intercept(ctx, normalizedQuery, parameters,
+		interceptor.TxControl(txc.Desc(), txc.Desc().GetBeginTx()),
 	)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	_, res, err := c.session.Execute(ctx, txc, normalizedQuery, &parameters, c.dataQueryOptions(ctx)...)
 	if err != nil {
 		return nil, badconn.Map(xerrors.WithStackTrace(err))
 	}
@@ -351,10 +358,15 @@ func (c *conn) queryContext(ctx context.Context, query string, args []driver.Nam
 }
 
 func (c *conn) execDataQuery(ctx context.Context, query string, params params.Parameters) (driver.Rows, error) {
-	_, res, err := c.session.Execute(ctx,
-		txControl(ctx, c.defaultTxControl),
-		query, &params, c.dataQueryOptions(ctx)...,
+	txc := txControl(ctx, c.defaultTxControl)
+	ctx, query, params, err := c.intercept(ctx, query, params,
+		interceptor.TxControl(txc.Desc(), txc.Desc().GetBeginTx()),
 	)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	_, res, err := c.session.Execute(ctx, txc, query, &params, c.dataQueryOptions(ctx)...)
 	if err != nil {
 		return nil, badconn.Map(xerrors.WithStackTrace(err))
 	}
@@ -369,6 +381,11 @@ func (c *conn) execDataQuery(ctx context.Context, query string, params params.Pa
 }
 
 func (c *conn) execScanQuery(ctx context.Context, query string, params params.Parameters) (driver.Rows, error) {
+	ctx, query, params, err := c.intercept(ctx, query, params, interceptors.TxControl{})
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
 	res, err := c.session.StreamExecuteScanQuery(ctx,
 		query, &params, c.scanQueryOptions(ctx)...,
 	)
@@ -476,6 +493,35 @@ func (c *conn) normalize(q string, args ...driver.NamedValue) (query string, _ p
 	}()...)
 }
 
+// intercept calls interceptors of connector before execution of data or scan query and returns
+// context with observer of execution for table session
+func (c *conn) intercept(
+	ctx context.Context, query string, parameters params.Parameters, txControl interceptors.TxControl,
+) (context.Context, string, params.Parameters, error) {
+	if c.connector.interceptor == nil {
+		return ctx, query, parameters, nil
+	}
+
+	call := &interceptors.Call{
+		Client:    interceptors.DatabaseSQL,
+		Query:     query,
+		Params:    &parameters,
+		TxControl: txControl,
+	}
+
+	observer, err := c.connector.interceptor(ctx, call)
+	if err != nil {
+		return ctx, "", nil, xerrors.WithStackTrace(err)
+	}
+
+	parameters = nil
+	if call.Params != nil {
+		parameters = *call.Params
+	}
+
+	return interceptor.WithObserver(ctx, observer), call.Query, parameters, nil
+}
+
 func (c *conn) ID() string {
 	return c.session.ID()
 }
@@ -852,6 +898,7 @@ func (c *conn) retryIdempotent(ctx context.Context, f func(ctx context.Context)
 		retry.WithIdempotent(true),
 		retry.WithTrace(c.connector.traceRetry),
 		retry.WithBudget(c.connector.retryBudget),
+		retry.WithPolicy(c.connector.retryPolicy),
 	)
 	if err != nil {
 		return xerrors.WithStackTrace(err)
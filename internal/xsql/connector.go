@@ -9,12 +9,14 @@ import (
 
 	"github.com/jonboulle/clockwork"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/interceptors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/bind"
 	metaHeaders "github.com/ydb-platform/ydb-go-sdk/v3/internal/meta"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/meta"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
 	"github.com/ydb-platform/ydb-go-sdk/v3/retry/budget"
 	"github.com/ydb-platform/ydb-go-sdk/v3/scheme"
 	"github.com/ydb-platform/ydb-go-sdk/v3/scripting"
@@ -190,6 +192,20 @@ func WithretryBudget(b budget.Budget) ConnectorOption {
 	return retryBudgetConnectorOption{b: b}
 }
 
+type retryPolicyConnectorOption struct {
+	p *retry.Policy
+}
+
+func (l retryPolicyConnectorOption) Apply(c *Connector) error {
+	c.retryPolicy = l.p
+
+	return nil
+}
+
+func WithRetryPolicy(p *retry.Policy) ConnectorOption {
+	return retryPolicyConnectorOption{p: p}
+}
+
 type fakeTxConnectorOption QueryMode
 
 func (m fakeTxConnectorOption) Apply(c *Connector) error {
@@ -203,6 +219,19 @@ func WithFakeTx(m QueryMode) ConnectorOption {
 	return fakeTxConnectorOption(m)
 }
 
+type interceptorsConnectorOption []interceptors.Interceptor
+
+func (list interceptorsConnectorOption) Apply(c *Connector) error {
+	c.interceptor = interceptors.Merge(append([]interceptors.Interceptor{c.interceptor}, list...)...)
+
+	return nil
+}
+
+// WithInterceptors appends interceptors of data and scan queries
+func WithInterceptors(list ...interceptors.Interceptor) ConnectorOption {
+	return interceptorsConnectorOption(list)
+}
+
 type ydbDriver interface {
 	Name() string
 	Table() table.Client
@@ -266,6 +295,9 @@ type Connector struct {
 	trace       *trace.DatabaseSQL
 	traceRetry  *trace.Retry
 	retryBudget budget.Budget
+	retryPolicy *retry.Policy
+
+	interceptor interceptors.Interceptor
 }
 
 var (
@@ -373,6 +405,10 @@ func (d *driverWrapper) RetryBudget() budget.Budget {
 	return d.c.retryBudget
 }
 
+func (d *driverWrapper) RetryPolicy() *retry.Policy {
+	return d.c.retryPolicy
+}
+
 func (d *driverWrapper) Open(_ string) (driver.Conn, error) {
 	return nil, ErrUnsupported
 }
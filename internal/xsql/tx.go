@@ -5,6 +5,7 @@ import (
 	"database/sql/driver"
 	"fmt"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/interceptors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/tx"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
@@ -147,6 +148,10 @@ func (tx *transaction) QueryContext(ctx context.Context, query string, args []dr
 	if err != nil {
 		return nil, xerrors.WithStackTrace(err)
 	}
+	ctx, query, parameters, err = tx.conn.intercept(ctx, query, parameters, interceptors.TxControl{TxID: tx.ID()})
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
 	res, err := tx.tx.Execute(ctx,
 		query, &parameters, tx.conn.dataQueryOptions(ctx)...,
 	)
@@ -189,12 +194,17 @@ func (tx *transaction) ExecContext(ctx context.Context, query string, args []dri
 	if err != nil {
 		return nil, xerrors.WithStackTrace(err)
 	}
-	_, err = tx.tx.Execute(ctx,
+	ctx, query, parameters, err = tx.conn.intercept(ctx, query, parameters, interceptors.TxControl{TxID: tx.ID()})
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	res, err := tx.tx.Execute(ctx,
 		query, &parameters, tx.conn.dataQueryOptions(ctx)...,
 	)
 	if err != nil {
 		return nil, badconn.Map(xerrors.WithStackTrace(err))
 	}
+	_ = res.Close()
 
 	return resultNoRows{}, nil
 }
@@ -475,6 +475,75 @@ func internalDriver(l Logger, d trace.Detailer) trace.Driver {
 				)
 			}
 		},
+		OnBalancerCircuitBreakerStateChange: func(
+			info trace.DriverBalancerCircuitBreakerStateChangeStartInfo,
+		) func(
+			trace.DriverBalancerCircuitBreakerStateChangeDoneInfo,
+		) {
+			if d.Details()&trace.DriverBalancerEvents == 0 {
+				return nil
+			}
+			ctx := with(*info.Context, WARN, "ydb", "driver", "balancer", "circuit breaker", "state change")
+			l.Log(ctx, "",
+				Stringer("endpoint", info.Endpoint),
+				String("label", info.Label),
+				String("from", info.From),
+				String("to", info.To),
+			)
+
+			return nil
+		},
+		OnBalancerConcurrencyLimiterAcquire: func(
+			info trace.DriverBalancerConcurrencyLimiterAcquireStartInfo,
+		) func(
+			trace.DriverBalancerConcurrencyLimiterAcquireDoneInfo,
+		) {
+			if d.Details()&trace.DriverBalancerEvents == 0 {
+				return nil
+			}
+			ctx := with(*info.Context, TRACE, "ydb", "driver", "balancer", "concurrency limiter", "acquire")
+			service := info.Service
+			l.Log(ctx, "start",
+				String("service", service),
+				Int("limit", info.Limit),
+				Int("inFlight", info.InFlight),
+				Int("queued", info.Queued),
+			)
+			start := time.Now()
+
+			return func(info trace.DriverBalancerConcurrencyLimiterAcquireDoneInfo) {
+				if info.Error == nil {
+					l.Log(ctx, "done",
+						String("service", service),
+						latencyField(start),
+					)
+				} else {
+					l.Log(WithLevel(ctx, WARN), "fail",
+						Error(info.Error),
+						String("service", service),
+						latencyField(start),
+						versionField(),
+					)
+				}
+			}
+		},
+		OnBalancerConcurrencyLimitChange: func(
+			info trace.DriverBalancerConcurrencyLimitChangeStartInfo,
+		) func(
+			trace.DriverBalancerConcurrencyLimitChangeDoneInfo,
+		) {
+			if d.Details()&trace.DriverBalancerEvents == 0 {
+				return nil
+			}
+			ctx := with(*info.Context, DEBUG, "ydb", "driver", "balancer", "concurrency limiter", "limit change")
+			l.Log(ctx, "",
+				String("service", info.Service),
+				Int("from", info.From),
+				Int("to", info.To),
+			)
+
+			return nil
+		},
 		OnGetCredentials: func(info trace.DriverGetCredentialsStartInfo) func(trace.DriverGetCredentialsDoneInfo) {
 			if d.Details()&trace.DriverCredentialsEvents == 0 {
 				return nil
@@ -499,5 +568,42 @@ func internalDriver(l Logger, d trace.Detailer) trace.Driver {
 				}
 			}
 		},
+		OnTLSCertificatesReload: func(
+			info trace.DriverTLSCertificatesReloadStartInfo,
+		) func(trace.DriverTLSCertificatesReloadDoneInfo) {
+			if d.Details()&trace.DriverCredentialsEvents == 0 {
+				return nil
+			}
+			ctx := with(*info.Context, TRACE, "ydb", "driver", "credentials", "tls", "reload")
+			caFile := info.CAFile
+			certFile := info.CertFile
+			keyFile := info.KeyFile
+			l.Log(ctx, "start",
+				String("ca_file", caFile),
+				String("cert_file", certFile),
+				String("key_file", keyFile),
+			)
+			start := time.Now()
+
+			return func(info trace.DriverTLSCertificatesReloadDoneInfo) {
+				if info.Error == nil {
+					l.Log(WithLevel(ctx, INFO), "done",
+						latencyField(start),
+						String("ca_file", caFile),
+						String("cert_file", certFile),
+						String("key_file", keyFile),
+					)
+				} else {
+					l.Log(WithLevel(ctx, ERROR), "done",
+						Error(info.Error),
+						latencyField(start),
+						String("ca_file", caFile),
+						String("cert_file", certFile),
+						String("key_file", keyFile),
+						versionField(),
+					)
+				}
+			}
+		},
 	}
 }
@@ -98,8 +98,10 @@ func (l *defaultLogger) Log(ctx context.Context, msg string, fields ...Field) {
 }
 
 type wrapper struct {
-	logQuery bool
-	logger   Logger
+	logQuery      bool
+	redaction     bool
+	allowedParams map[string]struct{}
+	logger        Logger
 }
 
 func wrapLogger(l Logger, opts ...Option) *wrapper {
@@ -1,5 +1,7 @@
 package log
 
+import "strings"
+
 type Option interface {
 	applyHolderOption(l *wrapper)
 }
@@ -37,3 +39,22 @@ func (logQuery logQueryOption) applyHolderOption(l *wrapper) {
 func WithLogQuery() logQueryOption {
 	return true
 }
+
+type paramsRedactionOption []string
+
+func (allowedParams paramsRedactionOption) applyHolderOption(l *wrapper) {
+	l.redaction = true
+	l.allowedParams = make(map[string]struct{}, len(allowedParams))
+	for _, name := range allowedParams {
+		l.allowedParams[strings.TrimPrefix(name, "$")] = struct{}{}
+	}
+}
+
+// WithParamsRedaction hides values of query parameters and strips literals from query texts
+// in logs of query and table clients. Values of parameters with names from allowedParams
+// are logged as is
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithParamsRedaction(allowedParams ...string) paramsRedactionOption {
+	return allowedParams
+}
@@ -459,7 +459,8 @@ func internalQuery(
 			l.Log(ctx, "start",
 				String("SessionID", info.Session.ID()),
 				String("SessionStatus", info.Session.Status()),
-				String("Query", info.Query),
+				l.queryField("Query", info.Query),
+				fingerprintField("QueryFingerprint", info.Query),
 			)
 			start := time.Now()
 
@@ -489,7 +490,8 @@ func internalQuery(
 			l.Log(ctx, "start",
 				String("SessionID", info.Session.ID()),
 				String("SessionStatus", info.Session.Status()),
-				String("Query", info.Query),
+				l.queryField("Query", info.Query),
+				fingerprintField("QueryFingerprint", info.Query),
 			)
 			start := time.Now()
 
@@ -547,9 +549,13 @@ func internalQuery(
 			}
 			ctx := with(*info.Context, TRACE, "ydb", "query", "transaction", "exec")
 			l.Log(ctx, "start",
-				String("SessionID", info.Session.ID()),
-				String("TransactionID", info.Tx.ID()),
-				String("SessionStatus", info.Session.Status()),
+				appendFieldByCondition(l.logQuery,
+					l.queryField("Query", info.Query),
+					String("SessionID", info.Session.ID()),
+					String("TransactionID", info.Tx.ID()),
+					String("SessionStatus", info.Session.Status()),
+					fingerprintField("QueryFingerprint", info.Query),
+				)...,
 			)
 			start := time.Now()
 
@@ -577,9 +583,13 @@ func internalQuery(
 			}
 			ctx := with(*info.Context, TRACE, "ydb", "query", "transaction", "query")
 			l.Log(ctx, "start",
-				String("SessionID", info.Session.ID()),
-				String("TransactionID", info.Tx.ID()),
-				String("SessionStatus", info.Session.Status()),
+				appendFieldByCondition(l.logQuery,
+					l.queryField("Query", info.Query),
+					String("SessionID", info.Session.ID()),
+					String("TransactionID", info.Tx.ID()),
+					String("SessionStatus", info.Session.Status()),
+					fingerprintField("QueryFingerprint", info.Query),
+				)...,
 			)
 			start := time.Now()
 
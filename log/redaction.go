@@ -0,0 +1,102 @@
+package log
+
+import (
+	"strings"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/fingerprint"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xstring"
+)
+
+const redacted = "<redacted>"
+
+type (
+	dataQuery interface {
+		String() string
+		YQL() string
+	}
+	queryParameters interface {
+		String() string
+	}
+	redactedParameters struct {
+		parameters queryParameters
+		allowed    map[string]struct{}
+	}
+)
+
+func (p redactedParameters) String() string {
+	parameters, ok := p.parameters.(*params.Parameters)
+	if !ok {
+		return redacted
+	}
+
+	buffer := xstring.Buffer()
+	defer buffer.Free()
+
+	buffer.WriteByte('{')
+	i := 0
+	parameters.Each(func(name string, v value.Value) {
+		if i != 0 {
+			buffer.WriteByte(',')
+		}
+		i++
+		buffer.WriteByte('"')
+		buffer.WriteString(name)
+		buffer.WriteString("\":")
+		if _, has := p.allowed[strings.TrimPrefix(name, "$")]; has {
+			buffer.WriteString(v.Yql())
+		} else {
+			buffer.WriteString(redacted)
+		}
+	})
+	buffer.WriteByte('}')
+
+	return buffer.String()
+}
+
+// queryField makes field with query text. Literals of query are stripped if redaction enabled
+func (l *wrapper) queryField(key, q string) Field {
+	if l.redaction {
+		return Stringer(key, fingerprint.Normalized(q))
+	}
+
+	return String(key, q)
+}
+
+// dataQueryField makes field with table data query. Literals of query are stripped if redaction enabled
+func (l *wrapper) dataQueryField(key string, q dataQuery) Field {
+	if q == nil {
+		return Any(key, nil)
+	}
+	if l.redaction {
+		return Stringer(key, fingerprint.Normalized(q.YQL()))
+	}
+
+	return Stringer(key, q)
+}
+
+// paramsField makes field with query parameters. Values of parameters are hidden if redaction enabled
+// excluding allowed parameters
+func (l *wrapper) paramsField(key string, p queryParameters) Field {
+	if p == nil {
+		return Any(key, nil)
+	}
+	if l.redaction {
+		return Stringer(key, redactedParameters{
+			parameters: p,
+			allowed:    l.allowedParams,
+		})
+	}
+
+	return Stringer(key, p)
+}
+
+func fingerprintField(key, q string) Field {
+	return Stringer(key, fingerprint.Stringer(q))
+}
+
+// dataQueryFingerprintField makes field with lazy fingerprint of table data query, nil query has empty fingerprint
+func dataQueryFingerprintField(key string, q dataQuery) Field {
+	return Stringer(key, fingerprint.DataQuery{Query: q})
+}
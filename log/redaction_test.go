@@ -0,0 +1,41 @@
+package log
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+)
+
+func TestRedaction(t *testing.T) {
+	parameters := &params.Parameters{
+		params.Named("$id", value.Int64Value(42)),
+		params.Named("$email", value.TextValue("john@example.com")),
+	}
+	q := `SELECT * FROM users WHERE id = $id AND email = $email AND name = 'John'`
+
+	t.Run("Disabled", func(t *testing.T) {
+		l := wrapLogger(nil, WithLogQuery())
+		require.Equal(t, q, l.queryField("query", q).String())
+		require.Equal(t, `{"$id":42l,"$email":"john@example.com"u}`, l.paramsField("params", parameters).String())
+	})
+	t.Run("Enabled", func(t *testing.T) {
+		l := wrapLogger(nil, WithLogQuery(), WithParamsRedaction("id"))
+		require.Equal(t,
+			"SELECT*FROM users WHERE id=$id AND email=$email AND name=?",
+			l.queryField("query", q).String(),
+		)
+		require.Equal(t, `{"$id":42l,"$email":<redacted>}`, l.paramsField("params", parameters).String())
+	})
+	t.Run("Fingerprint", func(t *testing.T) {
+		require.Equal(t,
+			fingerprintField("fingerprint", q).String(),
+			fingerprintField("fingerprint", `SELECT * FROM users WHERE id = $id AND email = $email AND name = 'Jane'`).String(),
+		)
+	})
+	t.Run("NilDataQueryFingerprint", func(t *testing.T) {
+		require.Equal(t, "", dataQueryFingerprintField("fingerprint", nil).String())
+	})
+}
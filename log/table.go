@@ -239,9 +239,10 @@ func internalTable(l *wrapper, d trace.Detailer) (t trace.Table) {
 		query := info.Query
 		l.Log(ctx, "start",
 			appendFieldByCondition(l.logQuery,
-				String("query", info.Query),
+				l.queryField("query", info.Query),
 				String("id", session.ID()),
 				String("status", session.Status()),
+				fingerprintField("fingerprint", info.Query),
 			)...,
 		)
 		start := time.Now()
@@ -252,7 +253,7 @@ func internalTable(l *wrapper, d trace.Detailer) (t trace.Table) {
 					appendFieldByCondition(l.logQuery,
 						Stringer("result", info.Result),
 						appendFieldByCondition(l.logQuery,
-							String("query", query),
+							l.queryField("query", query),
 							String("id", session.ID()),
 							String("status", session.Status()),
 							latencyField(start),
@@ -262,7 +263,7 @@ func internalTable(l *wrapper, d trace.Detailer) (t trace.Table) {
 			} else {
 				l.Log(WithLevel(ctx, ERROR), "failed",
 					appendFieldByCondition(l.logQuery,
-						String("query", query),
+						l.queryField("query", query),
 						Error(info.Error),
 						String("id", session.ID()),
 						String("status", session.Status()),
@@ -286,9 +287,13 @@ func internalTable(l *wrapper, d trace.Detailer) (t trace.Table) {
 		query := info.Query
 		l.Log(ctx, "start",
 			appendFieldByCondition(l.logQuery,
-				Stringer("query", info.Query),
-				String("id", session.ID()),
-				String("status", session.Status()),
+				l.dataQueryField("query", info.Query),
+				appendFieldByCondition(l.logQuery,
+					l.paramsField("params", info.Parameters),
+					String("id", session.ID()),
+					String("status", session.Status()),
+					dataQueryFingerprintField("fingerprint", info.Query),
+				)...,
 			)...,
 		)
 		start := time.Now()
@@ -298,7 +303,7 @@ func internalTable(l *wrapper, d trace.Detailer) (t trace.Table) {
 				tx := info.Tx
 				l.Log(ctx, "done",
 					appendFieldByCondition(l.logQuery,
-						Stringer("query", query),
+						l.dataQueryField("query", query),
 						String("id", session.ID()),
 						String("tx", tx.ID()),
 						String("status", session.Status()),
@@ -310,7 +315,7 @@ func internalTable(l *wrapper, d trace.Detailer) (t trace.Table) {
 			} else {
 				l.Log(WithLevel(ctx, ERROR), "failed",
 					appendFieldByCondition(l.logQuery,
-						Stringer("query", query),
+						l.dataQueryField("query", query),
 						Error(info.Error),
 						String("id", session.ID()),
 						String("status", session.Status()),
@@ -335,9 +340,13 @@ func internalTable(l *wrapper, d trace.Detailer) (t trace.Table) {
 		query := info.Query
 		l.Log(ctx, "start",
 			appendFieldByCondition(l.logQuery,
-				Stringer("query", info.Query),
-				String("id", session.ID()),
-				String("status", session.Status()),
+				l.dataQueryField("query", info.Query),
+				appendFieldByCondition(l.logQuery,
+					l.paramsField("params", info.Parameters),
+					String("id", session.ID()),
+					String("status", session.Status()),
+					dataQueryFingerprintField("fingerprint", info.Query),
+				)...,
 			)...,
 		)
 		start := time.Now()
@@ -346,7 +355,7 @@ func internalTable(l *wrapper, d trace.Detailer) (t trace.Table) {
 			if info.Error == nil {
 				l.Log(ctx, "done",
 					appendFieldByCondition(l.logQuery,
-						Stringer("query", query),
+						l.dataQueryField("query", query),
 						Error(info.Error),
 						String("id", session.ID()),
 						String("status", session.Status()),
@@ -356,7 +365,7 @@ func internalTable(l *wrapper, d trace.Detailer) (t trace.Table) {
 			} else {
 				l.Log(WithLevel(ctx, ERROR), "failed",
 					appendFieldByCondition(l.logQuery,
-						Stringer("query", query),
+						l.dataQueryField("query", query),
 						Error(info.Error),
 						String("id", session.ID()),
 						String("status", session.Status()),
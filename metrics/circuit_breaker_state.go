@@ -0,0 +1,15 @@
+package metrics
+
+import (
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry/breaker"
+)
+
+func circuitBreakerStateValue(state string) breaker.State {
+	for _, s := range []breaker.State{breaker.Closed, breaker.Open, breaker.HalfOpen} {
+		if s.String() == state {
+			return s
+		}
+	}
+
+	return breaker.Closed
+}
@@ -2,6 +2,7 @@ package metrics
 
 import (
 	"strconv"
+	"time"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/repeater"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
@@ -16,6 +17,13 @@ func driver(config Config) (t trace.Driver) {
 	endpoints := config.WithSystem("balancer").GaugeVec("endpoints", "az")
 	balancersDiscoveries := config.WithSystem("balancer").CounterVec("discoveries", "status", "cause")
 	balancerUpdates := config.WithSystem("balancer").CounterVec("updates", "cause")
+	circuitBreakers := config.WithSystem("balancer").GaugeVec("circuit_breaker_state", "endpoint", "label")
+	circuitBreakerTransitions := config.WithSystem("balancer").CounterVec("circuit_breaker_transitions",
+		"endpoint", "label", "to",
+	)
+	concurrencyLimits := config.WithSystem("balancer").GaugeVec("concurrency_limit", "service")
+	concurrencyAcquires := config.WithSystem("balancer").CounterVec("concurrency_acquires", "service", "status")
+	concurrencyWaits := config.WithSystem("balancer").TimerVec("concurrency_wait", "service")
 	conns := config.GaugeVec("conns", "endpoint", "node_id")
 	banned := config.WithSystem("conn").GaugeVec("banned", "endpoint", "node_id", "cause")
 	requests := config.WithSystem("conn").CounterVec("requests", "status", "method", "endpoint", "node_id")
@@ -122,6 +130,61 @@ func driver(config Config) (t trace.Driver) {
 			}
 		}
 	}
+	t.OnBalancerCircuitBreakerStateChange = func(
+		info trace.DriverBalancerCircuitBreakerStateChangeStartInfo,
+	) func(
+		trace.DriverBalancerCircuitBreakerStateChangeDoneInfo,
+	) {
+		if config.Details()&trace.DriverBalancerEvents != 0 {
+			labels := map[string]string{
+				"endpoint": info.Endpoint.Address(),
+				"label":    info.Label,
+			}
+			// 0 - closed, 1 - open, 2 - half-open
+			circuitBreakers.With(labels).Set(float64(circuitBreakerStateValue(info.To)))
+			labels["to"] = info.To
+			circuitBreakerTransitions.With(labels).Inc()
+		}
+
+		return nil
+	}
+	t.OnBalancerConcurrencyLimiterAcquire = func(
+		info trace.DriverBalancerConcurrencyLimiterAcquireStartInfo,
+	) func(
+		trace.DriverBalancerConcurrencyLimiterAcquireDoneInfo,
+	) {
+		if config.Details()&trace.DriverBalancerEvents == 0 {
+			return nil
+		}
+		service := info.Service
+		start := time.Now()
+		concurrencyLimits.With(map[string]string{
+			"service": service,
+		}).Set(float64(info.Limit))
+
+		return func(info trace.DriverBalancerConcurrencyLimiterAcquireDoneInfo) {
+			concurrencyAcquires.With(map[string]string{
+				"service": service,
+				"status":  errorBrief(info.Error),
+			}).Inc()
+			concurrencyWaits.With(map[string]string{
+				"service": service,
+			}).Record(time.Since(start))
+		}
+	}
+	t.OnBalancerConcurrencyLimitChange = func(
+		info trace.DriverBalancerConcurrencyLimitChangeStartInfo,
+	) func(
+		trace.DriverBalancerConcurrencyLimitChangeDoneInfo,
+	) {
+		if config.Details()&trace.DriverBalancerEvents != 0 {
+			concurrencyLimits.With(map[string]string{
+				"service": info.Service,
+			}).Set(float64(info.To))
+		}
+
+		return nil
+	}
 	t.OnConnDial = func(info trace.DriverConnDialStartInfo) func(trace.DriverConnDialDoneInfo) {
 		endpoint := info.Endpoint.Address()
 		nodeID := info.Endpoint.NodeID()
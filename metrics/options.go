@@ -0,0 +1,61 @@
+package metrics
+
+import "github.com/ydb-platform/ydb-go-sdk/v3/internal/fingerprint"
+
+const fingerprintLabel = "fingerprint"
+
+type (
+	// Option is an option of metrics traces
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	Option  func(o *options)
+	options struct {
+		queryFingerprint bool
+	}
+)
+
+// WithQueryFingerprint adds `fingerprint` label with fingerprint of normalized query text
+// to latency metrics of query client and database/sql queries
+//
+// Fingerprint does not depend on literals, comments and formatting of query, so number of label
+// values is limited by number of distinct queries in application code
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithQueryFingerprint() Option {
+	return func(o *options) {
+		o.queryFingerprint = true
+	}
+}
+
+func newOptions(opts ...Option) *options {
+	o := &options{}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(o)
+		}
+	}
+
+	return o
+}
+
+// queryLabelNames appends fingerprint label name to names if fingerprints enabled
+func (o *options) queryLabelNames(names ...string) []string {
+	if o.queryFingerprint {
+		return append(names, fingerprintLabel)
+	}
+
+	return names
+}
+
+// queryLabels appends fingerprint of query q to labels if fingerprints enabled
+func (o *options) queryLabels(labels map[string]string, q string) map[string]string {
+	if !o.queryFingerprint {
+		return labels
+	}
+	if labels == nil {
+		labels = make(map[string]string, 1)
+	}
+	labels[fingerprintLabel] = fingerprint.Fingerprint(q)
+
+	return labels
+}
@@ -0,0 +1,23 @@
+package metrics
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryLabels(t *testing.T) {
+	t.Run("Default", func(t *testing.T) {
+		o := newOptions()
+		require.Equal(t, []string{"query_mode"}, o.queryLabelNames("query_mode"))
+		require.Nil(t, o.queryLabels(nil, "SELECT 1"))
+	})
+	t.Run("WithQueryFingerprint", func(t *testing.T) {
+		o := newOptions(WithQueryFingerprint())
+		require.Equal(t, []string{"query_mode", "fingerprint"}, o.queryLabelNames("query_mode"))
+		labels := o.queryLabels(map[string]string{"query_mode": "data"}, "SELECT 1")
+		require.Equal(t, "data", labels["query_mode"])
+		require.Equal(t, labels, o.queryLabels(map[string]string{"query_mode": "data"}, "SELECT 2"))
+		require.NotEqual(t, labels, o.queryLabels(map[string]string{"query_mode": "data"}, "SELECT 1 FROM t"))
+	})
+}
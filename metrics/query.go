@@ -7,7 +7,7 @@ import (
 )
 
 //nolint:funlen
-func query(config Config) (t trace.Query) {
+func query(config Config, o *options) (t trace.Query) {
 	queryConfig := config.WithSystem("query")
 	{
 		poolConfig := queryConfig.WithSystem("pool")
@@ -150,16 +150,17 @@ func query(config Config) (t trace.Query) {
 		{
 			sessionExecConfig := sessionConfig.WithSystem("exec")
 			errs := sessionExecConfig.CounterVec("errs", "status")
-			latency := sessionExecConfig.TimerVec("latency")
+			latency := sessionExecConfig.TimerVec("latency", o.queryLabelNames()...)
 			t.OnSessionExec = func(info trace.QuerySessionExecStartInfo) func(info trace.QuerySessionExecDoneInfo) {
 				start := time.Now()
+				labels := o.queryLabels(nil, info.Query)
 
 				return func(info trace.QuerySessionExecDoneInfo) {
 					if sessionExecConfig.Details()&trace.QuerySessionEvents != 0 {
 						errs.With(map[string]string{
 							"status": errorBrief(info.Error),
 						}).Inc()
-						latency.With(nil).Record(time.Since(start))
+						latency.With(labels).Record(time.Since(start))
 					}
 				}
 			}
@@ -167,16 +168,17 @@ func query(config Config) (t trace.Query) {
 		{
 			sessionQueryConfig := sessionConfig.WithSystem("query")
 			errs := sessionQueryConfig.CounterVec("errs", "status")
-			latency := sessionQueryConfig.TimerVec("latency")
+			latency := sessionQueryConfig.TimerVec("latency", o.queryLabelNames()...)
 			t.OnSessionQuery = func(info trace.QuerySessionQueryStartInfo) func(info trace.QuerySessionQueryDoneInfo) {
 				start := time.Now()
+				labels := o.queryLabels(nil, info.Query)
 
 				return func(info trace.QuerySessionQueryDoneInfo) {
 					if sessionQueryConfig.Details()&trace.QuerySessionEvents != 0 {
 						errs.With(map[string]string{
 							"status": errorBrief(info.Error),
 						}).Inc()
-						latency.With(nil).Record(time.Since(start))
+						latency.With(labels).Record(time.Since(start))
 					}
 				}
 			}
@@ -204,16 +206,17 @@ func query(config Config) (t trace.Query) {
 		{
 			txExecConfig := txConfig.WithSystem("exec")
 			errs := txExecConfig.CounterVec("errs", "status")
-			latency := txExecConfig.TimerVec("latency")
+			latency := txExecConfig.TimerVec("latency", o.queryLabelNames()...)
 			t.OnTxExec = func(info trace.QueryTxExecStartInfo) func(info trace.QueryTxExecDoneInfo) {
 				start := time.Now()
+				labels := o.queryLabels(nil, info.Query)
 
 				return func(info trace.QueryTxExecDoneInfo) {
 					if txExecConfig.Details()&trace.QuerySessionEvents != 0 {
 						errs.With(map[string]string{
 							"status": errorBrief(info.Error),
 						}).Inc()
-						latency.With(nil).Record(time.Since(start))
+						latency.With(labels).Record(time.Since(start))
 					}
 				}
 			}
@@ -221,16 +224,17 @@ func query(config Config) (t trace.Query) {
 		{
 			txQueryConfig := txConfig.WithSystem("query")
 			errs := txQueryConfig.CounterVec("errs", "status")
-			latency := txQueryConfig.TimerVec("latency")
+			latency := txQueryConfig.TimerVec("latency", o.queryLabelNames()...)
 			t.OnTxQuery = func(info trace.QueryTxQueryStartInfo) func(info trace.QueryTxQueryDoneInfo) {
 				start := time.Now()
+				labels := o.queryLabels(nil, info.Query)
 
 				return func(info trace.QueryTxQueryDoneInfo) {
 					if txQueryConfig.Details()&trace.QuerySessionEvents != 0 {
 						errs.With(map[string]string{
 							"status": errorBrief(info.Error),
 						}).Inc()
-						latency.With(nil).Record(time.Since(start))
+						latency.With(labels).Record(time.Since(start))
 					}
 				}
 			}
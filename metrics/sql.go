@@ -9,14 +9,14 @@ import (
 // databaseSQL makes trace.DatabaseSQL with measuring `database/sql` events
 //
 //nolint:funlen
-func databaseSQL(config Config) (t trace.DatabaseSQL) {
+func databaseSQL(config Config, o *options) (t trace.DatabaseSQL) {
 	config = config.WithSystem("database").WithSystem("sql")
 	conns := config.GaugeVec("conns")
 	inflight := config.WithSystem("conns").GaugeVec("inflight")
 	query := config.CounterVec("query", "status", "query_mode")
-	queryLatency := config.WithSystem("query").TimerVec("latency", "query_mode")
+	queryLatency := config.WithSystem("query").TimerVec("latency", o.queryLabelNames("query_mode")...)
 	exec := config.CounterVec("exec", "status", "query_mode")
-	execLatency := config.WithSystem("exec").TimerVec("latency", "query_mode")
+	execLatency := config.WithSystem("exec").TimerVec("latency", o.queryLabelNames("query_mode")...)
 
 	config = config.WithSystem("tx")
 	txBegin := config.CounterVec("begin", "status")
@@ -120,6 +120,7 @@ func databaseSQL(config Config) (t trace.DatabaseSQL) {
 		}
 		var (
 			mode  = info.Mode
+			q     = info.Query
 			start = time.Now()
 		)
 
@@ -133,9 +134,9 @@ func databaseSQL(config Config) (t trace.DatabaseSQL) {
 					"status":     status,
 					"query_mode": mode,
 				}).Inc()
-				execLatency.With(map[string]string{
+				execLatency.With(o.queryLabels(map[string]string{
 					"query_mode": mode,
-				}).Record(time.Since(start))
+				}, q)).Record(time.Since(start))
 			}
 		}
 	}
@@ -145,6 +146,7 @@ func databaseSQL(config Config) (t trace.DatabaseSQL) {
 		}
 		var (
 			mode  = info.Mode
+			q     = info.Query
 			start = time.Now()
 		)
 
@@ -158,9 +160,9 @@ func databaseSQL(config Config) (t trace.DatabaseSQL) {
 					"status":     status,
 					"query_mode": mode,
 				}).Inc()
-				queryLatency.With(map[string]string{
+				queryLatency.With(o.queryLabels(map[string]string{
 					"query_mode": mode,
-				}).Record(time.Since(start))
+				}, q)).Record(time.Since(start))
 			}
 		}
 	}
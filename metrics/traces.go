@@ -4,22 +4,23 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3"
 )
 
-func WithTraces(config Config) ydb.Option {
+func WithTraces(config Config, opts ...Option) ydb.Option {
 	if config == nil {
 		return nil
 	}
 	config = config.WithSystem("ydb")
+	o := newOptions(opts...)
 
 	return ydb.MergeOptions(
 		ydb.WithTraceDriver(driver(config)),
 		ydb.WithTraceTable(table(config)),
-		ydb.WithTraceQuery(query(config)),
+		ydb.WithTraceQuery(query(config, o)),
 		ydb.WithTraceScripting(scripting(config)),
 		ydb.WithTraceScheme(scheme(config)),
 		ydb.WithTraceCoordination(coordination(config)),
 		ydb.WithTraceRatelimiter(ratelimiter(config)),
 		ydb.WithTraceDiscovery(discovery(config)),
-		ydb.WithTraceDatabaseSQL(databaseSQL(config)),
+		ydb.WithTraceDatabaseSQL(databaseSQL(config, o)),
 		ydb.WithTraceRetry(retry(config)),
 	)
 }
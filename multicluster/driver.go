@@ -0,0 +1,211 @@
+// Package multicluster provides Driver over several YDB clusters with automatic failover
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+package multicluster
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsync"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic"
+)
+
+var (
+	errNoClusters  = errors.New("no clusters")
+	errNoEndpoints = errors.New("no endpoints discovered")
+)
+
+type (
+	// Driver is a composition of drivers of several clusters in priority order
+	//
+	// All requests go to the active cluster, which is the first cluster at start. Health of
+	// clusters checks periodically with discovery and WhoAmI calls. If the active cluster
+	// becomes unhealthy, Driver switches to the next healthy cluster in priority order
+	// (failover). When cluster with higher priority becomes healthy again, Driver switches
+	// back to it (failback, can be disabled with WithoutFailback)
+	//
+	// Query(), Table() and Topic() clients and Connector() of Driver resolve the active
+	// cluster on each call. Objects which bound to cluster at creation (sessions, readers,
+	// writers, script operations and database/sql connections) are not moved on switch.
+	// Operation IDs of scripts started through Driver stay bound to their cluster
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	Driver struct {
+		config   config
+		clusters []*cluster
+
+		mu     sync.RWMutex
+		active int
+
+		// scripts binds operation IDs of started scripts to clusters
+		scripts xsync.Map[string, *cluster]
+
+		done      chan struct{}
+		wg        sync.WaitGroup
+		closeOnce func(ctx context.Context) error
+	}
+	cluster struct {
+		name      string
+		query     func() query.Client
+		table     func() table.Client
+		topic     func() topic.Client
+		check     func(ctx context.Context) error
+		connector func(opts ...ydb.ConnectorOption) (ydb.SQLConnector, error)
+		close     func(ctx context.Context) error
+
+		// health state, guarded by Driver.mu
+		healthy   bool
+		failures  int
+		successes int
+		latency   time.Duration
+	}
+)
+
+func newCluster(d *ydb.Driver) *cluster {
+	return &cluster{
+		name: d.Name(),
+		query: func() query.Client {
+			return d.Query()
+		},
+		table: d.Table,
+		topic: d.Topic,
+		check: func(ctx context.Context) error {
+			if _, err := d.Discovery().WhoAmI(ctx); err != nil {
+				return xerrors.WithStackTrace(err)
+			}
+			endpoints, err := d.Discovery().Discover(ctx)
+			if err != nil {
+				return xerrors.WithStackTrace(err)
+			}
+			if len(endpoints) == 0 {
+				return xerrors.WithStackTrace(errNoEndpoints)
+			}
+
+			return nil
+		},
+		connector: func(opts ...ydb.ConnectorOption) (ydb.SQLConnector, error) {
+			return ydb.Connector(d, opts...)
+		},
+		close:   d.Close,
+		healthy: true,
+	}
+}
+
+// New makes Driver over drivers of clusters in priority order and starts health checking
+//
+// Driver owns drivers: Close of Driver closes all of them
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func New(ctx context.Context, drivers []*ydb.Driver, opts ...Option) (*Driver, error) {
+	if len(drivers) == 0 {
+		return nil, xerrors.WithStackTrace(errNoClusters)
+	}
+
+	clusters := make([]*cluster, len(drivers))
+	for i, d := range drivers {
+		clusters[i] = newCluster(d)
+	}
+
+	d := newDriver(clusters, opts...)
+	d.startHealthChecks(ctx)
+
+	return d, nil
+}
+
+func newDriver(clusters []*cluster, opts ...Option) *Driver {
+	d := &Driver{
+		config:   defaultConfig(),
+		clusters: clusters,
+		done:     make(chan struct{}),
+	}
+	d.closeOnce = xsync.OnceFunc(d.close)
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&d.config)
+		}
+	}
+
+	return d
+}
+
+// Active returns name of the active cluster
+func (d *Driver) Active() string {
+	return d.activeCluster().name
+}
+
+func (d *Driver) activeCluster() *cluster {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	return d.clusters[d.active]
+}
+
+// pick returns the active cluster or the nearest healthy cluster for read-only requests
+// if nearest reads enabled
+func (d *Driver) pick(readOnly bool) *cluster {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	if !readOnly || !d.config.nearestReads {
+		return d.clusters[d.active]
+	}
+
+	nearest := d.clusters[d.active]
+	for _, c := range d.clusters {
+		if c.healthy && c.latency > 0 && (!nearest.healthy || nearest.latency == 0 || c.latency < nearest.latency) {
+			nearest = c
+		}
+	}
+
+	return nearest
+}
+
+// scriptCluster returns cluster which script started on or the active cluster for unknown scripts
+func (d *Driver) scriptCluster(opID string) *cluster {
+	if c, has := d.scripts.Get(opID); has {
+		return c
+	}
+
+	return d.activeCluster()
+}
+
+func (d *Driver) Query() query.Client {
+	return &queryClient{d: d}
+}
+
+func (d *Driver) Table() table.Client {
+	return &tableClient{d: d}
+}
+
+func (d *Driver) Topic() topic.Client {
+	return &topicClient{d: d}
+}
+
+// Close stops health checking and closes drivers of all clusters. Repeated calls of Close do nothing
+func (d *Driver) Close(ctx context.Context) error {
+	return d.closeOnce(ctx)
+}
+
+func (d *Driver) close(ctx context.Context) error {
+	close(d.done)
+	d.wg.Wait()
+
+	errs := make([]error, 0, len(d.clusters))
+	for _, c := range d.clusters {
+		if err := c.close(ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return xerrors.WithStackTrace(xerrors.Join(errs...))
+	}
+
+	return nil
+}
@@ -0,0 +1,309 @@
+package multicluster
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+)
+
+var errUnavailable = errors.New("unavailable")
+
+type stubCluster struct {
+	*cluster
+	err error
+}
+
+func newStubClusters(names ...string) []*stubCluster {
+	clusters := make([]*stubCluster, len(names))
+	for i, name := range names {
+		c := &stubCluster{}
+		c.cluster = &cluster{
+			name: name,
+			check: func(ctx context.Context) error {
+				return c.err
+			},
+			close: func(ctx context.Context) error {
+				return nil
+			},
+			healthy: true,
+		}
+		clusters[i] = c
+	}
+
+	return clusters
+}
+
+func newStubDriver(stubs []*stubCluster, opts ...Option) *Driver {
+	clusters := make([]*cluster, len(stubs))
+	for i := range stubs {
+		clusters[i] = stubs[i].cluster
+	}
+
+	return newDriver(clusters, opts...)
+}
+
+func TestFailoverAndFailback(t *testing.T) {
+	ctx := context.Background()
+	var switches []string
+	stubs := newStubClusters("primary", "standby", "reserve")
+	d := newStubDriver(stubs,
+		WithFailoverThreshold(2),
+		WithFailbackThreshold(2),
+		WithOnSwitch(func(from, to string) {
+			switches = append(switches, from+"->"+to)
+		}),
+	)
+	defer func() {
+		require.NoError(t, d.Close(ctx))
+	}()
+
+	require.Equal(t, "primary", d.Active())
+
+	stubs[0].err = errUnavailable
+	d.checkHealth(ctx)
+	require.Equal(t, "primary", d.Active())
+	d.checkHealth(ctx)
+	require.Equal(t, "standby", d.Active())
+
+	stubs[1].err = errUnavailable
+	d.checkHealth(ctx)
+	d.checkHealth(ctx)
+	require.Equal(t, "reserve", d.Active())
+
+	stubs[0].err = nil
+	d.checkHealth(ctx)
+	require.Equal(t, "reserve", d.Active())
+	d.checkHealth(ctx)
+	require.Equal(t, "primary", d.Active())
+
+	require.Equal(t, []string{"primary->standby", "standby->reserve", "reserve->primary"}, switches)
+}
+
+func TestWithoutFailback(t *testing.T) {
+	ctx := context.Background()
+	stubs := newStubClusters("primary", "standby")
+	d := newStubDriver(stubs, WithFailoverThreshold(1), WithoutFailback())
+	defer func() {
+		require.NoError(t, d.Close(ctx))
+	}()
+
+	stubs[0].err = errUnavailable
+	d.checkHealth(ctx)
+	require.Equal(t, "standby", d.Active())
+
+	stubs[0].err = nil
+	for i := 0; i < 10; i++ {
+		d.checkHealth(ctx)
+	}
+	require.Equal(t, "standby", d.Active())
+
+	stubs[1].err = errUnavailable
+	d.checkHealth(ctx)
+	require.Equal(t, "primary", d.Active())
+}
+
+func TestAllClustersUnhealthy(t *testing.T) {
+	ctx := context.Background()
+	stubs := newStubClusters("primary", "standby")
+	d := newStubDriver(stubs, WithFailoverThreshold(1))
+	defer func() {
+		require.NoError(t, d.Close(ctx))
+	}()
+
+	stubs[0].err = errUnavailable
+	stubs[1].err = errUnavailable
+	d.checkHealth(ctx)
+	require.Equal(t, "primary", d.Active())
+}
+
+func TestNearestReads(t *testing.T) {
+	stubs := newStubClusters("primary", "standby")
+	stubs[0].latency = 10 * time.Millisecond
+	stubs[1].latency = time.Millisecond
+
+	d := newStubDriver(stubs)
+	require.Equal(t, "primary", d.pick(true).name)
+
+	d = newStubDriver(stubs, WithNearestReads())
+	require.Equal(t, "primary", d.pick(false).name)
+	require.Equal(t, "standby", d.pick(true).name)
+
+	stubs[1].healthy = false
+	require.Equal(t, "primary", d.pick(true).name)
+}
+
+func TestNonPositiveOptions(t *testing.T) {
+	ctx := context.Background()
+	stubs := newStubClusters("primary", "standby")
+	d := newStubDriver(stubs,
+		WithHealthCheckInterval(0),
+		WithHealthCheckTimeout(-time.Second),
+		WithFailoverThreshold(-1),
+		WithFailbackThreshold(-1),
+	)
+	require.Equal(t, config{
+		healthCheckInterval: DefaultHealthCheckInterval,
+		healthCheckTimeout:  DefaultHealthCheckTimeout,
+		failoverThreshold:   DefaultFailoverThreshold,
+		failbackThreshold:   0,
+	}, d.config)
+
+	// health check loop must not panic on ticker creation
+	d.startHealthChecks(ctx)
+	require.NoError(t, d.Close(ctx))
+}
+
+func TestCloseTwice(t *testing.T) {
+	ctx := context.Background()
+	closes := 0
+	stubs := newStubClusters("primary")
+	stubs[0].close = func(ctx context.Context) error {
+		closes++
+
+		return nil
+	}
+	d := newStubDriver(stubs)
+	d.startHealthChecks(ctx)
+
+	require.NoError(t, d.Close(ctx))
+	require.NoError(t, d.Close(ctx))
+	require.Equal(t, 1, closes)
+}
+
+func TestIsReadOnlyTx(t *testing.T) {
+	require.False(t, isReadOnlyQueryTx())
+	require.False(t, isReadOnlyQueryTx(query.WithTxSettings(query.TxSettings(query.WithSerializableReadWrite()))))
+	require.True(t, isReadOnlyQueryTx(query.WithTxSettings(query.TxSettings(query.WithSnapshotReadOnly()))))
+	require.True(t, isReadOnlyQueryTx(query.WithTxSettings(query.TxSettings(query.WithOnlineReadOnly()))))
+
+	require.False(t, isReadOnlyTableTx())
+	require.False(t, isReadOnlyTableTx(table.WithTxSettings(table.TxSettings(table.WithSerializableReadWrite()))))
+	require.True(t, isReadOnlyTableTx(table.WithTxSettings(table.TxSettings(table.WithStaleReadOnly()))))
+}
+
+type stubQueryClient struct {
+	query.Client
+
+	name  string
+	calls *[]string
+}
+
+func (c *stubQueryClient) Query(ctx context.Context, q string, opts ...options.Execute) (query.Result, error) {
+	*c.calls = append(*c.calls, "Query:"+c.name)
+
+	return nil, nil
+}
+
+func (c *stubQueryClient) QueryRow(ctx context.Context, q string, opts ...options.Execute) (query.Row, error) {
+	*c.calls = append(*c.calls, "QueryRow:"+c.name)
+
+	return nil, nil
+}
+
+func (c *stubQueryClient) ExecuteScript(
+	ctx context.Context, q string, ttl time.Duration, opts ...options.Execute,
+) (*options.ExecuteScriptOperation, error) {
+	*c.calls = append(*c.calls, "ExecuteScript:"+c.name)
+
+	return &options.ExecuteScriptOperation{ID: "op-" + c.name}, nil
+}
+
+func (c *stubQueryClient) FetchScriptResults(
+	ctx context.Context, opID string, opts ...options.FetchScriptOption,
+) (*options.FetchScriptResult, error) {
+	*c.calls = append(*c.calls, "FetchScriptResults:"+c.name)
+
+	return &options.FetchScriptResult{}, nil
+}
+
+func (c *stubQueryClient) Script(opID string) query.Script {
+	*c.calls = append(*c.calls, "Script:"+c.name)
+
+	return &stubScript{id: opID}
+}
+
+type stubScript struct {
+	query.Script
+
+	id string
+}
+
+func (s *stubScript) ID() string {
+	return s.id
+}
+
+func (s *stubScript) Forget(ctx context.Context) error {
+	return nil
+}
+
+func newStubQueryClusters(calls *[]string, names ...string) []*stubCluster {
+	stubs := newStubClusters(names...)
+	for _, s := range stubs {
+		client := &stubQueryClient{name: s.name, calls: calls}
+		s.query = func() query.Client {
+			return client
+		}
+	}
+
+	return stubs
+}
+
+func TestNearestReadsQuery(t *testing.T) {
+	ctx := context.Background()
+	var calls []string
+	stubs := newStubQueryClusters(&calls, "primary", "standby")
+	stubs[0].latency = 10 * time.Millisecond
+	stubs[1].latency = time.Millisecond
+
+	d := newStubDriver(stubs, WithNearestReads())
+
+	_, _ = d.Query().Query(ctx, "SELECT 1")
+	_, _ = d.Query().Query(ctx, "SELECT 1", query.WithTxControl(query.SnapshotReadOnlyTxControl()))
+	_, _ = d.Query().QueryRow(ctx, "SELECT 1", query.WithTxControl(query.OnlineReadOnlyTxControl()))
+	_, _ = d.Query().QueryRow(ctx, "SELECT 1", query.WithTxControl(query.SerializableReadWriteTxControl()))
+	require.Equal(t, []string{
+		"Query:primary",
+		"Query:standby",
+		"QueryRow:standby",
+		"QueryRow:primary",
+	}, calls)
+}
+
+func TestScriptBoundToCluster(t *testing.T) {
+	ctx := context.Background()
+	var calls []string
+	stubs := newStubQueryClusters(&calls, "primary", "standby")
+	d := newStubDriver(stubs, WithFailoverThreshold(1))
+
+	op, err := d.Query().ExecuteScript(ctx, "SELECT 1", time.Hour)
+	require.NoError(t, err)
+
+	stubs[0].err = errUnavailable
+	d.checkHealth(ctx)
+	require.Equal(t, "standby", d.Active())
+
+	_, err = d.Query().FetchScriptResults(ctx, op.ID)
+	require.NoError(t, err)
+	s := d.Query().Script(op.ID)
+	require.Equal(t, op.ID, s.ID())
+	_, err = d.Query().FetchScriptResults(ctx, "unknown")
+	require.NoError(t, err)
+
+	require.NoError(t, s.Forget(ctx))
+	_ = d.Query().Script(op.ID)
+
+	require.Equal(t, []string{
+		"ExecuteScript:primary",
+		"FetchScriptResults:primary",
+		"Script:primary",
+		"FetchScriptResults:standby",
+		"Script:standby",
+	}, calls)
+}
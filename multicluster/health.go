@@ -0,0 +1,119 @@
+package multicluster
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
+)
+
+func (d *Driver) startHealthChecks(ctx context.Context) {
+	d.wg.Add(1)
+	go func() {
+		defer d.wg.Done()
+		d.healthCheckLoop(ctx)
+	}()
+}
+
+func (d *Driver) healthCheckLoop(ctx context.Context) {
+	ctx = xcontext.ValueOnly(ctx)
+
+	ticker := time.NewTicker(d.config.healthCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		d.checkHealth(ctx)
+
+		select {
+		case <-d.done:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// checkHealth checks all clusters concurrently and switches the active cluster if needed
+func (d *Driver) checkHealth(ctx context.Context) {
+	type result struct {
+		latency time.Duration
+		err     error
+	}
+	results := make([]result, len(d.clusters))
+
+	var wg sync.WaitGroup
+	for i, c := range d.clusters {
+		wg.Add(1)
+		go func(i int, c *cluster) {
+			defer wg.Done()
+
+			checkCtx, cancel := xcontext.WithTimeout(ctx, d.config.healthCheckTimeout)
+			defer cancel()
+
+			start := time.Now()
+			err := c.check(checkCtx)
+			results[i] = result{latency: time.Since(start), err: err}
+		}(i, c)
+	}
+	wg.Wait()
+
+	d.mu.Lock()
+	for i, c := range d.clusters {
+		c.update(results[i].latency, results[i].err, d.config.failoverThreshold)
+	}
+	from, to, switched := d.rebalance()
+	d.mu.Unlock()
+
+	if switched && d.config.onSwitch != nil {
+		d.config.onSwitch(from, to)
+	}
+}
+
+// update applies result of health check to cluster state. d.mu must be locked
+func (c *cluster) update(latency time.Duration, err error, failoverThreshold int) {
+	if err != nil {
+		c.failures++
+		c.successes = 0
+		if c.failures >= failoverThreshold {
+			c.healthy = false
+		}
+
+		return
+	}
+
+	c.failures = 0
+	c.successes++
+	c.healthy = true
+	c.latency = latency
+}
+
+// rebalance applies failover and failback policies to the active cluster. d.mu must be locked
+func (d *Driver) rebalance() (from, to string, switched bool) {
+	next := d.active
+	if !d.clusters[d.active].healthy {
+		for i, c := range d.clusters {
+			if c.healthy {
+				next = i
+
+				break
+			}
+		}
+	} else if d.config.failbackThreshold > 0 {
+		for i := 0; i < d.active; i++ {
+			if c := d.clusters[i]; c.healthy && c.successes >= d.config.failbackThreshold {
+				next = i
+
+				break
+			}
+		}
+	}
+
+	if next == d.active {
+		return "", "", false
+	}
+
+	from, to = d.clusters[d.active].name, d.clusters[next].name
+	d.active = next
+
+	return from, to, true
+}
@@ -0,0 +1,109 @@
+package multicluster
+
+import "time"
+
+const (
+	DefaultHealthCheckInterval = 5 * time.Second
+	DefaultHealthCheckTimeout  = 3 * time.Second
+	DefaultFailoverThreshold   = 3
+	DefaultFailbackThreshold   = 5
+)
+
+type (
+	// Option is an option of multi-cluster Driver
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	Option func(c *config)
+	config struct {
+		healthCheckInterval time.Duration
+		healthCheckTimeout  time.Duration
+		failoverThreshold   int
+		failbackThreshold   int
+		nearestReads        bool
+		onSwitch            func(from, to string)
+	}
+)
+
+func defaultConfig() config {
+	return config{
+		healthCheckInterval: DefaultHealthCheckInterval,
+		healthCheckTimeout:  DefaultHealthCheckTimeout,
+		failoverThreshold:   DefaultFailoverThreshold,
+		failbackThreshold:   DefaultFailbackThreshold,
+	}
+}
+
+// WithHealthCheckInterval overrides interval between health checks of clusters
+// If interval is less than or equal to zero then the DefaultHealthCheckInterval is used.
+func WithHealthCheckInterval(interval time.Duration) Option {
+	return func(c *config) {
+		if interval > 0 {
+			c.healthCheckInterval = interval
+		} else {
+			c.healthCheckInterval = DefaultHealthCheckInterval
+		}
+	}
+}
+
+// WithHealthCheckTimeout overrides timeout of single health check of cluster
+// If timeout is less than or equal to zero then the DefaultHealthCheckTimeout is used.
+func WithHealthCheckTimeout(timeout time.Duration) Option {
+	return func(c *config) {
+		if timeout > 0 {
+			c.healthCheckTimeout = timeout
+		} else {
+			c.healthCheckTimeout = DefaultHealthCheckTimeout
+		}
+	}
+}
+
+// WithFailoverThreshold defines number of consecutive failed health checks after which
+// cluster marks as unhealthy and requests switch to the next healthy cluster in priority order
+// If failedChecks is less than or equal to zero then the DefaultFailoverThreshold is used.
+func WithFailoverThreshold(failedChecks int) Option {
+	return func(c *config) {
+		if failedChecks > 0 {
+			c.failoverThreshold = failedChecks
+		} else {
+			c.failoverThreshold = DefaultFailoverThreshold
+		}
+	}
+}
+
+// WithFailbackThreshold defines number of consecutive successful health checks of cluster
+// with higher priority after which requests switch back to this cluster
+// If successfulChecks is less than or equal to zero then failback is disabled (see WithoutFailback).
+func WithFailbackThreshold(successfulChecks int) Option {
+	return func(c *config) {
+		if successfulChecks > 0 {
+			c.failbackThreshold = successfulChecks
+		} else {
+			c.failbackThreshold = 0
+		}
+	}
+}
+
+// WithoutFailback disables switch back to cluster with higher priority. Requests stay on
+// the current cluster until it becomes unhealthy
+func WithoutFailback() Option {
+	return func(c *config) {
+		c.failbackThreshold = 0
+	}
+}
+
+// WithNearestReads routes read-only transactions to the healthy cluster with the lowest
+// latency of health checks instead of the active cluster. Read-only transactions are
+// DoTx calls with read-only tx settings and Query, QueryResultSet and QueryRow calls of
+// query client with read-only tx control (for example, query.SnapshotReadOnlyTxControl())
+func WithNearestReads() Option {
+	return func(c *config) {
+		c.nearestReads = true
+	}
+}
+
+// WithOnSwitch sets callback which called on switch of active cluster
+func WithOnSwitch(onSwitch func(from, to string)) Option {
+	return func(c *config) {
+		c.onSwitch = onSwitch
+	}
+}
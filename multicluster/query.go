@@ -0,0 +1,132 @@
+package multicluster
+
+import (
+	"context"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+var _ query.Client = (*queryClient)(nil)
+
+type queryClient struct {
+	d *Driver
+}
+
+// isReadOnlyQueryTx checks transaction settings of DoTx options
+func isReadOnlyQueryTx(opts ...options.DoTxOption) bool {
+	a := allocator.New()
+	defer a.Free()
+
+	settings := options.ParseDoTxOpts(&trace.Query{}, opts...).TxSettings()
+
+	return settings.ToYDB(a).GetSerializableReadWrite() == nil
+}
+
+// isReadOnlyQuery checks transaction control of Execute options. Queries without explicit
+// transaction control are not read-only
+func isReadOnlyQuery(opts ...options.Execute) bool {
+	a := allocator.New()
+	defer a.Free()
+
+	beginTx := options.ExecuteSettings(opts...).TxControl().ToYDB(a).GetBeginTx()
+
+	return beginTx != nil && beginTx.GetSerializableReadWrite() == nil
+}
+
+func (c *queryClient) Do(ctx context.Context, op query.Operation, opts ...options.DoOption) error {
+	return c.d.activeCluster().query().Do(ctx, op, opts...)
+}
+
+// DoTx executes transaction on the active cluster or on the nearest cluster for read-only
+// transactions if nearest reads enabled
+func (c *queryClient) DoTx(ctx context.Context, op query.TxOperation, opts ...options.DoTxOption) error {
+	return c.d.pick(isReadOnlyQueryTx(opts...)).query().DoTx(ctx, op, opts...)
+}
+
+func (c *queryClient) Exec(ctx context.Context, q string, opts ...options.Execute) error {
+	return c.d.activeCluster().query().Exec(ctx, q, opts...)
+}
+
+// Query executes query on the active cluster or on the nearest cluster for queries with
+// read-only transaction control if nearest reads enabled
+func (c *queryClient) Query(ctx context.Context, q string, opts ...options.Execute) (query.Result, error) {
+	return c.d.pick(isReadOnlyQuery(opts...)).query().Query(ctx, q, opts...)
+}
+
+// QueryResultSet executes query on the cluster chosen same as in Query
+func (c *queryClient) QueryResultSet(
+	ctx context.Context, q string, opts ...options.Execute,
+) (query.ResultSet, error) {
+	return c.d.pick(isReadOnlyQuery(opts...)).query().QueryResultSet(ctx, q, opts...)
+}
+
+// QueryRow executes query on the cluster chosen same as in Query
+func (c *queryClient) QueryRow(ctx context.Context, q string, opts ...options.Execute) (query.Row, error) {
+	return c.d.pick(isReadOnlyQuery(opts...)).query().QueryRow(ctx, q, opts...)
+}
+
+// ExecuteScript starts script on the active cluster and binds operation ID to this cluster
+func (c *queryClient) ExecuteScript(
+	ctx context.Context, q string, ttl time.Duration, opts ...options.Execute,
+) (*options.ExecuteScriptOperation, error) {
+	cc := c.d.activeCluster()
+
+	op, err := cc.query().ExecuteScript(ctx, q, ttl, opts...)
+	if err != nil {
+		return nil, err
+	}
+	c.d.scripts.Set(op.ID, cc)
+
+	return op, nil
+}
+
+// FetchScriptResults fetches results of script from the cluster which script started on.
+// Scripts which unknown for Driver (for example, started before process restart) are
+// fetched from the active cluster
+func (c *queryClient) FetchScriptResults(
+	ctx context.Context, opID string, opts ...options.FetchScriptOption,
+) (*options.FetchScriptResult, error) {
+	return c.d.scriptCluster(opID).query().FetchScriptResults(ctx, opID, opts...)
+}
+
+// StartScript starts script on the active cluster and binds script handle to this cluster
+func (c *queryClient) StartScript(
+	ctx context.Context, q string, ttl time.Duration, opts ...options.Execute,
+) (query.Script, error) {
+	cc := c.d.activeCluster()
+
+	s, err := cc.query().StartScript(ctx, q, ttl, opts...)
+	if err != nil {
+		return nil, err
+	}
+	c.d.scripts.Set(s.ID(), cc)
+
+	return &script{Script: s, d: c.d}, nil
+}
+
+// Script returns handle of script execution on the cluster which script started on.
+// Scripts which unknown for Driver (for example, started before process restart) are
+// bound to the active cluster
+func (c *queryClient) Script(opID string) query.Script {
+	return &script{Script: c.d.scriptCluster(opID).query().Script(opID), d: c.d}
+}
+
+// script forgets binding of script to cluster on Forget
+type script struct {
+	query.Script
+
+	d *Driver
+}
+
+func (s *script) Forget(ctx context.Context) error {
+	if err := s.Script.Forget(ctx); err != nil {
+		return err
+	}
+	s.d.scripts.Delete(s.ID())
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package multicluster
+
+import (
+	"context"
+	"database/sql/driver"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+var _ ydb.SQLConnector = (*connector)(nil)
+
+type connector struct {
+	d          *Driver
+	connectors map[*cluster]ydb.SQLConnector
+}
+
+// Connector makes database/sql connector which opens new connections on the active cluster
+//
+// Opened connections are not moved on switch of active cluster. Limit lifetime of connections
+// with sql.DB.SetConnMaxLifetime for rebalancing connections after switch
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func (d *Driver) Connector(opts ...ydb.ConnectorOption) (ydb.SQLConnector, error) {
+	c := &connector{
+		d:          d,
+		connectors: make(map[*cluster]ydb.SQLConnector, len(d.clusters)),
+	}
+	for _, cluster := range d.clusters {
+		cc, err := cluster.connector(opts...)
+		if err != nil {
+			_ = c.Close()
+
+			return nil, xerrors.WithStackTrace(err)
+		}
+		c.connectors[cluster] = cc
+	}
+
+	return c, nil
+}
+
+func (c *connector) Connect(ctx context.Context) (driver.Conn, error) {
+	return c.connectors[c.d.activeCluster()].Connect(ctx)
+}
+
+func (c *connector) Driver() driver.Driver {
+	return c.connectors[c.d.activeCluster()].Driver()
+}
+
+func (c *connector) Close() error {
+	errs := make([]error, 0, len(c.connectors))
+	for _, cc := range c.connectors {
+		if err := cc.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return xerrors.WithStackTrace(xerrors.Join(errs...))
+	}
+
+	return nil
+}
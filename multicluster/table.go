@@ -0,0 +1,39 @@
+package multicluster
+
+import (
+	"context"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+)
+
+var _ table.Client = (*tableClient)(nil)
+
+type tableClient struct {
+	d *Driver
+}
+
+// isReadOnlyTableTx checks transaction settings of DoTx options
+func isReadOnlyTableTx(opts ...table.Option) bool {
+	var o table.Options
+	for _, opt := range opts {
+		if opt != nil {
+			opt.ApplyTableOption(&o)
+		}
+	}
+
+	return o.TxSettings != nil && o.TxSettings.Settings().GetSerializableReadWrite() == nil
+}
+
+func (c *tableClient) CreateSession(ctx context.Context, opts ...table.Option) (table.ClosableSession, error) {
+	return c.d.activeCluster().table().CreateSession(ctx, opts...)
+}
+
+func (c *tableClient) Do(ctx context.Context, op table.Operation, opts ...table.Option) error {
+	return c.d.activeCluster().table().Do(ctx, op, opts...)
+}
+
+// DoTx executes transaction on the active cluster or on the nearest cluster for read-only
+// transactions if nearest reads enabled
+func (c *tableClient) DoTx(ctx context.Context, op table.TxOperation, opts ...table.Option) error {
+	return c.d.pick(isReadOnlyTableTx(opts...)).table().DoTx(ctx, op, opts...)
+}
@@ -0,0 +1,69 @@
+package multicluster
+
+import (
+	"context"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topiclistener"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicoptions"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicreader"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topictypes"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicwriter"
+)
+
+var _ topic.Client = (*topicClient)(nil)
+
+type topicClient struct {
+	d *Driver
+}
+
+func (c *topicClient) Alter(ctx context.Context, path string, opts ...topicoptions.AlterOption) error {
+	return c.d.activeCluster().topic().Alter(ctx, path, opts...)
+}
+
+func (c *topicClient) Create(ctx context.Context, path string, opts ...topicoptions.CreateOption) error {
+	return c.d.activeCluster().topic().Create(ctx, path, opts...)
+}
+
+func (c *topicClient) Describe(
+	ctx context.Context, path string, opts ...topicoptions.DescribeOption,
+) (topictypes.TopicDescription, error) {
+	return c.d.activeCluster().topic().Describe(ctx, path, opts...)
+}
+
+func (c *topicClient) DescribeConsumer(
+	ctx context.Context, path, consumer string, opts ...topicoptions.DescribeConsumerOption,
+) (topictypes.TopicConsumerDescription, error) {
+	return c.d.activeCluster().topic().DescribeConsumer(ctx, path, consumer, opts...)
+}
+
+func (c *topicClient) Drop(ctx context.Context, path string, opts ...topicoptions.DropOption) error {
+	return c.d.activeCluster().topic().Drop(ctx, path, opts...)
+}
+
+// StartListener starts listener on the active cluster. Listener is not moved on switch
+// of active cluster
+func (c *topicClient) StartListener(
+	consumer string,
+	handler topiclistener.EventHandler,
+	readSelectors topicoptions.ReadSelectors,
+	opts ...topicoptions.ListenerOption,
+) (*topiclistener.TopicListener, error) {
+	return c.d.activeCluster().topic().StartListener(consumer, handler, readSelectors, opts...)
+}
+
+// StartReader starts reader on the active cluster. Reader is not moved on switch
+// of active cluster
+func (c *topicClient) StartReader(
+	consumer string,
+	readSelectors topicoptions.ReadSelectors,
+	opts ...topicoptions.ReaderOption,
+) (*topicreader.Reader, error) {
+	return c.d.activeCluster().topic().StartReader(consumer, readSelectors, opts...)
+}
+
+// StartWriter starts writer on the active cluster. Writer is not moved on switch
+// of active cluster
+func (c *topicClient) StartWriter(topicPath string, opts ...topicoptions.WriterOption) (*topicwriter.Writer, error) {
+	return c.d.activeCluster().topic().StartWriter(topicPath, opts...)
+}
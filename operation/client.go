@@ -12,6 +12,7 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/conn"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/operation/metadata"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/operation/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/operation/service"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
 )
@@ -60,33 +61,21 @@ func (c *Client) Get(ctx context.Context, opID string) (*operation, error) {
 func get(
 	ctx context.Context, client Ydb_Operation_V1.OperationServiceClient, opID string,
 ) (*operation, error) {
-	status, err := retry.RetryWithResult(ctx, func(ctx context.Context) (*operation, error) {
-		response, err := client.GetOperation(
-			conn.WithoutWrapping(ctx),
-			&Ydb_Operations.GetOperationRequest{
-				Id: opID,
-			},
-		)
-		if err != nil {
-			return nil, xerrors.WithStackTrace(err)
-		}
-
-		var md Ydb_Query.ExecuteScriptMetadata
-		err = response.GetOperation().GetMetadata().UnmarshalTo(&md)
-		if err != nil {
-			return nil, xerrors.WithStackTrace(err)
-		}
+	op, err := service.Get(ctx, client, opID)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
 
-		return &operation{
-			Ready:  response.GetOperation().GetReady(),
-			Status: response.GetOperation().GetStatus().String(),
-		}, nil
-	}, retry.WithIdempotent(true))
+	var md Ydb_Query.ExecuteScriptMetadata
+	err = op.GetMetadata().UnmarshalTo(&md)
 	if err != nil {
 		return nil, xerrors.WithStackTrace(err)
 	}
 
-	return status, nil
+	return &operation{
+		Ready:  op.GetReady(),
+		Status: op.GetStatus().String(),
+	}, nil
 }
 
 func list[PT metadata.Constraint[T], T metadata.TypesConstraint](
@@ -135,65 +124,11 @@ func list[PT metadata.Constraint[T], T metadata.TypesConstraint](
 	return operations, nil
 }
 
-func cancel(
-	ctx context.Context, client Ydb_Operation_V1.OperationServiceClient, opID string,
-) error {
-	err := retry.Retry(ctx, func(ctx context.Context) error {
-		response, err := client.CancelOperation(conn.WithoutWrapping(ctx), &Ydb_Operations.CancelOperationRequest{
-			Id: opID,
-		})
-		if err != nil {
-			return xerrors.WithStackTrace(err)
-		}
-
-		if response.GetStatus() != Ydb.StatusIds_SUCCESS {
-			return xerrors.WithStackTrace(xerrors.Operation(
-				xerrors.WithStatusCode(response.GetStatus()),
-				xerrors.WithIssues(response.GetIssues()),
-			))
-		}
-
-		return nil
-	}, retry.WithIdempotent(true))
-	if err != nil {
-		return xerrors.WithStackTrace(err)
-	}
-
-	return nil
-}
-
 // Cancel starts cancellation of a long-running operation.
 //
 // Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
 func (c *Client) Cancel(ctx context.Context, opID string) error {
-	err := cancel(ctx, c.operationServiceClient, opID)
-	if err != nil {
-		return xerrors.WithStackTrace(err)
-	}
-
-	return nil
-}
-
-func forget(
-	ctx context.Context, client Ydb_Operation_V1.OperationServiceClient, opID string,
-) error {
-	err := retry.Retry(ctx, func(ctx context.Context) error {
-		response, err := client.ForgetOperation(conn.WithoutWrapping(ctx), &Ydb_Operations.ForgetOperationRequest{
-			Id: opID,
-		})
-		if err != nil {
-			return xerrors.WithStackTrace(err)
-		}
-
-		if response.GetStatus() != Ydb.StatusIds_SUCCESS {
-			return xerrors.WithStackTrace(xerrors.Operation(
-				xerrors.WithStatusCode(response.GetStatus()),
-				xerrors.WithIssues(response.GetIssues()),
-			))
-		}
-
-		return nil
-	}, retry.WithIdempotent(true))
+	err := service.Cancel(ctx, c.operationServiceClient, opID)
 	if err != nil {
 		return xerrors.WithStackTrace(err)
 	}
@@ -206,7 +141,7 @@ func forget(
 //
 // Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
 func (c *Client) Forget(ctx context.Context, opID string) error {
-	err := forget(ctx, c.operationServiceClient, opID)
+	err := service.Forget(ctx, c.operationServiceClient, opID)
 	if err != nil {
 		return xerrors.WithStackTrace(err)
 	}
@@ -11,6 +11,8 @@ import (
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/config"
 	"github.com/ydb-platform/ydb-go-sdk/v3/credentials"
+	"github.com/ydb-platform/ydb-go-sdk/v3/faults"
+	"github.com/ydb-platform/ydb-go-sdk/v3/interceptors"
 	balancerConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/balancer/config"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/certificates"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/conn"
@@ -25,7 +27,11 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql"
 	"github.com/ydb-platform/ydb-go-sdk/v3/log"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry/breaker"
 	"github.com/ydb-platform/ydb-go-sdk/v3/retry/budget"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry/limiter"
 	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicoptions"
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
@@ -44,6 +50,17 @@ func WithStaticCredentials(user, password string) Option {
 	}
 }
 
+// WithStaticCredentialsEndpoint sets endpoint for request token by user and password of static credentials
+//
+// Initial endpoint of driver used by default
+func WithStaticCredentialsEndpoint(endpoint string) Option {
+	return func(ctx context.Context, c *Driver) error {
+		c.staticCredentialsEndpoint = endpoint
+
+		return nil
+	}
+}
+
 // WithNodeAddressMutator applies mutator for node addresses from discovery.ListEndpoints response
 //
 // Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
@@ -348,6 +365,26 @@ func MergeOptions(opts ...Option) Option {
 	}
 }
 
+// WithFaultInjection injects faults from rules into calls of table, query, scheme, scripting,
+// coordination, ratelimiter and topic clients. First matched rule injects fault into call.
+// Rules can be enabled or disabled at runtime
+//
+// WithFaultInjection intended for resilience testing of retries, sessions pool and topic
+// reconnects. Do not use it in production
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithFaultInjection(rules ...*faults.Rule) Option {
+	return func(ctx context.Context, c *Driver) error {
+		for _, rule := range rules {
+			if rule != nil {
+				c.faultRules = append(c.faultRules, rule)
+			}
+		}
+
+		return nil
+	}
+}
+
 // WithDiscoveryInterval sets interval between cluster discovery calls.
 func WithDiscoveryInterval(discoveryInterval time.Duration) Option {
 	return func(ctx context.Context, c *Driver) error {
@@ -368,6 +405,47 @@ func WithRetryBudget(b budget.Budget) Option {
 	}
 }
 
+// WithRetryPolicy applies retry policy to retry loops of all clients (table, query, scheme, scripting,
+// coordination, ratelimiter, topic control plane and database/sql with retry.Do and retry.DoTx helpers)
+// and to invalidation of table and query sessions on errors.
+// Reconnects of topic reader and writer streams are not affected (see topicoptions retry settings).
+// Policy can be loaded from JSON with retry.ParsePolicy or retry.LoadPolicy
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithRetryPolicy(p *retry.Policy) Option {
+	return func(ctx context.Context, c *Driver) error {
+		c.options = append(c.options, config.WithRetryPolicy(p))
+
+		return nil
+	}
+}
+
+// WithCircuitBreaker enables client-side circuit breakers per endpoint and (optionally) per label
+// of retry operation (see retry.WithLabel). Calls on endpoint with open circuit breaker fails fast
+// with breaker.OpenError
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithCircuitBreaker(opts ...breaker.Option) Option {
+	return func(ctx context.Context, c *Driver) error {
+		c.options = append(c.options, config.WithCircuitBreaker(opts...))
+
+		return nil
+	}
+}
+
+// WithConcurrencyLimiter enables adaptive (AIMD) concurrency limiters of calls per service (query,
+// table and topic control plane). Calls over limit waits for free slot in queue until context done
+// or fails with limiter.LimitExceededError
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithConcurrencyLimiter(opts ...limiter.Option) Option {
+	return func(ctx context.Context, c *Driver) error {
+		c.options = append(c.options, config.WithConcurrencyLimiter(opts...))
+
+		return nil
+	}
+}
+
 // WithTraceDriver appends trace.Driver into driver traces
 func WithTraceDriver(t trace.Driver, opts ...trace.DriverComposeOption) Option { //nolint:gocritic
 	return func(ctx context.Context, c *Driver) error {
@@ -431,6 +509,34 @@ func WithCertificatesFromFile(caFile string, opts ...certificates.FromFileOption
 	}
 }
 
+// WithReloadableCertificatesFromFile appends CA certificates from file to TLS config root certificates.
+// File checks for changes on new connections and certificates reloads after changes of file
+// (for example, rotation of certificates by cert-manager)
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithReloadableCertificatesFromFile(caFile string, opts ...certificates.ReloaderOption) Option {
+	return func(ctx context.Context, c *Driver) error {
+		c.options = append(c.options, config.WithReloadableCertificatesFromFile(caFile, opts...))
+
+		return nil
+	}
+}
+
+// WithReloadableClientCertificateFromFiles sets client certificate and key from pem-encoded files.
+// Files checks for changes on new connections and certificate reloads after changes of files
+// (for example, rotation of certificates by cert-manager)
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithReloadableClientCertificateFromFiles(
+	certFile, keyFile string, opts ...certificates.ReloaderOption,
+) Option {
+	return func(ctx context.Context, c *Driver) error {
+		c.options = append(c.options, config.WithReloadableClientCertificateFromFiles(certFile, keyFile, opts...))
+
+		return nil
+	}
+}
+
 // WithTLSConfig replaces older TLS config
 //
 // Warning: all early TLS config changes (such as WithCertificate, WithCertificatesFromFile, WithCertificatesFromPem,
@@ -478,6 +584,18 @@ func WithQueryConfigOption(option queryConfig.Option) Option {
 	}
 }
 
+// WithQueryResultCache defines cache of results for read-only queries of query.Client.
+// Results caches only for queries with query.WithResultCache option
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithQueryResultCache(c *query.ResultCache) Option {
+	return func(ctx context.Context, d *Driver) error {
+		d.queryOptions = append(d.queryOptions, queryConfig.WithResultCache(c))
+
+		return nil
+	}
+}
+
 // WithSessionPoolSizeLimit set max size of internal sessions pool in table.Client
 func WithSessionPoolSizeLimit(sizeLimit int) Option {
 	return func(ctx context.Context, c *Driver) error {
@@ -581,6 +699,28 @@ func WithTraceTable(t trace.Table, opts ...trace.TableComposeOption) Option { //
 	}
 }
 
+// WithQueryInterceptors appends interceptors of queries of query client
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithQueryInterceptors(list ...interceptors.Interceptor) Option {
+	return func(ctx context.Context, c *Driver) error {
+		c.queryOptions = append(c.queryOptions, queryConfig.WithInterceptors(list...))
+
+		return nil
+	}
+}
+
+// WithTableInterceptors appends interceptors of data and scan queries of table client
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithTableInterceptors(list ...interceptors.Interceptor) Option {
+	return func(ctx context.Context, c *Driver) error {
+		c.tableOptions = append(c.tableOptions, tableConfig.WithInterceptors(list...))
+
+		return nil
+	}
+}
+
 // WithTraceQuery appends trace.Query into query traces
 func WithTraceQuery(t trace.Query, opts ...trace.QueryComposeOption) Option { //nolint:gocritic
 	return func(ctx context.Context, c *Driver) error {
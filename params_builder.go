@@ -8,3 +8,29 @@ import "github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
 func ParamsBuilder() params.Builder {
 	return params.Builder{}
 }
+
+// ParamsFromStruct makes query arguments from exported fields of struct (or pointer to struct) v.
+//
+// Parameter names are taken from `sql` tag (same as for ScanStruct) or from field names with `$` prefix.
+// Fields with tag `sql:"-"` are skipped. Types of parameters are inferred from types of fields:
+// pointers are mapped to Optional types, time.Time - to Timestamp, uuid.UUID - to UUID,
+// slices - to List types, maps - to Dict types, nested structs - to Struct types.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func ParamsFromStruct(v interface{}) (*params.Parameters, error) {
+	return params.FromStruct(v)
+}
+
+// ParamsListOf makes query argument with List<Struct<...>> value from slice of structs.
+// Struct members are inferred same as in ParamsFromStruct.
+//
+// Example:
+//
+//	rows, err := ydb.ParamsListOf("$rows", users)
+//	...
+//	err = db.Query().Exec(ctx, "UPSERT INTO users SELECT * FROM AS_TABLE($rows)", query.WithParameters(rows))
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func ParamsListOf[T any](name string, rows []T) (*params.Parameters, error) {
+	return params.ListOf(name, rows)
+}
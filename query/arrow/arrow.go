@@ -0,0 +1,473 @@
+package arrow
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync/atomic"
+
+	apacheArrow "github.com/apache/arrow/go/v15/arrow"
+	"github.com/apache/arrow/go/v15/arrow/array"
+	"github.com/apache/arrow/go/v15/arrow/decimal128"
+	"github.com/apache/arrow/go/v15/arrow/ipc"
+	"github.com/apache/arrow/go/v15/arrow/memory"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/decimal"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/result"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+)
+
+const DefaultBatchSize = 1024
+
+var (
+	_ array.RecordReader = (*RecordReader)(nil)
+
+	ErrUnsupportedType = errors.New("unsupported type")
+
+	errDecimalNotFinite = errors.New("decimal value is not finite")
+)
+
+type (
+	// RecordReader reads Arrow record batches of result set
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	RecordReader struct {
+		ctx       context.Context //nolint:containedctx
+		rs        query.ResultSet
+		schema    *apacheArrow.Schema
+		appenders []appendFunc
+		mem       memory.Allocator
+		batchSize int
+
+		// native is a result set in Arrow format, batches of native result set decodes without conversion
+		native  result.ArrowSet
+		batches *ipc.Reader
+
+		refCount int64
+		record   apacheArrow.Record
+		done     bool
+		err      error
+	}
+	// Option is an option for NewRecordReader
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	Option     func(r *RecordReader)
+	appendFunc func(b array.Builder, v value.Value) error
+)
+
+// WithBatchSize defines max count of rows in record batch (DefaultBatchSize by default)
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithBatchSize(batchSize int) Option {
+	return func(r *RecordReader) {
+		if batchSize > 0 {
+			r.batchSize = batchSize
+		}
+	}
+}
+
+// WithAllocator defines memory allocator for record batches (memory.DefaultAllocator by default)
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithAllocator(mem memory.Allocator) Option {
+	return func(r *RecordReader) {
+		if mem != nil {
+			r.mem = mem
+		}
+	}
+}
+
+// Schema returns Arrow schema of result set. Schema of result set in Arrow format (see query.WithArrowFormat)
+// decodes from result set, otherwise schema makes from columns of result set.
+//
+// Optional types are mapped to nullable fields, Date - to date32, Datetime - to timestamp[s],
+// Timestamp - to timestamp[us], Interval - to duration[us], UUID - to fixed_size_binary[16],
+// Decimal - to decimal128, List - to list, text types - to utf8, binary types - to binary
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func Schema(rs query.ResultSet) (*apacheArrow.Schema, error) {
+	if native, ok := nativeResultSet(rs); ok {
+		schema, err := decodeSchema(native.ArrowSchema(), memory.DefaultAllocator)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		return schema, nil
+	}
+
+	schema, _, err := schemaOf(rs)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return schema, nil
+}
+
+func nativeResultSet(rs query.ResultSet) (result.ArrowSet, bool) {
+	native, ok := rs.(result.ArrowSet)
+	if !ok || native.ArrowSchema() == nil {
+		return nil, false
+	}
+
+	return native, true
+}
+
+func decodeSchema(schema []byte, mem memory.Allocator) (*apacheArrow.Schema, error) {
+	r, err := ipc.NewReader(bytes.NewReader(schema), ipc.WithAllocator(mem))
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	defer r.Release()
+
+	return r.Schema(), nil
+}
+
+func schemaOf(rs query.ResultSet) (*apacheArrow.Schema, []appendFunc, error) {
+	var (
+		names     = rs.Columns()
+		fields    = make([]apacheArrow.Field, len(names))
+		appenders = make([]appendFunc, len(names))
+	)
+	for i, t := range rs.ColumnTypes() {
+		dt, nullable, appender, err := fieldType(t)
+		if err != nil {
+			return nil, nil, xerrors.WithStackTrace(fmt.Errorf("column '%s': %w", names[i], err))
+		}
+		fields[i] = apacheArrow.Field{
+			Name:     names[i],
+			Type:     dt,
+			Nullable: nullable,
+		}
+		appenders[i] = appender
+	}
+
+	return apacheArrow.NewSchema(fields, nil), appenders, nil
+}
+
+// NewRecordReader makes reader of Arrow record batches of result set.
+//
+// Record batches of result set in Arrow format (query executed with query.WithArrowFormat option) decodes
+// as is. Otherwise (for example, if server returned result set in value format) record batches are built
+// on client side from rows of result set
+//
+// Result sets in Arrow format are available from streaming Query of query.Session and query.Transaction only
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func NewRecordReader(ctx context.Context, rs query.ResultSet, opts ...Option) (_ *RecordReader, err error) {
+	r := &RecordReader{
+		ctx:       ctx,
+		rs:        rs,
+		mem:       memory.DefaultAllocator,
+		batchSize: DefaultBatchSize,
+		refCount:  1,
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(r)
+		}
+	}
+
+	if native, ok := nativeResultSet(rs); ok {
+		r.native = native
+		r.schema, err = decodeSchema(native.ArrowSchema(), r.mem)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		return r, nil
+	}
+
+	r.schema, r.appenders, err = schemaOf(rs)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return r, nil
+}
+
+func (r *RecordReader) Retain() {
+	atomic.AddInt64(&r.refCount, 1)
+}
+
+func (r *RecordReader) Release() {
+	if atomic.AddInt64(&r.refCount, -1) != 0 {
+		return
+	}
+	if r.record != nil {
+		r.record.Release()
+		r.record = nil
+	}
+	if r.batches != nil {
+		r.batches.Release()
+		r.batches = nil
+	}
+}
+
+func (r *RecordReader) Schema() *apacheArrow.Schema {
+	return r.schema
+}
+
+// Record returns current record batch. Record batch is valid until next call of Next
+func (r *RecordReader) Record() apacheArrow.Record {
+	return r.record
+}
+
+func (r *RecordReader) Err() error {
+	return r.err
+}
+
+// Next reads next batch of rows. Next returns false if result set has no more rows or on error
+func (r *RecordReader) Next() bool {
+	if r.record != nil {
+		r.record.Release()
+		r.record = nil
+	}
+	if r.done || r.err != nil {
+		return false
+	}
+	if r.native != nil {
+		return r.nextNative()
+	}
+
+	b := array.NewRecordBuilder(r.mem, r.schema)
+	defer b.Release()
+
+	var (
+		values = make([]value.Value, len(r.appenders))
+		dst    = make([]interface{}, len(values))
+		rows   int
+	)
+	for i := range values {
+		dst[i] = &values[i]
+	}
+	for ; rows < r.batchSize; rows++ {
+		row, err := r.rs.NextRow(r.ctx)
+		if err != nil {
+			if xerrors.Is(err, io.EOF) {
+				r.done = true
+
+				break
+			}
+			r.err = xerrors.WithStackTrace(err)
+
+			return false
+		}
+		if err = row.Scan(dst...); err != nil {
+			r.err = xerrors.WithStackTrace(err)
+
+			return false
+		}
+		for i, appendValue := range r.appenders {
+			if err = appendValue(b.Field(i), values[i]); err != nil {
+				r.err = xerrors.WithStackTrace(fmt.Errorf("column '%s': %w", r.schema.Field(i).Name, err))
+
+				return false
+			}
+		}
+	}
+	if rows == 0 {
+		return false
+	}
+
+	r.record = b.NewRecord()
+
+	return true
+}
+
+// nextNative decodes next record batch of result set in Arrow format
+func (r *RecordReader) nextNative() bool {
+	for {
+		if r.batches != nil {
+			if r.batches.Next() {
+				r.record = r.batches.Record()
+				r.record.Retain()
+
+				return true
+			}
+			if err := r.batches.Err(); err != nil {
+				r.err = xerrors.WithStackTrace(err)
+
+				return false
+			}
+			r.batches.Release()
+			r.batches = nil
+		}
+
+		data, err := r.native.NextArrowBatch(r.ctx)
+		if err != nil {
+			if xerrors.Is(err, io.EOF) {
+				r.done = true
+			} else {
+				r.err = xerrors.WithStackTrace(err)
+			}
+
+			return false
+		}
+
+		// record batch message of result set decodes as IPC stream with schema message of result set
+		r.batches, err = ipc.NewReader(
+			io.MultiReader(bytes.NewReader(r.native.ArrowSchema()), bytes.NewReader(data)),
+			ipc.WithAllocator(r.mem),
+		)
+		if err != nil {
+			r.err = xerrors.WithStackTrace(err)
+
+			return false
+		}
+	}
+}
+
+//nolint:funlen,gocyclo
+func fieldType(t types.Type) (_ apacheArrow.DataType, nullable bool, _ appendFunc, _ error) {
+	switch t := t.(type) {
+	case types.Optional:
+		dt, _, appendInner, err := fieldType(t.InnerType())
+		if err != nil {
+			return nil, false, nil, err
+		}
+
+		return dt, true, func(b array.Builder, v value.Value) error {
+			inner, ok := value.UnwrapOptional(v)
+			if !ok {
+				b.AppendNull()
+
+				return nil
+			}
+
+			return appendInner(b, inner)
+		}, nil
+	case *types.List:
+		dt, _, appendItem, err := fieldType(t.ItemType())
+		if err != nil {
+			return nil, false, nil, err
+		}
+
+		return apacheArrow.ListOf(dt), false, func(b array.Builder, v value.Value) error {
+			items, ok := v.(interface{ ListItems() []value.Value })
+			if !ok {
+				return xerrors.WithStackTrace(fmt.Errorf("%w: %s", ErrUnsupportedType, v.Type().Yql()))
+			}
+			lb := b.(*array.ListBuilder) //nolint:forcetypeassert
+			lb.Append(true)
+			for _, item := range items.ListItems() {
+				if err := appendItem(lb.ValueBuilder(), item); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		}, nil
+	case *types.Decimal:
+		return &apacheArrow.Decimal128Type{
+			Precision: int32(t.Precision()),
+			Scale:     int32(t.Scale()),
+		}, false, appendDecimal, nil
+	case types.Primitive:
+		switch t {
+		case types.Bool:
+			return apacheArrow.FixedWidthTypes.Boolean, false,
+				appender[bool, bool, *array.BooleanBuilder](identity[bool]), nil
+		case types.Int8:
+			return apacheArrow.PrimitiveTypes.Int8, false,
+				appender[int8, int8, *array.Int8Builder](identity[int8]), nil
+		case types.Uint8:
+			return apacheArrow.PrimitiveTypes.Uint8, false,
+				appender[uint8, uint8, *array.Uint8Builder](identity[uint8]), nil
+		case types.Int16:
+			return apacheArrow.PrimitiveTypes.Int16, false,
+				appender[int16, int16, *array.Int16Builder](identity[int16]), nil
+		case types.Uint16:
+			return apacheArrow.PrimitiveTypes.Uint16, false,
+				appender[uint16, uint16, *array.Uint16Builder](identity[uint16]), nil
+		case types.Int32:
+			return apacheArrow.PrimitiveTypes.Int32, false,
+				appender[int32, int32, *array.Int32Builder](identity[int32]), nil
+		case types.Uint32:
+			return apacheArrow.PrimitiveTypes.Uint32, false,
+				appender[uint32, uint32, *array.Uint32Builder](identity[uint32]), nil
+		case types.Int64:
+			return apacheArrow.PrimitiveTypes.Int64, false,
+				appender[int64, int64, *array.Int64Builder](identity[int64]), nil
+		case types.Uint64:
+			return apacheArrow.PrimitiveTypes.Uint64, false,
+				appender[uint64, uint64, *array.Uint64Builder](identity[uint64]), nil
+		case types.Float:
+			return apacheArrow.PrimitiveTypes.Float32, false,
+				appender[float32, float32, *array.Float32Builder](identity[float32]), nil
+		case types.Double:
+			return apacheArrow.PrimitiveTypes.Float64, false,
+				appender[float64, float64, *array.Float64Builder](identity[float64]), nil
+		case types.Date:
+			return apacheArrow.FixedWidthTypes.Date32, false,
+				appender[int32, apacheArrow.Date32, *array.Date32Builder](func(days int32) apacheArrow.Date32 {
+					return apacheArrow.Date32(days)
+				}), nil
+		case types.Datetime:
+			return &apacheArrow.TimestampType{Unit: apacheArrow.Second, TimeZone: "UTC"}, false,
+				appender[int64, apacheArrow.Timestamp, *array.TimestampBuilder](func(seconds int64) apacheArrow.Timestamp {
+					return apacheArrow.Timestamp(seconds)
+				}), nil
+		case types.Timestamp:
+			return &apacheArrow.TimestampType{Unit: apacheArrow.Microsecond, TimeZone: "UTC"}, false,
+				appender[uint64, apacheArrow.Timestamp, *array.TimestampBuilder](func(us uint64) apacheArrow.Timestamp {
+					return apacheArrow.Timestamp(us)
+				}), nil
+		case types.Interval:
+			return apacheArrow.FixedWidthTypes.Duration_us, false,
+				appender[int64, apacheArrow.Duration, *array.DurationBuilder](func(us int64) apacheArrow.Duration {
+					return apacheArrow.Duration(us)
+				}), nil
+		case types.UUID:
+			return &apacheArrow.FixedSizeBinaryType{ByteWidth: 16}, false, //nolint:gomnd
+				appender[[16]byte, []byte, *array.FixedSizeBinaryBuilder](func(uuid [16]byte) []byte {
+					return uuid[:]
+				}), nil
+		case types.Text, types.JSON, types.JSONDocument, types.DyNumber,
+			types.TzDate, types.TzDatetime, types.TzTimestamp:
+			return apacheArrow.BinaryTypes.String, false,
+				appender[string, string, *array.StringBuilder](identity[string]), nil
+		case types.Bytes, types.YSON:
+			return apacheArrow.BinaryTypes.Binary, false,
+				appender[[]byte, []byte, *array.BinaryBuilder](identity[[]byte]), nil
+		}
+	}
+
+	return nil, false, nil, xerrors.WithStackTrace(fmt.Errorf("%w: %s", ErrUnsupportedType, t.Yql()))
+}
+
+func identity[T any](v T) T {
+	return v
+}
+
+// appender makes function which casts YDB value to go type T and appends converted value
+// to Arrow builder B
+func appender[T, A any, B interface{ Append(v A) }](convert func(v T) A) appendFunc {
+	return func(b array.Builder, v value.Value) error {
+		var x T
+		if err := value.CastTo(v, &x); err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		b.(B).Append(convert(x)) //nolint:forcetypeassert
+
+		return nil
+	}
+}
+
+func appendDecimal(b array.Builder, v value.Value) error {
+	d, ok := v.(value.DecimalValuer)
+	if !ok {
+		return xerrors.WithStackTrace(fmt.Errorf("%w: %s", ErrUnsupportedType, v.Type().Yql()))
+	}
+	x := decimal.FromInt128(d.Value(), d.Precision(), d.Scale())
+	if decimal.IsInf(x) || decimal.IsNaN(x) || decimal.IsErr(x) {
+		return xerrors.WithStackTrace(fmt.Errorf("%w: %s", errDecimalNotFinite, v.Yql()))
+	}
+	b.(*array.Decimal128Builder).Append(decimal128.FromBigInt(x)) //nolint:forcetypeassert
+
+	return nil
+}
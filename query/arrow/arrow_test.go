@@ -0,0 +1,215 @@
+package arrow
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	apacheArrow "github.com/apache/arrow/go/v15/arrow"
+	"github.com/apache/arrow/go/v15/arrow/array"
+	"github.com/apache/arrow/go/v15/arrow/decimal128"
+	"github.com/apache/arrow/go/v15/arrow/ipc"
+	"github.com/apache/arrow/go/v15/arrow/memory"
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
+	internalQuery "github.com/ydb-platform/ydb-go-sdk/v3/internal/query"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+)
+
+func resultSet(columns []*Ydb.Column, rows ...*Ydb.Value) query.ResultSet {
+	names := make([]string, len(columns))
+	columnTypes := make([]types.Type, len(columns))
+	for i := range columns {
+		names[i] = columns[i].GetName()
+		columnTypes[i] = types.TypeFromYDB(columns[i].GetType())
+	}
+	queryRows := make([]query.Row, len(rows))
+	for i := range rows {
+		queryRows[i] = internalQuery.NewRow(columns, rows[i])
+	}
+
+	return internalQuery.MaterializedResultSet(0, names, columnTypes, queryRows)
+}
+
+func TestRecordReader(t *testing.T) {
+	ctx := xtest.Context(t)
+	columns := []*Ydb.Column{
+		{Name: "id", Type: &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_INT32}}},
+		{Name: "name", Type: &Ydb.Type{Type: &Ydb.Type_OptionalType{OptionalType: &Ydb.OptionalType{
+			Item: &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_UTF8}},
+		}}}},
+		{Name: "amount", Type: &Ydb.Type{Type: &Ydb.Type_DecimalType{DecimalType: &Ydb.DecimalType{
+			Precision: 22, Scale: 9,
+		}}}},
+		{Name: "ts", Type: &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_TIMESTAMP}}},
+		{Name: "tags", Type: &Ydb.Type{Type: &Ydb.Type_ListType{ListType: &Ydb.ListType{
+			Item: &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_UINT64}},
+		}}}},
+	}
+	row := func(id int32, name *string, ts uint64, tags ...uint64) *Ydb.Value {
+		nameValue := &Ydb.Value{Value: &Ydb.Value_NullFlagValue{}}
+		if name != nil {
+			nameValue = &Ydb.Value{Value: &Ydb.Value_TextValue{TextValue: *name}}
+		}
+		tagsValue := &Ydb.Value{}
+		for _, tag := range tags {
+			tagsValue.Items = append(tagsValue.Items, &Ydb.Value{Value: &Ydb.Value_Uint64Value{Uint64Value: tag}})
+		}
+
+		return &Ydb.Value{Items: []*Ydb.Value{
+			{Value: &Ydb.Value_Int32Value{Int32Value: id}},
+			nameValue,
+			{Value: &Ydb.Value_Low_128{Low_128: uint64(id) * 1000000000}},
+			{Value: &Ydb.Value_Uint64Value{Uint64Value: ts}},
+			tagsValue,
+		}}
+	}
+	name := "test"
+	rs := resultSet(columns,
+		row(1, &name, 1000000, 1, 2),
+		row(2, nil, 2000000),
+		row(3, &name, 3000000, 3),
+	)
+
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	r, err := NewRecordReader(ctx, rs, WithBatchSize(2), WithAllocator(mem))
+	require.NoError(t, err)
+	defer r.Release()
+
+	require.Equal(t, []apacheArrow.Field{
+		{Name: "id", Type: apacheArrow.PrimitiveTypes.Int32},
+		{Name: "name", Type: apacheArrow.BinaryTypes.String, Nullable: true},
+		{Name: "amount", Type: &apacheArrow.Decimal128Type{Precision: 22, Scale: 9}},
+		{Name: "ts", Type: &apacheArrow.TimestampType{Unit: apacheArrow.Microsecond, TimeZone: "UTC"}},
+		{Name: "tags", Type: apacheArrow.ListOf(apacheArrow.PrimitiveTypes.Uint64)},
+	}, r.Schema().Fields())
+
+	require.True(t, r.Next())
+	record := r.Record()
+	require.EqualValues(t, 2, record.NumRows())
+	require.Equal(t, []int32{1, 2}, record.Column(0).(*array.Int32).Int32Values())
+	names := record.Column(1).(*array.String)
+	require.Equal(t, "test", names.Value(0))
+	require.True(t, names.IsNull(1))
+	require.Equal(t, decimal128.FromI64(1000000000), record.Column(2).(*array.Decimal128).Value(0))
+	require.Equal(t, apacheArrow.Timestamp(2000000), record.Column(3).(*array.Timestamp).Value(1))
+	require.Equal(t, `[[1 2] []]`, record.Column(4).String())
+
+	require.True(t, r.Next())
+	require.EqualValues(t, 1, r.Record().NumRows())
+	require.Equal(t, []int32{3}, r.Record().Column(0).(*array.Int32).Int32Values())
+
+	require.False(t, r.Next())
+	require.NoError(t, r.Err())
+}
+
+func TestSchemaUnsupportedType(t *testing.T) {
+	_, err := Schema(resultSet([]*Ydb.Column{
+		{Name: "d", Type: &Ydb.Type{Type: &Ydb.Type_DictType{DictType: &Ydb.DictType{
+			Key:     &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_UTF8}},
+			Payload: &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_UTF8}},
+		}}}},
+	}))
+	require.ErrorIs(t, err, ErrUnsupportedType)
+}
+
+type arrowResultSet struct {
+	query.ResultSet
+
+	schema  []byte
+	batches [][]byte
+}
+
+func (rs *arrowResultSet) ArrowSchema() []byte {
+	return rs.schema
+}
+
+func (rs *arrowResultSet) NextArrowBatch(context.Context) ([]byte, error) {
+	if len(rs.batches) == 0 {
+		return nil, io.EOF
+	}
+	batch := rs.batches[0]
+	rs.batches = rs.batches[1:]
+
+	return batch, nil
+}
+
+// ipcMessages returns schema message and record batch messages as server sends them in result set parts
+func ipcMessages(t *testing.T, schema *apacheArrow.Schema, records ...apacheArrow.Record) ([]byte, [][]byte) {
+	// end-of-stream marker written by ipc.Writer on close
+	const eosSize = 8
+
+	var buf bytes.Buffer
+	w := ipc.NewWriter(&buf, ipc.WithSchema(schema))
+	require.NoError(t, w.Close())
+	schemaMessage := append([]byte(nil), buf.Bytes()[:buf.Len()-eosSize]...)
+
+	batches := make([][]byte, 0, len(records))
+	for _, record := range records {
+		buf.Reset()
+		w = ipc.NewWriter(&buf, ipc.WithSchema(schema))
+		require.NoError(t, w.Write(record))
+		require.NoError(t, w.Close())
+		batches = append(batches, append([]byte(nil), buf.Bytes()[len(schemaMessage):buf.Len()-eosSize]...))
+	}
+
+	return schemaMessage, batches
+}
+
+func TestRecordReaderNative(t *testing.T) {
+	ctx := xtest.Context(t)
+	schema := apacheArrow.NewSchema([]apacheArrow.Field{
+		{Name: "id", Type: apacheArrow.PrimitiveTypes.Int32},
+		{Name: "name", Type: apacheArrow.BinaryTypes.String, Nullable: true},
+	}, nil)
+	record := func(ids ...int32) apacheArrow.Record {
+		b := array.NewRecordBuilder(memory.DefaultAllocator, schema)
+		defer b.Release()
+		for _, id := range ids {
+			b.Field(0).(*array.Int32Builder).Append(id)
+			b.Field(1).(*array.StringBuilder).AppendNull()
+		}
+
+		return b.NewRecord()
+	}
+	first, second := record(1, 2), record(3)
+	defer first.Release()
+	defer second.Release()
+
+	schemaMessage, batches := ipcMessages(t, schema, first, second)
+	rs := &arrowResultSet{
+		// columns of native result set are not used by reader
+		ResultSet: resultSet(nil),
+		schema:    schemaMessage,
+		batches:   batches,
+	}
+
+	s, err := Schema(rs)
+	require.NoError(t, err)
+	require.True(t, schema.Equal(s))
+
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	r, err := NewRecordReader(ctx, rs, WithAllocator(mem))
+	require.NoError(t, err)
+	defer r.Release()
+
+	require.True(t, schema.Equal(r.Schema()))
+
+	require.True(t, r.Next())
+	require.Equal(t, []int32{1, 2}, r.Record().Column(0).(*array.Int32).Int32Values())
+	require.True(t, r.Record().Column(1).IsNull(1))
+
+	require.True(t, r.Next())
+	require.Equal(t, []int32{3}, r.Record().Column(0).(*array.Int32).Int32Values())
+
+	require.False(t, r.Next())
+	require.NoError(t, r.Err())
+}
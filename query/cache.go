@@ -0,0 +1,68 @@
+package query
+
+import (
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/cache"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/options"
+)
+
+type (
+	// ResultCache is an in-memory cache of materialized results of read-only queries with TTL,
+	// limit of total size in bytes, LRU eviction and deduplication of concurrent identical requests.
+	// Key of cached result is a query text with canonical encoding of query parameters
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	ResultCache = cache.Cache
+
+	// ResultCacheOption is an option for NewResultCache
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	ResultCacheOption = cache.Option
+
+	// ResultCacheStats contains counters of result cache usage
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	ResultCacheStats = cache.Stats
+)
+
+// NewResultCache makes cache of query results. Result cache applies to query client with
+// ydb.WithQueryResultCache option
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func NewResultCache(opts ...ResultCacheOption) *ResultCache {
+	return cache.New(opts...)
+}
+
+// WithResultCacheTTL defines time to live of cached results (cache.DefaultTTL by default)
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithResultCacheTTL(ttl time.Duration) ResultCacheOption {
+	return cache.WithTTL(ttl)
+}
+
+// WithResultCacheMaxBytes defines upper bound of total size of cached results (cache.DefaultMaxBytes by default)
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithResultCacheMaxBytes(maxBytes int) ResultCacheOption {
+	return cache.WithMaxBytes(maxBytes)
+}
+
+// WithResultCacheFetchTimeout defines timeout of shared fetch of result (cache.DefaultFetchTimeout by default).
+// Shared fetch of result is not canceled by cancellation of context of requests which wait for it
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithResultCacheFetchTimeout(timeout time.Duration) ResultCacheOption {
+	return cache.WithFetchTimeout(timeout)
+}
+
+// WithResultCache requests result from cache of query client (if cache defined with ydb.WithQueryResultCache).
+// Tables defines paths of tables which results of query depends on for invalidation with
+// ResultCache.Invalidate. Result cache applies only to Client.Query, Client.QueryResultSet and
+// Client.QueryRow with read-only transaction control (snapshot, stale or online read-only).
+// Intercepted queries (see ydb.WithQueryInterceptors) bypass result cache, so interceptors see every call
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithResultCache(tables ...string) options.Execute {
+	return options.WithResultCache(tables...)
+}
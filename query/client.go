@@ -6,6 +6,7 @@ import (
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/closer"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
 	"github.com/ydb-platform/ydb-go-sdk/v3/retry/budget"
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
@@ -95,6 +96,18 @@ type (
 		FetchScriptResults(
 			ctx context.Context, opID string, opts ...options.FetchScriptOption,
 		) (*options.FetchScriptResult, error)
+
+		// StartScript starts long executing script and returns handle for waiting, cancellation
+		// and streaming script results
+		//
+		// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+		StartScript(ctx context.Context, query string, ttl time.Duration, ops ...options.Execute) (Script, error)
+
+		// Script returns handle of script execution by operation ID (for example, persisted
+		// before process restart)
+		//
+		// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+		Script(opID string) Script
 	}
 )
 
@@ -142,6 +155,13 @@ func WithLabel(lbl string) options.RetryOptionsOption {
 	return options.WithLabel(lbl)
 }
 
+// WithRetryPolicy creates option with retry policy which overrides retry policy of driver
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithRetryPolicy(p *retry.Policy) options.RetryOptionsOption {
+	return options.WithRetryPolicy(p)
+}
+
 // WithRetryBudget creates option with external budget
 //
 // Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
@@ -0,0 +1,60 @@
+package query
+
+import (
+	"context"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xiter"
+)
+
+type (
+	// ScriptProgress contains status and statistics of running (or completed) script execution
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	ScriptProgress = options.ScriptProgress
+
+	// ExecStatus is a status of script execution
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	ExecStatus = options.ExecStatus
+
+	// Script is a handle of long-running script execution started with Client.StartScript
+	// or resumed with Client.Script by persisted operation ID
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	Script interface {
+		// ID returns operation ID of script execution. ID may be persisted for resuming
+		// script handle with Client.Script after process restart
+		ID() string
+
+		// Wait polls operation status with backoff until script execution completed.
+		// Wait returns error if script execution failed, aborted or cancelled
+		Wait(ctx context.Context) (*options.MetadataExecuteQuery, error)
+
+		// Progress returns current status and statistics of script execution without waiting of completion
+		Progress(ctx context.Context) (*ScriptProgress, error)
+
+		// Cancel starts cancellation of script execution
+		Cancel(ctx context.Context) error
+
+		// Forget forgets completed script execution. Results of forgotten script are not available
+		Forget(ctx context.Context) error
+
+		// NextResultSet waits script completion and returns next result set of script.
+		// Rows of result set are fetched page by page with fetch tokens transparently
+		NextResultSet(ctx context.Context) (ResultSet, error)
+
+		// ResultSets is experimental API for range iterators available
+		// with Go version 1.23+
+		ResultSets(ctx context.Context) xiter.Seq2[ResultSet, error]
+	}
+)
+
+const (
+	ExecStatusUnspecified = options.ExecStatusUnspecified
+	ExecStatusStarting    = options.ExecStatusStarting
+	ExecStatusAborted     = options.ExecStatusAborted
+	ExecStatusCancelled   = options.ExecStatusCancelled
+	ExecStatusCompleted   = options.ExecStatusCompleted
+	ExecStatusFailed      = options.ExecStatusFailed
+)
@@ -73,6 +73,18 @@ func WithStatsMode(mode options.StatsMode, callback func(Stats)) options.Execute
 	return options.WithStatsMode(mode, callback)
 }
 
+// WithArrowFormat requests result sets in Arrow format from server. Rows of such result sets are not available
+// with NextRow, record batches of result sets reads with package github.com/ydb-platform/ydb-go-sdk/v3/query/arrow
+//
+// Arrow format is supported by streaming Query of Session and Transaction only. Helpers, which read rows of result
+// (QueryResultSet and QueryRow of Client, Session and Transaction, Query of Client), return error with this option
+// before execute query.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithArrowFormat() options.Execute {
+	return options.WithResultSetFormat(options.ResultSetFormatArrow)
+}
+
 func WithCallOptions(opts ...grpc.CallOption) options.Execute {
 	return options.WithCallOptions(opts...)
 }
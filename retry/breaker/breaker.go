@@ -0,0 +1,150 @@
+package breaker
+
+import (
+	"time"
+)
+
+const (
+	DefaultErrorRateThreshold    = 0.5
+	DefaultSlowCallRateThreshold = 0.5
+	DefaultMinRequests           = 20
+	DefaultWindow                = 10 * time.Second
+	DefaultOpenTimeout           = 30 * time.Second
+	DefaultHalfOpenMaxCalls      = 3
+)
+
+type (
+	// Config describes thresholds of client-side circuit breakers. Circuit breaker works per endpoint
+	// and optionally per label of retry operation (see retry.WithLabel)
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	Config struct {
+		// ErrorRateThreshold is a rate of failed calls in window which opens circuit breaker
+		ErrorRateThreshold float64
+
+		// SlowCallDuration is a duration of call which counts as slow call (slow calls not counts if zero)
+		SlowCallDuration time.Duration
+
+		// SlowCallRateThreshold is a rate of slow calls in window which opens circuit breaker
+		SlowCallRateThreshold float64
+
+		// MinRequests is a minimal count of calls in window for checks of thresholds
+		MinRequests int
+
+		// Window is a duration of window for calls statistics
+		Window time.Duration
+
+		// OpenTimeout is a duration of open state before probe calls in half-open state
+		OpenTimeout time.Duration
+
+		// HalfOpenMaxCalls is a count of probe calls in half-open state. Circuit breaker closes if all
+		// probe calls are successful
+		HalfOpenMaxCalls int
+
+		// ByLabel makes separated circuit breakers for each label of retry operation on endpoint
+		ByLabel bool
+	}
+
+	// Option is an option for circuit breaker config
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	Option func(c *Config)
+)
+
+// WithErrorRateThreshold defines a rate of failed calls (from 0 to 1) which opens circuit breaker
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithErrorRateThreshold(rate float64) Option {
+	return func(c *Config) {
+		if rate > 0 && rate <= 1 {
+			c.ErrorRateThreshold = rate
+		}
+	}
+}
+
+// WithSlowCallThreshold defines a duration of slow call and a rate of slow calls (from 0 to 1)
+// which opens circuit breaker
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithSlowCallThreshold(duration time.Duration, rate float64) Option {
+	return func(c *Config) {
+		if duration > 0 && rate > 0 && rate <= 1 {
+			c.SlowCallDuration = duration
+			c.SlowCallRateThreshold = rate
+		}
+	}
+}
+
+// WithMinRequests defines a minimal count of calls in window for checks of thresholds
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithMinRequests(n int) Option {
+	return func(c *Config) {
+		if n > 0 {
+			c.MinRequests = n
+		}
+	}
+}
+
+// WithWindow defines a duration of window for calls statistics
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithWindow(window time.Duration) Option {
+	return func(c *Config) {
+		if window > 0 {
+			c.Window = window
+		}
+	}
+}
+
+// WithOpenTimeout defines a duration of open state
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithOpenTimeout(timeout time.Duration) Option {
+	return func(c *Config) {
+		if timeout > 0 {
+			c.OpenTimeout = timeout
+		}
+	}
+}
+
+// WithHalfOpenMaxCalls defines a count of probe calls in half-open state
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithHalfOpenMaxCalls(n int) Option {
+	return func(c *Config) {
+		if n > 0 {
+			c.HalfOpenMaxCalls = n
+		}
+	}
+}
+
+// WithLabels makes separated circuit breakers for each label of retry operation on endpoint
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithLabels(byLabel bool) Option {
+	return func(c *Config) {
+		c.ByLabel = byLabel
+	}
+}
+
+// New makes circuit breaker config with defaults and applied options
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func New(opts ...Option) *Config {
+	c := &Config{
+		ErrorRateThreshold:    DefaultErrorRateThreshold,
+		SlowCallRateThreshold: DefaultSlowCallRateThreshold,
+		MinRequests:           DefaultMinRequests,
+		Window:                DefaultWindow,
+		OpenTimeout:           DefaultOpenTimeout,
+		HalfOpenMaxCalls:      DefaultHalfOpenMaxCalls,
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(c)
+		}
+	}
+
+	return c
+}
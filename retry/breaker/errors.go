@@ -0,0 +1,38 @@
+package breaker
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrOpen is a special error for calls rejected by open circuit breaker
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+var ErrOpen = errors.New("circuit breaker is open")
+
+// OpenError describes call rejected by open circuit breaker
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type OpenError struct {
+	// Address is an address of endpoint
+	Address string
+
+	// Label is a label of retry operation (empty if circuit breakers not separated by labels)
+	Label string
+
+	// RetryAfter is a remaining duration of open state
+	RetryAfter time.Duration
+}
+
+func (e *OpenError) Error() string {
+	if e.Label != "" {
+		return fmt.Sprintf("%s: address=%q, label=%q, retryAfter=%v", ErrOpen, e.Address, e.Label, e.RetryAfter)
+	}
+
+	return fmt.Sprintf("%s: address=%q, retryAfter=%v", ErrOpen, e.Address, e.RetryAfter)
+}
+
+func (e *OpenError) Is(target error) bool {
+	return target == ErrOpen //nolint:errorlint
+}
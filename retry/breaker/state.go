@@ -0,0 +1,28 @@
+package breaker
+
+// State is a state of circuit breaker
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type State int
+
+const (
+	// Closed state allows all calls
+	Closed = State(iota)
+	// Open state rejects all calls with OpenError
+	Open
+	// HalfOpen state allows limited count of probe calls
+	HalfOpen
+)
+
+func (s State) String() string {
+	switch s {
+	case Closed:
+		return "closed"
+	case Open:
+		return "open"
+	case HalfOpen:
+		return "half-open"
+	default:
+		return "unknown"
+	}
+}
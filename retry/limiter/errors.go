@@ -0,0 +1,33 @@
+package limiter
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrLimitExceeded is a special error for calls rejected by concurrency limiter
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+var ErrLimitExceeded = errors.New("concurrency limit exceeded")
+
+// LimitExceededError describes call rejected by concurrency limiter
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type LimitExceededError struct {
+	// Service is a service of rejected call
+	Service Service
+
+	// Limit is a current limit of concurrent calls of service
+	Limit int
+
+	// Queued is a count of calls which waits for free slot
+	Queued int
+}
+
+func (e *LimitExceededError) Error() string {
+	return fmt.Sprintf("%s: service=%q, limit=%d, queued=%d", ErrLimitExceeded, e.Service, e.Limit, e.Queued)
+}
+
+func (e *LimitExceededError) Is(target error) bool {
+	return target == ErrLimitExceeded //nolint:errorlint
+}
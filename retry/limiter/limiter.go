@@ -0,0 +1,184 @@
+package limiter
+
+import (
+	"time"
+)
+
+const (
+	DefaultInitialLimit = 20
+	DefaultMinLimit     = 1
+	DefaultMaxLimit     = 200
+	DefaultBackoffRatio = 0.9
+	DefaultMaxQueueSize = 100
+)
+
+// Service is a group of YDB API methods with separated concurrency limit
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type Service string
+
+const (
+	// ServiceQuery limits calls of query service (excluding AttachSession streams)
+	ServiceQuery = Service("query")
+	// ServiceTable limits calls of table service
+	ServiceTable = Service("table")
+	// ServiceTopic limits control plane calls of topic service (excluding read and write streams)
+	ServiceTopic = Service("topic")
+)
+
+type (
+	// Config describes adaptive concurrency limiter with AIMD (additive increase, multiplicative decrease)
+	// algorithm. Limit of concurrent calls increases by one on successful calls and decreases
+	// multiplicatively on overload signals (OVERLOADED, RESOURCE_EXHAUSTED or timeout of call)
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	Config struct {
+		// InitialLimit is a limit of concurrent calls at start
+		InitialLimit int
+
+		// MinLimit is a minimal limit of concurrent calls
+		MinLimit int
+
+		// MaxLimit is a maximal limit of concurrent calls
+		MaxLimit int
+
+		// BackoffRatio is a multiplier (from 0 to 1) of limit on overload signal
+		BackoffRatio float64
+
+		// Timeout is a duration of call which counts as overload signal (not counts if zero)
+		Timeout time.Duration
+
+		// MaxQueueSize is a maximal count of calls which waits for free slot
+		MaxQueueSize int
+
+		// MaxQueueWait is a maximal duration of waiting for free slot (waits until context done if zero)
+		MaxQueueWait time.Duration
+
+		services map[Service][]Option
+	}
+
+	// Option is an option for concurrency limiter config
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	Option func(c *Config)
+)
+
+// WithInitialLimit defines a limit of concurrent calls at start
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithInitialLimit(limit int) Option {
+	return func(c *Config) {
+		if limit > 0 {
+			c.InitialLimit = limit
+		}
+	}
+}
+
+// WithLimits defines minimal and maximal limits of concurrent calls
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithLimits(minLimit, maxLimit int) Option {
+	return func(c *Config) {
+		if minLimit > 0 && maxLimit >= minLimit {
+			c.MinLimit, c.MaxLimit = minLimit, maxLimit
+		}
+	}
+}
+
+// WithBackoffRatio defines a multiplier (from 0 to 1) of limit on overload signal
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithBackoffRatio(ratio float64) Option {
+	return func(c *Config) {
+		if ratio > 0 && ratio < 1 {
+			c.BackoffRatio = ratio
+		}
+	}
+}
+
+// WithTimeout defines a duration of call which counts as overload signal
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *Config) {
+		if timeout > 0 {
+			c.Timeout = timeout
+		}
+	}
+}
+
+// WithMaxQueueSize defines a maximal count of calls which waits for free slot.
+// Calls rejects immediately if zero
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithMaxQueueSize(size int) Option {
+	return func(c *Config) {
+		if size >= 0 {
+			c.MaxQueueSize = size
+		}
+	}
+}
+
+// WithMaxQueueWait defines a maximal duration of waiting for free slot
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithMaxQueueWait(wait time.Duration) Option {
+	return func(c *Config) {
+		if wait > 0 {
+			c.MaxQueueWait = wait
+		}
+	}
+}
+
+// WithService overrides config options for service
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithService(service Service, opts ...Option) Option {
+	return func(c *Config) {
+		if c.services == nil {
+			c.services = make(map[Service][]Option)
+		}
+		c.services[service] = append(c.services[service], opts...)
+	}
+}
+
+// ForService returns config of service with applied overrides
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func (c *Config) ForService(service Service) *Config {
+	cc := *c
+	cc.services = nil
+	for _, opt := range c.services[service] {
+		if opt != nil {
+			opt(&cc)
+		}
+	}
+	if cc.InitialLimit < cc.MinLimit {
+		cc.InitialLimit = cc.MinLimit
+	}
+	if cc.InitialLimit > cc.MaxLimit {
+		cc.InitialLimit = cc.MaxLimit
+	}
+
+	return &cc
+}
+
+// New makes concurrency limiter config with defaults and applied options
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func New(opts ...Option) *Config {
+	c := &Config{
+		InitialLimit: DefaultInitialLimit,
+		MinLimit:     DefaultMinLimit,
+		MaxLimit:     DefaultMaxLimit,
+		BackoffRatio: DefaultBackoffRatio,
+		MaxQueueSize: DefaultMaxQueueSize,
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(c)
+		}
+	}
+
+	return c
+}
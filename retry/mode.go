@@ -11,6 +11,7 @@ type retryMode struct {
 	errType            xerrors.Type
 	backoff            backoff.Type
 	isRetryObjectValid bool
+	maxAttempts        int
 }
 
 func (m retryMode) MustRetry(isOperationIdempotent bool) bool {
@@ -29,6 +30,9 @@ func (m retryMode) MustRetry(isOperationIdempotent bool) bool {
 
 func (m retryMode) StatusCode() int64 { return m.code }
 
+// MaxAttempts returns limit of attempts on error (unlimited if zero)
+func (m retryMode) MaxAttempts() int { return m.maxAttempts }
+
 func (m retryMode) MustBackoff() bool { return m.backoff&backoff.TypeAny != 0 }
 
 func (m retryMode) BackoffType() backoff.Type { return m.backoff }
@@ -0,0 +1,270 @@
+package retry
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	grpcCodes "google.golang.org/grpc/codes"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/backoff"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// BackoffKind is a kind of backoff between attempts
+type BackoffKind string
+
+const (
+	// BackoffDefault keeps backoff of error by default
+	BackoffDefault = BackoffKind("")
+	// BackoffNone makes next attempt immediately
+	BackoffNone = BackoffKind("none")
+	// BackoffFast makes next attempt after fast backoff
+	BackoffFast = BackoffKind("fast")
+	// BackoffSlow makes next attempt after slow backoff
+	BackoffSlow = BackoffKind("slow")
+)
+
+var (
+	errUnknownPolicyStatus  = errors.New("unknown YDB status in retry policy")
+	errUnknownPolicyCode    = errors.New("unknown gRPC code in retry policy")
+	errUnknownPolicyBackoff = errors.New("unknown backoff kind in retry policy")
+	errNoTimeForNextAttempt = errors.New("not enough time until deadline for next attempt")
+)
+
+// PolicyRule describes retry behaviour on errors with one status code
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type PolicyRule struct {
+	// Retry allows retries on error
+	Retry bool `json:"retry"`
+
+	// IdempotentOnly allows retries on error for idempotent operations only
+	IdempotentOnly bool `json:"idempotent_only,omitempty"`
+
+	// MaxAttempts limits count of attempts of operation, failed with the status or code of rule (unlimited if zero).
+	// Attempts, failed with other errors, are not counted
+	MaxAttempts int `json:"max_attempts,omitempty"`
+
+	// Backoff is a kind of backoff before next attempt
+	Backoff BackoffKind `json:"backoff,omitempty"`
+
+	// DeleteSession marks session as broken (true) or valid (false) on error. Broken session will not return
+	// into sessions pool. If not set - session is deleted by default rules of SDK
+	DeleteSession *bool `json:"delete_session,omitempty"`
+}
+
+// Policy describes retry behaviour per YDB status and gRPC code. Errors without rules in policy
+// retries by default rules of SDK
+//
+// Example of JSON representation:
+//
+//	{
+//	  "statuses": {
+//	    "OVERLOADED": {"retry": true, "max_attempts": 5, "backoff": "slow"},
+//	    "UNDETERMINED": {"retry": true, "idempotent_only": true, "backoff": "fast"}
+//	  },
+//	  "grpc_codes": {
+//	    "Unavailable": {"retry": true, "backoff": "fast", "delete_session": true}
+//	  },
+//	  "deadline_aware": true
+//	}
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type Policy struct {
+	// Statuses contains rules by YDB status names (such as OVERLOADED, UNAVAILABLE)
+	Statuses map[string]PolicyRule `json:"statuses,omitempty"`
+
+	// GRPCCodes contains rules by gRPC code names (such as Unavailable, ResourceExhausted)
+	GRPCCodes map[string]PolicyRule `json:"grpc_codes,omitempty"`
+
+	// DeadlineAware stops retries if remaining time of context is not enough for backoff and one more attempt
+	// (with duration of previous attempt)
+	DeadlineAware bool `json:"deadline_aware,omitempty"`
+}
+
+// Validate checks names of statuses, codes and backoff kinds in policy
+func (p *Policy) Validate() error {
+	for name, rule := range p.Statuses {
+		if _, has := Ydb.StatusIds_StatusCode_value[name]; !has {
+			return xerrors.WithStackTrace(fmt.Errorf("%w: %s", errUnknownPolicyStatus, name))
+		}
+		if err := rule.validate(); err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+	}
+	for name, rule := range p.GRPCCodes {
+		if _, has := grpcCodeByName(name); !has {
+			return xerrors.WithStackTrace(fmt.Errorf("%w: %s", errUnknownPolicyCode, name))
+		}
+		if err := rule.validate(); err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+	}
+
+	return nil
+}
+
+func (r PolicyRule) validate() error {
+	switch r.Backoff {
+	case BackoffDefault, BackoffNone, BackoffFast, BackoffSlow:
+		return nil
+	default:
+		return xerrors.WithStackTrace(fmt.Errorf("%w: %s", errUnknownPolicyBackoff, r.Backoff))
+	}
+}
+
+func grpcCodeByName(name string) (grpcCodes.Code, bool) {
+	for code := grpcCodes.OK; code <= grpcCodes.Unauthenticated; code++ {
+		if code.String() == name {
+			return code, true
+		}
+	}
+
+	return 0, false
+}
+
+// ParsePolicy parses and validates retry policy from JSON
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func ParsePolicy(data []byte) (*Policy, error) {
+	var p Policy
+	if err := json.Unmarshal(data, &p); err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	if err := p.Validate(); err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return &p, nil
+}
+
+// LoadPolicy reads, parses and validates retry policy from JSON
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func LoadPolicy(r io.Reader) (*Policy, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return ParsePolicy(data)
+}
+
+// rule returns rule of policy for err
+func (p *Policy) rule(err error) (rule PolicyRule, ok bool) {
+	if p == nil {
+		return rule, false
+	}
+	if code, has := xerrors.OperationStatus(err); has {
+		rule, ok = p.Statuses[code.String()]
+
+		return rule, ok
+	}
+	if code, has := xerrors.TransportCode(err); has {
+		rule, ok = p.GRPCCodes[code.String()]
+
+		return rule, ok
+	}
+
+	return rule, false
+}
+
+// MustDeleteSession reports whether session must be deleted after err.
+// Second result is false if policy has no rule for err or rule doesn't set delete_session
+func (p *Policy) MustDeleteSession(err error) (mustDelete, ok bool) {
+	rule, ok := p.rule(err)
+	if !ok || rule.DeleteSession == nil {
+		return false, false
+	}
+
+	return *rule.DeleteSession, true
+}
+
+// apply applies policy rule for err to retry mode
+func (p *Policy) apply(err error, m retryMode) retryMode {
+	rule, ok := p.rule(err)
+	if !ok {
+		return m
+	}
+	switch {
+	case !rule.Retry:
+		m.errType = xerrors.TypeNonRetryable
+	case rule.IdempotentOnly:
+		m.errType = xerrors.TypeConditionallyRetryable
+	default:
+		m.errType = xerrors.TypeRetryable
+	}
+	switch rule.Backoff {
+	case BackoffNone:
+		m.backoff = backoff.TypeNoBackoff
+	case BackoffFast:
+		m.backoff = backoff.TypeFast
+	case BackoffSlow:
+		m.backoff = backoff.TypeSlow
+	}
+	if rule.DeleteSession != nil {
+		m.isRetryObjectValid = !*rule.DeleteSession
+	}
+	m.maxAttempts = rule.MaxAttempts
+
+	return m
+}
+
+// enoughTime reports whether remaining time of context is enough for delay and attempt
+func (p *Policy) enoughTime(ctx context.Context, delay, attempt time.Duration) bool {
+	if p == nil || !p.DeadlineAware {
+		return true
+	}
+	deadline, has := ctx.Deadline()
+	if !has {
+		return true
+	}
+
+	return time.Until(deadline) >= delay+attempt
+}
+
+var _ Option = policyOption{}
+
+type policyOption struct {
+	p *Policy
+}
+
+func (o policyOption) ApplyRetryOption(opts *retryOptions) {
+	if o.p != nil {
+		opts.policy = o.p
+	}
+}
+
+func (o policyOption) ApplyDoOption(opts *doOptions) {
+	opts.retryOptions = append(opts.retryOptions, WithPolicy(o.p))
+}
+
+func (o policyOption) ApplyDoTxOption(opts *doTxOptions) {
+	opts.retryOptions = append(opts.retryOptions, WithPolicy(o.p))
+}
+
+// WithPolicy applies retry policy. Nil policy is ignored
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithPolicy(p *Policy) policyOption {
+	return policyOption{p: p}
+}
+
+// PolicyFromOptions returns retry policy from options or nil if policy not defined
+//
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func PolicyFromOptions(opts ...Option) *Policy {
+	options := &retryOptions{}
+	for _, opt := range opts {
+		if _, ok := opt.(policyOption); ok {
+			opt.ApplyRetryOption(options)
+		}
+	}
+
+	return options.policy
+}
@@ -0,0 +1,182 @@
+package retry
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	grpcCodes "google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/backoff"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+)
+
+func TestParsePolicy(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		json string
+		err  error
+	}{
+		{
+			name: xtest.CurrentFileLine(),
+			json: `{
+				"statuses": {"OVERLOADED": {"retry": true, "max_attempts": 5, "backoff": "slow"}},
+				"grpc_codes": {"Unavailable": {"retry": true, "backoff": "fast", "delete_session": true}},
+				"deadline_aware": true
+			}`,
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			json: `{"statuses": {"OVERLOAD": {"retry": true}}}`,
+			err:  errUnknownPolicyStatus,
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			json: `{"grpc_codes": {"UNAVAILABLE": {"retry": true}}}`,
+			err:  errUnknownPolicyCode,
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			json: `{"statuses": {"OVERLOADED": {"retry": true, "backoff": "exponential"}}}`,
+			err:  errUnknownPolicyBackoff,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := LoadPolicy(strings.NewReader(tt.json))
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, p.DeadlineAware)
+			require.Equal(t, PolicyRule{Retry: true, MaxAttempts: 5, Backoff: BackoffSlow}, p.Statuses["OVERLOADED"])
+		})
+	}
+}
+
+func TestPolicyApply(t *testing.T) {
+	deleteSession := true
+	p := &Policy{
+		Statuses: map[string]PolicyRule{
+			"OVERLOADED":  {Retry: false},
+			"BAD_REQUEST": {Retry: true, IdempotentOnly: true, Backoff: BackoffNone},
+			"BAD_SESSION": {Retry: true, Backoff: BackoffNone},
+		},
+		GRPCCodes: map[string]PolicyRule{
+			"Unavailable": {Retry: true, Backoff: BackoffSlow, DeleteSession: &deleteSession, MaxAttempts: 3},
+		},
+	}
+	overloaded := xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_OVERLOADED))
+	m := p.apply(overloaded, Check(overloaded))
+	require.False(t, m.MustRetry(true))
+
+	badRequest := xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_BAD_REQUEST))
+	m = p.apply(badRequest, Check(badRequest))
+	require.True(t, m.MustRetry(true))
+	require.False(t, m.MustRetry(false))
+	require.Equal(t, backoff.TypeNoBackoff, m.BackoffType())
+
+	unavailable := xerrors.Transport(grpcStatus.Error(grpcCodes.Unavailable, ""))
+	m = p.apply(unavailable, Check(unavailable))
+	require.True(t, m.MustRetry(false))
+	require.Equal(t, backoff.TypeSlow, m.BackoffType())
+	require.True(t, m.MustDeleteSession())
+	require.Equal(t, 3, m.MaxAttempts())
+	mustDelete, ok := p.MustDeleteSession(unavailable)
+	require.True(t, ok)
+	require.True(t, mustDelete)
+
+	// rule without delete_session keeps default invalidation of session
+	badSession := xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_BAD_SESSION))
+	m = p.apply(badSession, Check(badSession))
+	require.True(t, m.MustRetry(false))
+	require.True(t, m.MustDeleteSession())
+	_, ok = p.MustDeleteSession(badSession)
+	require.False(t, ok)
+
+	aborted := xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_ABORTED))
+	require.Equal(t, Check(aborted), p.apply(aborted, Check(aborted)))
+	_, ok = p.MustDeleteSession(aborted)
+	require.False(t, ok)
+
+	require.Same(t, p, PolicyFromOptions(WithIdempotent(true), WithPolicy(p), WithPolicy(nil)))
+	require.Nil(t, PolicyFromOptions(WithIdempotent(true)))
+}
+
+func TestRetryWithPolicy(t *testing.T) {
+	ctx := xtest.Context(t)
+	t.Run("MaxAttempts", func(t *testing.T) {
+		attempts := 0
+		err := Retry(ctx, func(ctx context.Context) error {
+			attempts++
+
+			return xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_OVERLOADED))
+		}, WithPolicy(&Policy{
+			Statuses: map[string]PolicyRule{
+				"OVERLOADED": {Retry: true, MaxAttempts: 3, Backoff: BackoffNone},
+			},
+		}))
+		require.Error(t, err)
+		require.Equal(t, 3, attempts)
+	})
+	t.Run("MaxAttemptsPerRule", func(t *testing.T) {
+		attempts := 0
+		err := Retry(ctx, func(ctx context.Context) error {
+			attempts++
+			if attempts%2 == 0 {
+				return xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_UNAVAILABLE))
+			}
+
+			return xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_OVERLOADED))
+		}, WithPolicy(&Policy{
+			Statuses: map[string]PolicyRule{
+				"OVERLOADED":  {Retry: true, MaxAttempts: 3, Backoff: BackoffNone},
+				"UNAVAILABLE": {Retry: true, MaxAttempts: 3, Backoff: BackoffNone},
+			},
+		}))
+		require.Error(t, err)
+		require.True(t, xerrors.IsOperationError(err, Ydb.StatusIds_OVERLOADED))
+		require.Equal(t, 5, attempts)
+	})
+	t.Run("NonRetryableByDefault", func(t *testing.T) {
+		attempts := 0
+		err := Retry(ctx, func(ctx context.Context) error {
+			attempts++
+			if attempts < 3 {
+				return xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_PRECONDITION_FAILED))
+			}
+
+			return nil
+		}, WithPolicy(&Policy{
+			Statuses: map[string]PolicyRule{
+				"PRECONDITION_FAILED": {Retry: true, Backoff: BackoffNone},
+			},
+		}))
+		require.NoError(t, err)
+		require.Equal(t, 3, attempts)
+	})
+	t.Run("DeadlineAware", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(ctx, time.Second)
+		defer cancel()
+		attempts := 0
+		start := time.Now()
+		err := Retry(ctx, func(ctx context.Context) error {
+			attempts++
+
+			return xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_OVERLOADED))
+		}, WithPolicy(&Policy{DeadlineAware: true}), WithSlowBackoff(backoff.New(
+			backoff.WithSlotDuration(time.Hour),
+			backoff.WithCeiling(0),
+			backoff.WithJitterLimit(1),
+		)))
+		require.ErrorIs(t, err, errNoTimeForNextAttempt)
+		require.Equal(t, 1, attempts)
+		require.Less(t, time.Since(start), time.Second)
+	})
+}
@@ -27,6 +27,7 @@ type retryOptions struct {
 	fastBackoff backoff.Backoff
 	slowBackoff backoff.Backoff
 	budget      budget.Budget
+	policy      *Policy
 
 	panicCallback func(e interface{})
 }
@@ -307,6 +308,9 @@ func RetryWithResult[T any](ctx context.Context, //nolint:revive,funlen
 	if options.idempotent {
 		ctx = xcontext.WithIdempotent(ctx, options.idempotent)
 	}
+	if options.label != "" {
+		ctx = xcontext.WithRetryLabel(ctx, options.label)
+	}
 
 	defer func() {
 		if finalErr != nil && options.stackTrace {
@@ -320,7 +324,11 @@ func RetryWithResult[T any](ctx context.Context, //nolint:revive,funlen
 		i        int
 		attempts int
 
-		code   = int64(0)
+		code = int64(0)
+
+		// attempts, failed with status or code, for limit of attempts of retry policy rule
+		ruleAttempts = make(map[int64]int)
+
 		onDone = trace.RetryOnRetry(options.trace, &ctx,
 			options.call, options.label, options.idempotent, xcontext.IsNestedCall(ctx),
 		)
@@ -338,13 +346,14 @@ func RetryWithResult[T any](ctx context.Context, //nolint:revive,funlen
 			)
 
 		default:
+			attemptStart := time.Now()
 			v, err := opWithRecover(ctx, options, op)
 
 			if err == nil {
 				return v, nil
 			}
 
-			m := Check(err)
+			m := options.policy.apply(err, Check(err))
 
 			if m.StatusCode() != code {
 				i = 0
@@ -359,10 +368,28 @@ func RetryWithResult[T any](ctx context.Context, //nolint:revive,funlen
 				)
 			}
 
-			t := time.NewTimer(backoff.Delay(m.BackoffType(), i,
+			ruleAttempts[code]++
+			if m.MaxAttempts() > 0 && ruleAttempts[code] >= m.MaxAttempts() {
+				return zeroValue, xerrors.WithStackTrace(
+					fmt.Errorf("attempts limit %d of retry policy reached: %w", m.MaxAttempts(), err),
+				)
+			}
+
+			delay := backoff.Delay(m.BackoffType(), i,
 				backoff.WithFastBackoff(options.fastBackoff),
 				backoff.WithSlowBackoff(options.slowBackoff),
-			))
+			)
+
+			if !options.policy.enoughTime(ctx, delay, time.Since(attemptStart)) {
+				return zeroValue, xerrors.WithStackTrace(
+					xerrors.Join(
+						fmt.Errorf("attempt No.%d: %w", attempts, errNoTimeForNextAttempt),
+						err,
+					),
+				)
+			}
+
+			t := time.NewTimer(delay)
 
 			select {
 			case <-ctx.Done():
@@ -80,6 +80,11 @@ func DoWithResult[T any](ctx context.Context, db *sql.DB,
 		copy(options.retryOptions[1:], options.retryOptions)
 		options.retryOptions[0] = WithTrace(tracer.TraceRetry())
 	}
+	if d, has := db.Driver().(interface {
+		RetryPolicy() *Policy
+	}); has {
+		options.retryOptions = append([]Option{WithPolicy(d.RetryPolicy())}, options.retryOptions...)
+	}
 	for _, opt := range opts {
 		if opt != nil {
 			opt.ApplyDoOption(&options)
@@ -199,6 +204,11 @@ func DoTxWithResult[T any](ctx context.Context, db *sql.DB, //nolint:funlen
 		options.retryOptions[0] = WithTrace(d.TraceRetry())
 		options.retryOptions[1] = WithBudget(d.RetryBudget())
 	}
+	if d, has := db.Driver().(interface {
+		RetryPolicy() *Policy
+	}); has {
+		options.retryOptions = append([]Option{WithPolicy(d.RetryPolicy())}, options.retryOptions...)
+	}
 	for _, opt := range opts {
 		if opt != nil {
 			opt.ApplyDoTxOption(&options)
@@ -6,6 +6,7 @@ import (
 	"database/sql/driver"
 	"fmt"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/interceptors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/bind"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql"
@@ -129,6 +130,16 @@ func WithPositionalArgs() QueryBindConnectorOption {
 	return xsql.WithQueryBind(bind.PositionalArgs{})
 }
 
+// WithNamedArgs replaces named args such as `@name` and `:name` to YDB params `$name`
+func WithNamedArgs() QueryBindConnectorOption {
+	return xsql.WithQueryBind(bind.NamedArgs{})
+}
+
+// WithSliceArgs passes go slice args as YDB List params and rewrites `IN ($ids)` to `IN $ids`
+func WithSliceArgs() QueryBindConnectorOption {
+	return xsql.WithQueryBind(bind.SliceArgs{})
+}
+
 func WithNumericArgs() QueryBindConnectorOption {
 	return xsql.WithQueryBind(bind.NumericArgs{})
 }
@@ -152,6 +163,16 @@ func WithDatabaseSQLTrace(
 	return xsql.WithTrace(&t, opts...)
 }
 
+// WithDatabaseSQLInterceptors appends interceptors of data and scan queries of database/sql connector
+//
+// database/sql connector executes queries over table client sessions, so interceptors from
+// WithTableInterceptors also intercept queries of database/sql connector
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithDatabaseSQLInterceptors(list ...interceptors.Interceptor) ConnectorOption {
+	return xsql.WithInterceptors(list...)
+}
+
 func WithDisableServerBalancer() ConnectorOption {
 	return xsql.WithDisableServerBalancer()
 }
@@ -172,6 +193,7 @@ func Connector(parent *Driver, opts ...ConnectorOption) (SQLConnector, error) {
 			xsql.WithOnClose(d.detach),
 			xsql.WithTraceRetry(parent.config.TraceRetry()),
 			xsql.WithretryBudget(parent.config.RetryBudget()),
+			xsql.WithRetryPolicy(parent.config.RetryPolicy()),
 		)...,
 	)
 	if err != nil {
@@ -0,0 +1,20 @@
+package sugar
+
+import "github.com/ydb-platform/ydb-go-sdk/v3/internal/fingerprint"
+
+// NormalizeQuery returns compact form of YQL query without comments, literals and extra whitespace
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func NormalizeQuery(q string) string {
+	return fingerprint.Normalize(q)
+}
+
+// QueryFingerprint returns stable hash of normalized YQL query
+//
+// Fingerprint is a low-cardinality identity of query which suitable as label of metrics
+// or attribute of trace spans
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func QueryFingerprint(q string) string {
+	return fingerprint.Fingerprint(q)
+}
@@ -0,0 +1,393 @@
+package sugar
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/scanner"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	tableOptions "github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+)
+
+const (
+	defaultPageSize = 100
+
+	pagerLimitParam     = "$pagerLimit"
+	pagerKeyParamPrefix = "$pagerKey"
+)
+
+// ErrInvalidPageToken returned by Pager.Page if continuation token is malformed or
+// made by pager of other table, key or filter
+var ErrInvalidPageToken = errors.New("invalid page token")
+
+type (
+	pagerConfig struct {
+		pageSize    int
+		primaryKey  []string
+		filter      string
+		params      *params.Parameters
+		executeOpts []options.Execute
+	}
+	PagerOption func(c *pagerConfig)
+)
+
+// WithPagerPageSize sets count of rows in page (100 by default)
+func WithPagerPageSize(pageSize int) PagerOption {
+	return func(c *pagerConfig) {
+		if pageSize > 0 {
+			c.pageSize = pageSize
+		}
+	}
+}
+
+// WithPagerPrimaryKey sets key columns for paging instead of primary key of table from DescribeTable
+//
+// Key columns must identify rows uniquely.
+func WithPagerPrimaryKey(columns ...string) PagerOption {
+	return func(c *pagerConfig) {
+		c.primaryKey = columns
+	}
+}
+
+// WithPagerFilter sets YQL filter expression for rows with parameters of this expression
+//
+// Parameter names with prefixes $pagerKey and $pagerLimit are reserved by Pager.
+func WithPagerFilter(expr string, params *params.Parameters) PagerOption {
+	return func(c *pagerConfig) {
+		c.filter = expr
+		c.params = params
+	}
+}
+
+// WithPagerExecuteOptions appends options of query execution (snapshot read-only transaction by default)
+func WithPagerExecuteOptions(opts ...options.Execute) PagerOption {
+	return func(c *pagerConfig) {
+		c.executeOpts = append(c.executeOpts, opts...)
+	}
+}
+
+// Pager reads table with keyset pagination: each page selects rows with key lexicographically greater than
+// the last key of previous page. The last key is passed between pages as opaque URL-safe continuation token.
+//
+// Key columns must not contain NULL values: rows with NULL in key columns are skipped.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type Pager[T any] struct {
+	c           query.Client
+	cfg         *pagerConfig
+	tablePath   string
+	columns     []string
+	keyTypes    []types.Type
+	fingerprint uint32
+	scanOpts    []scanner.ScanStructOption
+}
+
+// NewPager makes Pager for table with absolute path tablePath
+//
+// Columns of select are defined by fields of struct T (names are taken from `sql` tag or name of field).
+// Primary key of table detected with DescribeTable if WithPagerPrimaryKey option is not defined.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func NewPager[T any](ctx context.Context,
+	db interface {
+		Table() table.Client
+		Query() query.Client
+	},
+	tablePath string, opts ...PagerOption,
+) (*Pager[T], error) {
+	cfg := &pagerConfig{
+		pageSize: defaultPageSize,
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(cfg)
+		}
+	}
+
+	var desc tableOptions.Description
+	err := db.Table().Do(ctx, func(ctx context.Context, s table.Session) (err error) {
+		desc, err = s.DescribeTable(ctx, tablePath)
+
+		return err
+	}, table.WithIdempotent())
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	columns, err := structColumns(reflect.TypeOf((*T)(nil)).Elem())
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	p := &Pager[T]{
+		c:         db.Query(),
+		cfg:       cfg,
+		tablePath: tablePath,
+	}
+	if len(cfg.primaryKey) == 0 {
+		cfg.primaryKey = desc.PrimaryKey
+	}
+	if len(cfg.primaryKey) == 0 {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("table '%s' has no primary key", tablePath))
+	}
+
+	columnTypes := make(map[string]types.Type, len(desc.Columns))
+	for _, column := range desc.Columns {
+		columnTypes[column.Name] = column.Type
+	}
+	for _, key := range cfg.primaryKey {
+		t, has := columnTypes[key]
+		if !has {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("column '%s' not found in table '%s'", key, tablePath))
+		}
+		p.keyTypes = append(p.keyTypes, t)
+	}
+
+	p.columns = columns
+	for _, key := range cfg.primaryKey {
+		if !containsString(columns, key) {
+			// key columns required for continuation token
+			p.columns = append(p.columns, key)
+			p.scanOpts = []scanner.ScanStructOption{scanner.WithAllowMissingFieldsInStruct()}
+		}
+	}
+
+	p.fingerprint = crc32.ChecksumIEEE([]byte(
+		tablePath + "\x00" + strings.Join(cfg.primaryKey, ",") + "\x00" + cfg.filter,
+	))
+
+	return p, nil
+}
+
+func structColumns(t reflect.Type) ([]string, error) {
+	if t.Kind() != reflect.Struct {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("%s is not a struct", t.String()))
+	}
+	columns := make([]string, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() || f.Tag.Get("sql") == "-" {
+			continue
+		}
+		columns = append(columns, types.FieldName(f, "sql"))
+	}
+
+	return columns, nil
+}
+
+func containsString(ss []string, s string) bool {
+	for i := range ss {
+		if ss[i] == s {
+			return true
+		}
+	}
+
+	return false
+}
+
+func quoteIdentifier(name string) string {
+	return "`" + strings.ReplaceAll(name, "`", "\\`") + "`"
+}
+
+func pagerKeyParam(i int) string {
+	return pagerKeyParamPrefix + strconv.Itoa(i)
+}
+
+func pagerQuery(tablePath string, columns, key []string, filter, keyCondition string) string {
+	var b strings.Builder
+	b.WriteString("SELECT ")
+	for i, column := range columns {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		b.WriteString(quoteIdentifier(column))
+	}
+	b.WriteString("\nFROM ")
+	b.WriteString(quoteIdentifier(tablePath))
+	b.WriteString("\nWHERE ")
+	if filter != "" {
+		b.WriteString("(")
+		b.WriteString(filter)
+		b.WriteString(") AND ")
+	}
+	b.WriteString(keyCondition)
+	b.WriteString("\nORDER BY ")
+	for i, column := range key {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		b.WriteString(quoteIdentifier(column))
+	}
+	b.WriteString("\nLIMIT ")
+	b.WriteString(pagerLimitParam)
+	b.WriteString(";")
+
+	return b.String()
+}
+
+// keyCondition returns lexicographic condition `(k1, k2) > ($pagerKey0, $pagerKey1)`
+func keyCondition(key []string) string {
+	if len(key) == 1 {
+		return quoteIdentifier(key[0]) + " > " + pagerKeyParam(0)
+	}
+	columns := make([]string, len(key))
+	placeholders := make([]string, len(key))
+	for i := range key {
+		columns[i] = quoteIdentifier(key[i])
+		placeholders[i] = pagerKeyParam(i)
+	}
+
+	return "(" + strings.Join(columns, ", ") + ") > (" + strings.Join(placeholders, ", ") + ")"
+}
+
+func (p *Pager[T]) query(lastKey []value.Value) (string, *params.Parameters) {
+	parameters := params.Parameters{
+		params.Named(pagerLimitParam, value.Uint64Value(uint64(p.cfg.pageSize+1))),
+	}
+	if p.cfg.params != nil {
+		parameters = append(parameters, *p.cfg.params...)
+	}
+	condition := "true"
+	if lastKey != nil {
+		condition = keyCondition(p.cfg.primaryKey)
+		for i := range lastKey {
+			parameters = append(parameters, params.Named(pagerKeyParam(i), lastKey[i]))
+		}
+	}
+
+	return pagerQuery(p.tablePath, p.columns, p.cfg.primaryKey, p.cfg.filter, condition), &parameters
+}
+
+// Page returns page of rows after continuation token and continuation token of next page
+//
+// Empty token means first page. Empty next token means the last page.
+func (p *Pager[T]) Page(ctx context.Context, token string) (rows []*T, next string, _ error) {
+	var lastKey []value.Value
+	if token != "" {
+		var err error
+		lastKey, err = p.decodeToken(token)
+		if err != nil {
+			return nil, "", xerrors.WithStackTrace(err)
+		}
+	}
+
+	yql, parameters := p.query(lastKey)
+	opts := append([]options.Execute{
+		query.WithTxControl(query.SnapshotReadOnlyTxControl()),
+		query.WithParameters(parameters),
+	}, p.cfg.executeOpts...)
+
+	rs, err := p.c.QueryResultSet(ctx, yql, opts...)
+	if err != nil {
+		return nil, "", xerrors.WithStackTrace(err)
+	}
+
+	rows = make([]*T, 0, p.cfg.pageSize)
+	for {
+		row, err := rs.NextRow(ctx)
+		if err != nil {
+			if xerrors.Is(err, io.EOF) {
+				return rows, "", nil
+			}
+
+			return nil, "", xerrors.WithStackTrace(err)
+		}
+		if len(rows) == p.cfg.pageSize {
+			// extra row exists, so next page is not empty
+			break
+		}
+		var v T
+		if err = row.ScanStruct(&v, p.scanOpts...); err != nil {
+			return nil, "", xerrors.WithStackTrace(err)
+		}
+		rows = append(rows, &v)
+		lastKey = make([]value.Value, len(p.cfg.primaryKey))
+		dst := make([]scanner.NamedDestination, len(p.cfg.primaryKey))
+		for i, name := range p.cfg.primaryKey {
+			dst[i] = scanner.NamedRef(name, &lastKey[i])
+		}
+		if err = row.ScanNamed(dst...); err != nil {
+			return nil, "", xerrors.WithStackTrace(err)
+		}
+	}
+
+	next, err = p.encodeToken(lastKey)
+	if err != nil {
+		return nil, "", xerrors.WithStackTrace(err)
+	}
+
+	return rows, next, nil
+}
+
+type pageToken struct {
+	Fingerprint uint32    `json:"f"`
+	Key         []*string `json:"k"`
+}
+
+func (p *Pager[T]) encodeToken(key []value.Value) (string, error) {
+	t := pageToken{
+		Fingerprint: p.fingerprint,
+		Key:         make([]*string, len(key)),
+	}
+	for i := range key {
+		s, null, err := value.ToText(key[i])
+		if err != nil {
+			return "", xerrors.WithStackTrace(err)
+		}
+		if !null {
+			t.Key[i] = &s
+		}
+	}
+	data, err := json.Marshal(t)
+	if err != nil {
+		return "", xerrors.WithStackTrace(err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func (p *Pager[T]) decodeToken(token string) ([]value.Value, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("%w: %v", ErrInvalidPageToken, err))
+	}
+	var t pageToken
+	if err = json.Unmarshal(data, &t); err != nil {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("%w: %v", ErrInvalidPageToken, err))
+	}
+	if t.Fingerprint != p.fingerprint || len(t.Key) != len(p.keyTypes) {
+		return nil, xerrors.WithStackTrace(ErrInvalidPageToken)
+	}
+	key := make([]value.Value, len(t.Key))
+	for i := range t.Key {
+		if t.Key[i] == nil {
+			optional, isOptional := p.keyTypes[i].(types.Optional)
+			if !isOptional {
+				return nil, xerrors.WithStackTrace(ErrInvalidPageToken)
+			}
+			key[i] = value.NullValue(optional.InnerType())
+
+			continue
+		}
+		key[i], err = value.FromText(p.keyTypes[i], *t.Key[i])
+		if err != nil {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("%w: %v", ErrInvalidPageToken, err))
+		}
+	}
+
+	return key, nil
+}
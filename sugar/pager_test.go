@@ -0,0 +1,111 @@
+package sugar
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+)
+
+type testPagerRow struct {
+	City    string `sql:"city"`
+	Number  uint32 `sql:"number"`
+	Address string
+	skipped int    //nolint:unused
+	Ignored string `sql:"-"`
+}
+
+func testPager(filter string) *Pager[testPagerRow] {
+	return &Pager[testPagerRow]{
+		cfg: &pagerConfig{
+			pageSize:   10,
+			primaryKey: []string{"city", "number"},
+			filter:     filter,
+			params: &params.Parameters{
+				params.Named("$minNumber", value.Uint32Value(3)),
+			},
+		},
+		tablePath:   "/local/schools",
+		columns:     []string{"city", "number", "Address"},
+		keyTypes:    []types.Type{types.NewOptional(types.Text), types.Uint32},
+		fingerprint: 42,
+	}
+}
+
+func TestStructColumns(t *testing.T) {
+	columns, err := structColumns(reflect.TypeOf(testPagerRow{}))
+	require.NoError(t, err)
+	require.Equal(t, []string{"city", "number", "Address"}, columns)
+
+	_, err = structColumns(reflect.TypeOf(""))
+	require.Error(t, err)
+}
+
+func TestPagerQuery(t *testing.T) {
+	p := testPager("`number` >= $minNumber")
+
+	yql, parameters := p.query(nil)
+	require.Equal(t, "SELECT `city`, `number`, `Address`\n"+
+		"FROM `/local/schools`\n"+
+		"WHERE (`number` >= $minNumber) AND true\n"+
+		"ORDER BY `city`, `number`\n"+
+		"LIMIT $pagerLimit;", yql)
+	require.Equal(t, `{"$pagerLimit":11ul,"$minNumber":3u}`, parameters.String())
+
+	yql, parameters = p.query([]value.Value{
+		value.OptionalValue(value.TextValue("Moscow")),
+		value.Uint32Value(7),
+	})
+	require.Equal(t, "SELECT `city`, `number`, `Address`\n"+
+		"FROM `/local/schools`\n"+
+		"WHERE (`number` >= $minNumber) AND (`city`, `number`) > ($pagerKey0, $pagerKey1)\n"+
+		"ORDER BY `city`, `number`\n"+
+		"LIMIT $pagerLimit;", yql)
+	require.Equal(t, 4, parameters.Count())
+
+	require.Equal(t, "`id` > $pagerKey0", keyCondition([]string{"id"}))
+}
+
+func TestPageToken(t *testing.T) {
+	p := testPager("")
+	key := []value.Value{
+		value.OptionalValue(value.TextValue("Санкт-Петербург/?&")),
+		value.Uint32Value(7),
+	}
+	token, err := p.encodeToken(key)
+	require.NoError(t, err)
+	require.NotContains(t, token, "=")
+	require.NotContains(t, token, "/")
+	require.NotContains(t, token, "+")
+
+	decoded, err := p.decodeToken(token)
+	require.NoError(t, err)
+	require.Len(t, decoded, 2)
+	for i := range key {
+		require.Equal(t, key[i].Yql(), decoded[i].Yql())
+	}
+
+	t.Run("Null", func(t *testing.T) {
+		token, err := p.encodeToken([]value.Value{value.NullValue(types.Text), value.Uint32Value(1)})
+		require.NoError(t, err)
+		decoded, err := p.decodeToken(token)
+		require.NoError(t, err)
+		require.Equal(t, value.NullValue(types.Text).Yql(), decoded[0].Yql())
+	})
+	t.Run("OtherPager", func(t *testing.T) {
+		other := testPager("")
+		other.fingerprint = 43
+		_, err := other.decodeToken(token)
+		require.ErrorIs(t, err, ErrInvalidPageToken)
+	})
+	t.Run("Malformed", func(t *testing.T) {
+		for _, token := range []string{"!!!", "e30", "eyJmIjo0MiwiayI6WyJhIiwiYiJdfQ"} {
+			_, err := p.decodeToken(token)
+			require.ErrorIs(t, err, ErrInvalidPageToken, token)
+		}
+	})
+}
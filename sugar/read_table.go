@@ -0,0 +1,329 @@
+package sugar
+
+import (
+	"context"
+	"fmt"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/result/indexed"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+const defaultParallelReadBufferRows = 1000
+
+// TableRow is a row of table which delivered to handler of ParallelReadTable
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type TableRow struct {
+	keyRange int
+	columns  []string
+	values   []value.Value
+}
+
+// KeyRange returns index of partition key range of row
+func (r TableRow) KeyRange() int {
+	return r.keyRange
+}
+
+// Columns returns names of row columns
+func (r TableRow) Columns() []string {
+	return r.columns
+}
+
+// Values returns values of row columns in order of Columns
+func (r TableRow) Values() []types.Value {
+	return r.values
+}
+
+// Scan casts values of row columns into destinations (in order of Columns)
+func (r TableRow) Scan(dst ...interface{}) error {
+	if len(dst) != len(r.values) {
+		return xerrors.WithStackTrace(
+			fmt.Errorf("count of destinations (%d) not equal to count of columns (%d)", len(dst), len(r.values)),
+		)
+	}
+	for i := range dst {
+		if err := value.CastTo(r.values[i], dst[i]); err != nil {
+			return xerrors.WithStackTrace(fmt.Errorf("column '%s': %w", r.columns[i], err))
+		}
+	}
+
+	return nil
+}
+
+type (
+	parallelReadTableConfig struct {
+		columns          []string
+		ordered          bool
+		keyRangeSnapshot bool
+		bufferRows       int
+	}
+	ParallelReadTableOption func(c *parallelReadTableConfig)
+)
+
+// WithParallelReadColumns sets projection of columns for ParallelReadTable (all columns by default)
+func WithParallelReadColumns(columns ...string) ParallelReadTableOption {
+	return func(c *parallelReadTableConfig) {
+		c.columns = append(c.columns, columns...)
+	}
+}
+
+// WithParallelReadOrdered makes ParallelReadTable deliver rows to handler sequentially in primary key order
+//
+// Rows of key ranges which read ahead are buffered (see WithParallelReadBufferRows).
+func WithParallelReadOrdered() ParallelReadTableOption {
+	return func(c *parallelReadTableConfig) {
+		c.ordered = true
+	}
+}
+
+// WithParallelReadKeyRangeSnapshot makes ParallelReadTable read each key range attempt from server snapshot
+//
+// Consistency is per key range only: snapshot is taken by server on start of reading of each key range,
+// so rows of different key ranges may be read from different snapshots. Retry of key range reads rest of
+// key range from new snapshot. Consistent snapshot of whole table is not provided by ParallelReadTable.
+func WithParallelReadKeyRangeSnapshot() ParallelReadTableOption {
+	return func(c *parallelReadTableConfig) {
+		c.keyRangeSnapshot = true
+	}
+}
+
+// WithParallelReadBufferRows sets count of buffered rows per key range in ordered mode (1000 by default)
+func WithParallelReadBufferRows(rows int) ParallelReadTableOption {
+	return func(c *parallelReadTableConfig) {
+		if rows > 0 {
+			c.bufferRows = rows
+		}
+	}
+}
+
+// ParallelReadTable reads whole table concurrently by partition key ranges with given count of workers
+// and delivers rows into handler.
+//
+// Each key range reads with retries. If reading of key range failed, the retry resumes reading after
+// the last delivered primary key, so handler receives each row only once.
+//
+// By default, rows delivered without any order and handler calls concurrently from workers.
+// With WithParallelReadOrdered option handler calls sequentially with rows in primary key order.
+//
+// If handler returns error, ParallelReadTable stops reading and returns this error.
+//
+// ParallelReadTable does not read whole table from single snapshot: key ranges are read independently,
+// so concurrent writes may be observed by some key ranges and not observed by others.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func ParallelReadTable(ctx context.Context, c table.Client, path string, workers int,
+	handler func(ctx context.Context, row TableRow) error, opts ...ParallelReadTableOption,
+) error {
+	cfg := &parallelReadTableConfig{
+		bufferRows: defaultParallelReadBufferRows,
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(cfg)
+		}
+	}
+	if workers < 1 {
+		workers = 1
+	}
+
+	var desc options.Description
+	err := c.Do(ctx, func(ctx context.Context, s table.Session) (err error) {
+		desc, err = s.DescribeTable(ctx, path, options.WithShardKeyBounds())
+
+		return err
+	}, table.WithIdempotent())
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	r, err := newParallelReader(c, path, &desc, cfg)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	g, gctx := errgroup.WithContext(ctx)
+
+	var ranges []chan TableRow
+	if cfg.ordered {
+		ranges = make([]chan TableRow, len(desc.KeyRanges))
+		for i := range ranges {
+			ranges[i] = make(chan TableRow, cfg.bufferRows)
+		}
+		g.Go(func() error {
+			return deliverOrdered(gctx, ranges, handler)
+		})
+	}
+
+	g.Go(func() error {
+		rg, rctx := errgroup.WithContext(gctx)
+		rg.SetLimit(workers)
+		for i := range desc.KeyRanges {
+			i := i
+			deliver := func(ctx context.Context, row TableRow) error {
+				return handler(ctx, row)
+			}
+			if ranges != nil {
+				deliver = func(ctx context.Context, row TableRow) error {
+					select {
+					case <-ctx.Done():
+						return ctx.Err()
+					case ranges[i] <- row:
+						return nil
+					}
+				}
+			}
+			rg.Go(func() error {
+				if err := r.readKeyRange(rctx, i, desc.KeyRanges[i], deliver); err != nil {
+					return xerrors.WithStackTrace(err)
+				}
+				if ranges != nil {
+					close(ranges[i])
+				}
+
+				return nil
+			})
+		}
+
+		return rg.Wait()
+	})
+
+	return xerrors.WithStackTrace(g.Wait())
+}
+
+func deliverOrdered(ctx context.Context, ranges []chan TableRow,
+	handler func(ctx context.Context, row TableRow) error,
+) error {
+	for i := range ranges {
+	rows:
+		for {
+			select {
+			case <-ctx.Done():
+				return xerrors.WithStackTrace(ctx.Err())
+			case row, ok := <-ranges[i]:
+				if !ok {
+					break rows
+				}
+				if err := handler(ctx, row); err != nil {
+					return xerrors.WithStackTrace(err)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+type parallelReader struct {
+	c           table.Client
+	path        string
+	cfg         *parallelReadTableConfig
+	columns     []string
+	readColumns []string
+	keyIndexes  []int
+}
+
+func newParallelReader(c table.Client, path string, desc *options.Description, cfg *parallelReadTableConfig) (
+	*parallelReader, error,
+) {
+	r := &parallelReader{
+		c:    c,
+		path: path,
+		cfg:  cfg,
+	}
+	if len(cfg.columns) > 0 {
+		r.readColumns = append(r.readColumns, cfg.columns...)
+	} else {
+		for _, column := range desc.Columns {
+			r.readColumns = append(r.readColumns, column.Name)
+		}
+	}
+	projected := len(r.readColumns)
+	indexes := make(map[string]int, len(r.readColumns))
+	for i, name := range r.readColumns {
+		indexes[name] = i
+	}
+	for _, key := range desc.PrimaryKey {
+		idx, has := indexes[key]
+		if !has {
+			// primary key columns required for resume of reading
+			idx = len(r.readColumns)
+			r.readColumns = append(r.readColumns, key)
+		}
+		r.keyIndexes = append(r.keyIndexes, idx)
+	}
+	if len(r.keyIndexes) == 0 {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("table '%s' has no primary key", path))
+	}
+	r.columns = r.readColumns[:projected]
+
+	return r, nil
+}
+
+func (r *parallelReader) readOptions(kr options.KeyRange, lastKey value.Value) []options.ReadTableOption {
+	opts := []options.ReadTableOption{
+		options.ReadColumns(r.readColumns...),
+		options.ReadOrdered(),
+	}
+	if r.cfg.keyRangeSnapshot {
+		opts = append(opts, options.ReadFromSnapshot(true))
+	}
+	switch {
+	case lastKey != nil:
+		opts = append(opts, options.ReadGreater(lastKey))
+	case kr.From != nil:
+		opts = append(opts, options.ReadGreaterOrEqual(kr.From))
+	}
+	if kr.To != nil {
+		opts = append(opts, options.ReadLess(kr.To))
+	}
+
+	return opts
+}
+
+func (r *parallelReader) readKeyRange(ctx context.Context, idx int, kr options.KeyRange,
+	deliver func(ctx context.Context, row TableRow) error,
+) error {
+	var lastKey value.Value
+
+	return r.c.Do(ctx, func(ctx context.Context, s table.Session) error {
+		res, err := s.StreamReadTable(ctx, r.path, r.readOptions(kr, lastKey)...)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			_ = res.Close()
+		}()
+		for res.NextResultSet(ctx) {
+			for res.NextRow() {
+				values := make([]value.Value, len(r.readColumns))
+				dst := make([]indexed.RequiredOrOptional, len(values))
+				for i := range values {
+					dst[i] = &values[i]
+				}
+				if err = res.Scan(dst...); err != nil {
+					return err
+				}
+				if err = deliver(ctx, TableRow{
+					keyRange: idx,
+					columns:  r.columns,
+					values:   values[:len(r.columns)],
+				}); err != nil {
+					return err
+				}
+				key := make([]value.Value, len(r.keyIndexes))
+				for i, keyIdx := range r.keyIndexes {
+					key[i] = values[keyIdx]
+				}
+				lastKey = value.TupleValue(key...)
+			}
+		}
+
+		return res.Err()
+	}, table.WithIdempotent())
+}
@@ -0,0 +1,150 @@
+package sugar
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+)
+
+func testTableDescription() *options.Description {
+	return &options.Description{
+		Columns: []options.Column{
+			{Name: "a", Type: types.NewOptional(types.Uint64)},
+			{Name: "b", Type: types.NewOptional(types.Text)},
+			{Name: "c", Type: types.NewOptional(types.Text)},
+		},
+		PrimaryKey: []string{"a", "b"},
+	}
+}
+
+func TestParallelReaderColumns(t *testing.T) {
+	t.Run("All", func(t *testing.T) {
+		r, err := newParallelReader(nil, "/local/t", testTableDescription(), &parallelReadTableConfig{})
+		require.NoError(t, err)
+		require.Equal(t, []string{"a", "b", "c"}, r.columns)
+		require.Equal(t, []string{"a", "b", "c"}, r.readColumns)
+		require.Equal(t, []int{0, 1}, r.keyIndexes)
+	})
+	t.Run("Projection", func(t *testing.T) {
+		r, err := newParallelReader(nil, "/local/t", testTableDescription(), &parallelReadTableConfig{
+			columns: []string{"c", "b"},
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"c", "b"}, r.columns)
+		require.Equal(t, []string{"c", "b", "a"}, r.readColumns)
+		require.Equal(t, []int{2, 1}, r.keyIndexes)
+	})
+	t.Run("NoPrimaryKey", func(t *testing.T) {
+		_, err := newParallelReader(nil, "/local/t", &options.Description{}, &parallelReadTableConfig{})
+		require.Error(t, err)
+	})
+}
+
+func TestParallelReaderReadOptions(t *testing.T) {
+	a := allocator.New()
+	defer a.Free()
+
+	r, err := newParallelReader(nil, "/local/t", testTableDescription(), &parallelReadTableConfig{keyRangeSnapshot: true})
+	require.NoError(t, err)
+
+	apply := func(opts []options.ReadTableOption) *Ydb_Table.ReadTableRequest {
+		req := &Ydb_Table.ReadTableRequest{}
+		for _, opt := range opts {
+			opt.ApplyReadTableOption((*options.ReadTableDesc)(req), a)
+		}
+
+		return req
+	}
+
+	kr := options.KeyRange{
+		From: value.TupleValue(value.OptionalValue(value.Uint64Value(10))),
+		To:   value.TupleValue(value.OptionalValue(value.Uint64Value(20))),
+	}
+	req := apply(r.readOptions(kr, nil))
+	require.True(t, req.GetOrdered())
+	require.Equal(t, []string{"a", "b", "c"}, req.GetColumns())
+	require.NotNil(t, req.GetKeyRange().GetGreaterOrEqual())
+	require.NotNil(t, req.GetKeyRange().GetLess())
+	require.Equal(t, options.FeatureEnabled.ToYDB(), req.GetUseSnapshot())
+
+	lastKey := value.TupleValue(
+		value.OptionalValue(value.Uint64Value(15)),
+		value.OptionalValue(value.TextValue("x")),
+	)
+	req = apply(r.readOptions(kr, lastKey))
+	require.Nil(t, req.GetKeyRange().GetGreaterOrEqual())
+	require.Len(t, req.GetKeyRange().GetGreater().GetValue().GetItems(), 2)
+	require.NotNil(t, req.GetKeyRange().GetLess())
+
+	req = apply(r.readOptions(options.KeyRange{}, nil))
+	require.Nil(t, req.GetKeyRange())
+}
+
+func TestDeliverOrdered(t *testing.T) {
+	ranges := make([]chan TableRow, 3)
+	for i := range ranges {
+		ranges[i] = make(chan TableRow, 2)
+	}
+	// ranges filled in reverse order
+	for i := len(ranges) - 1; i >= 0; i-- {
+		ranges[i] <- TableRow{keyRange: i, values: []value.Value{value.Uint64Value(uint64(i * 2))}}
+		ranges[i] <- TableRow{keyRange: i, values: []value.Value{value.Uint64Value(uint64(i*2 + 1))}}
+		close(ranges[i])
+	}
+	var delivered []uint64
+	err := deliverOrdered(context.Background(), ranges, func(ctx context.Context, row TableRow) error {
+		var v uint64
+		if err := row.Scan(&v); err != nil {
+			return err
+		}
+		delivered = append(delivered, v)
+
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{0, 1, 2, 3, 4, 5}, delivered)
+
+	t.Run("HandlerError", func(t *testing.T) {
+		errTest := errors.New("test")
+		ranges := []chan TableRow{make(chan TableRow, 1)}
+		ranges[0] <- TableRow{}
+		err := deliverOrdered(context.Background(), ranges, func(ctx context.Context, row TableRow) error {
+			return errTest
+		})
+		require.ErrorIs(t, err, errTest)
+	})
+	t.Run("Canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		err := deliverOrdered(ctx, []chan TableRow{make(chan TableRow)}, func(ctx context.Context, row TableRow) error {
+			return nil
+		})
+		require.ErrorIs(t, err, context.Canceled)
+	})
+}
+
+func TestTableRowScan(t *testing.T) {
+	row := TableRow{
+		columns: []string{"a", "b"},
+		values: []value.Value{
+			value.OptionalValue(value.Uint64Value(1)),
+			value.NullValue(types.Text),
+		},
+	}
+	var (
+		a uint64
+		b *string
+	)
+	require.NoError(t, row.Scan(&a, &b))
+	require.Equal(t, uint64(1), a)
+	require.Nil(t, b)
+	require.Error(t, row.Scan(&a))
+}
@@ -0,0 +1,243 @@
+// Package bulk provides streaming loaders of pre-serialized data into tables with BulkUpsert.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+package bulk
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"io"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+)
+
+const (
+	defaultChunkBytes  = 8 << 20
+	defaultConcurrency = 4
+)
+
+type (
+	csvConfig struct {
+		chunkBytes  int
+		concurrency int
+		skipRows    int
+		header      bool
+		delimiter   string
+		nullValue   *string
+		onChunk     func(rows, bytes int)
+	}
+	CSVOption func(c *csvConfig)
+)
+
+// WithChunkBytes sets max size of data in single BulkUpsert request (8MB by default)
+//
+// Single CSV record larger than chunk size is uploaded in own request.
+func WithChunkBytes(chunkBytes int) CSVOption {
+	return func(c *csvConfig) {
+		if chunkBytes > 0 {
+			c.chunkBytes = chunkBytes
+		}
+	}
+}
+
+// WithConcurrency sets count of concurrently uploaded chunks
+func WithConcurrency(concurrency int) CSVOption {
+	return func(c *csvConfig) {
+		if concurrency > 0 {
+			c.concurrency = concurrency
+		}
+	}
+}
+
+// WithSkipRows sets count of rows to skip at the beginning of data (before the header)
+func WithSkipRows(skipRows int) CSVOption {
+	return func(c *csvConfig) {
+		c.skipRows = skipRows
+	}
+}
+
+// WithHeader marks first row (after skipped rows) as header with column names
+//
+// Header sends with each chunk.
+func WithHeader() CSVOption {
+	return func(c *csvConfig) {
+		c.header = true
+	}
+}
+
+// WithDelimiter sets fields delimiter (comma by default)
+func WithDelimiter(delimiter string) CSVOption {
+	return func(c *csvConfig) {
+		c.delimiter = delimiter
+	}
+}
+
+// WithNullValue sets text representation of NULL values
+func WithNullValue(nullValue string) CSVOption {
+	return func(c *csvConfig) {
+		c.nullValue = &nullValue
+	}
+}
+
+// WithOnChunk sets callback which calls after successful upload of each chunk
+func WithOnChunk(onChunk func(rows, bytes int)) CSVOption {
+	return func(c *csvConfig) {
+		c.onChunk = onChunk
+	}
+}
+
+func (c *csvConfig) formatOptions() []options.CSVFormatOption {
+	var opts []options.CSVFormatOption
+	if c.header {
+		opts = append(opts, options.WithCSVHeader())
+	}
+	if c.delimiter != "" {
+		opts = append(opts, options.WithCSVDelimiter(c.delimiter))
+	}
+	if c.nullValue != nil {
+		opts = append(opts, options.WithCSVNullValue(*c.nullValue))
+	}
+
+	return opts
+}
+
+// UpsertCSV reads CSV data from r, splits it by records into chunks of limited size
+// and uploads chunks concurrently with BulkUpsert.
+//
+// Each chunk uploads with retries. On first failed chunk UpsertCSV stops reading and returns error,
+// so part of data may be already uploaded. BulkUpsert is idempotent, so whole UpsertCSV can be repeated.
+func UpsertCSV(ctx context.Context, c table.Client, tablePath string, r io.Reader, opts ...CSVOption) error {
+	cfg := &csvConfig{
+		chunkBytes:  defaultChunkBytes,
+		concurrency: defaultConcurrency,
+		onChunk:     func(rows, bytes int) {},
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(cfg)
+		}
+	}
+
+	var (
+		records   = newCSVRecordReader(r)
+		header    []byte
+		formatOps = cfg.formatOptions()
+	)
+	for i := 0; i < cfg.skipRows; i++ {
+		if _, err := records.next(); err != nil {
+			return xerrors.WithStackTrace(noEOF(err))
+		}
+	}
+	if cfg.header {
+		var err error
+		header, err = records.next()
+		if err != nil {
+			return xerrors.WithStackTrace(noEOF(err))
+		}
+	}
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(cfg.concurrency)
+
+	upload := func(chunk []byte, rows int) {
+		g.Go(func() error {
+			err := c.Do(gctx, func(ctx context.Context, s table.Session) error {
+				return s.BulkUpsert(ctx, tablePath, nil, options.WithCSVData(chunk, formatOps...))
+			}, table.WithIdempotent())
+			if err != nil {
+				return xerrors.WithStackTrace(err)
+			}
+			cfg.onChunk(rows, len(chunk)-len(header))
+
+			return nil
+		})
+	}
+
+	var (
+		chunk = append(make([]byte, 0, cfg.chunkBytes), header...)
+		rows  = 0
+	)
+	for gctx.Err() == nil {
+		record, err := records.next()
+		if err != nil && !errors.Is(err, io.EOF) {
+			_ = g.Wait()
+
+			return xerrors.WithStackTrace(err)
+		}
+		if rows > 0 && (len(chunk)+len(record) > cfg.chunkBytes || errors.Is(err, io.EOF)) {
+			upload(chunk, rows)
+			chunk = append(make([]byte, 0, cfg.chunkBytes), header...)
+			rows = 0
+		}
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		chunk = append(chunk, record...)
+		rows++
+	}
+
+	if err := g.Wait(); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return xerrors.WithStackTrace(ctx.Err())
+}
+
+func noEOF(err error) error {
+	if errors.Is(err, io.EOF) {
+		return io.ErrUnexpectedEOF
+	}
+
+	return err
+}
+
+// csvRecordReader splits CSV stream into raw records (with line terminator)
+// with respect to quoted fields which may contain line breaks
+type csvRecordReader struct {
+	r *bufio.Reader
+}
+
+func newCSVRecordReader(r io.Reader) *csvRecordReader {
+	return &csvRecordReader{
+		r: bufio.NewReader(r),
+	}
+}
+
+// next returns next raw record or io.EOF if no more records
+func (r *csvRecordReader) next() ([]byte, error) {
+	var (
+		record   []byte
+		inQuotes bool
+	)
+	for {
+		line, err := r.r.ReadSlice('\n')
+		if err != nil && !errors.Is(err, bufio.ErrBufferFull) && !errors.Is(err, io.EOF) {
+			return nil, xerrors.WithStackTrace(err)
+		}
+		record = append(record, line...)
+		if bytes.Count(line, []byte{'"'})%2 == 1 {
+			inQuotes = !inQuotes
+		}
+		switch {
+		case errors.Is(err, bufio.ErrBufferFull):
+			continue
+		case errors.Is(err, io.EOF):
+			if len(record) == 0 {
+				return nil, io.EOF
+			}
+			if record[len(record)-1] != '\n' {
+				record = append(record, '\n')
+			}
+
+			return record, nil
+		case !inQuotes:
+			return record, nil
+		}
+	}
+}
@@ -0,0 +1,142 @@
+package bulk
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+)
+
+type testSession struct {
+	table.Session
+
+	mu       sync.Mutex
+	requests []*Ydb_Table.BulkUpsertRequest
+	fail     error
+}
+
+func (s *testSession) BulkUpsert(ctx context.Context, tablePath string, rows value.Value,
+	opts ...options.BulkUpsertOption,
+) error {
+	if s.fail != nil {
+		return s.fail
+	}
+	req := &Ydb_Table.BulkUpsertRequest{Table: tablePath}
+	for _, opt := range opts {
+		if formatOpt, has := opt.(options.BulkUpsertFormatOption); has {
+			formatOpt.ApplyBulkUpsertFormatOption((*options.BulkUpsertDesc)(req))
+		}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests = append(s.requests, req)
+
+	return nil
+}
+
+type testClient struct {
+	table.Client
+
+	s *testSession
+}
+
+func (c *testClient) Do(ctx context.Context, op table.Operation, opts ...table.Option) error {
+	return op(ctx, c.s)
+}
+
+func (s *testSession) chunks() []string {
+	chunks := make([]string, 0, len(s.requests))
+	for _, req := range s.requests {
+		chunks = append(chunks, string(req.GetData()))
+	}
+	sort.Strings(chunks)
+
+	return chunks
+}
+
+func TestCSVRecordReader(t *testing.T) {
+	r := newCSVRecordReader(strings.NewReader("a,b\n1,\"multi\nline\"\n2,\"x\"\"y\"\n3,last"))
+	var records []string
+	for {
+		record, err := r.next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+		records = append(records, string(record))
+	}
+	require.Equal(t, []string{
+		"a,b\n",
+		"1,\"multi\nline\"\n",
+		"2,\"x\"\"y\"\n",
+		"3,last\n",
+	}, records)
+}
+
+func TestUpsertCSV(t *testing.T) {
+	t.Run("Chunks", func(t *testing.T) {
+		s := &testSession{}
+		var rows, size int
+		err := UpsertCSV(context.Background(), &testClient{s: s}, "/local/t",
+			strings.NewReader("# comment\nid,name\n1,a\n2,b\n3,c\n"),
+			WithSkipRows(1),
+			WithHeader(),
+			WithChunkBytes(len("id,name\n1,a\n2,b\n")),
+			WithNullValue("NULL"),
+			WithOnChunk(func(r, b int) {
+				s.mu.Lock()
+				defer s.mu.Unlock()
+				rows += r
+				size += b
+			}),
+		)
+		require.NoError(t, err)
+		require.Equal(t, []string{"id,name\n1,a\n2,b\n", "id,name\n3,c\n"}, s.chunks())
+		require.Equal(t, 3, rows)
+		require.Equal(t, len("1,a\n2,b\n3,c\n"), size)
+		for _, req := range s.requests {
+			require.Equal(t, "/local/t", req.GetTable())
+			require.True(t, req.GetCsvSettings().GetHeader())
+			require.Equal(t, []byte("NULL"), req.GetCsvSettings().GetNullValue())
+		}
+	})
+	t.Run("LargeRecord", func(t *testing.T) {
+		s := &testSession{}
+		large := strings.Repeat("x", 100)
+		err := UpsertCSV(context.Background(), &testClient{s: s}, "/local/t",
+			strings.NewReader("1,a\n2,"+large+"\n3,c"),
+			WithChunkBytes(10),
+		)
+		require.NoError(t, err)
+		require.Equal(t, []string{"1,a\n", "2," + large + "\n", "3,c\n"}, s.chunks())
+	})
+	t.Run("Empty", func(t *testing.T) {
+		s := &testSession{}
+		require.NoError(t, UpsertCSV(context.Background(), &testClient{s: s}, "/local/t", &bytes.Buffer{}))
+		require.Empty(t, s.requests)
+	})
+	t.Run("NoHeader", func(t *testing.T) {
+		err := UpsertCSV(context.Background(), &testClient{s: &testSession{}}, "/local/t", &bytes.Buffer{},
+			WithHeader(),
+		)
+		require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	})
+	t.Run("Error", func(t *testing.T) {
+		errTest := errors.New("test")
+		err := UpsertCSV(context.Background(), &testClient{s: &testSession{fail: errTest}}, "/local/t",
+			strings.NewReader("1,a\n2,b\n"),
+		)
+		require.ErrorIs(t, err, errTest)
+	})
+}
@@ -0,0 +1,351 @@
+// Package dump provides logical dump of tables to local files and restore of tables from such dumps.
+//
+// Dump walks the directory tree, writes schema of each row table into schema.json file and table rows into
+// chunk files in JSONL or CSV format. Partitions of table are read concurrently with StreamReadTable by key ranges.
+// Restore recreates tables from schema files and loads rows with concurrent chunked BulkUpsert.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+package dump
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/scheme"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/result/indexed"
+)
+
+const (
+	sysDirectory = ".sys"
+
+	defaultConcurrency = 4
+	defaultChunkRows   = 100000
+	defaultBatchRows   = 1000
+	defaultCSVNull     = `\N`
+)
+
+type db interface {
+	Name() string
+	Scheme() scheme.Client
+	Table() table.Client
+}
+
+type (
+	config struct {
+		format       Format
+		concurrency  int
+		chunkRows    int
+		batchRows    int
+		csvNullValue string
+		onTable      func(path string)
+	}
+	Option func(c *config)
+)
+
+// WithFormat sets format of data files (FormatJSONL by default)
+func WithFormat(format Format) Option {
+	return func(c *config) {
+		c.format = format
+	}
+}
+
+// WithConcurrency sets count of concurrently read key ranges on dump and
+// concurrently uploaded chunks on restore
+func WithConcurrency(concurrency int) Option {
+	return func(c *config) {
+		if concurrency > 0 {
+			c.concurrency = concurrency
+		}
+	}
+}
+
+// WithChunkRows sets max count of rows in single data file on dump
+func WithChunkRows(rows int) Option {
+	return func(c *config) {
+		if rows > 0 {
+			c.chunkRows = rows
+		}
+	}
+}
+
+// WithBatchRows sets count of rows in single BulkUpsert request on restore
+func WithBatchRows(rows int) Option {
+	return func(c *config) {
+		if rows > 0 {
+			c.batchRows = rows
+		}
+	}
+}
+
+// WithCSVNullValue sets text representation of NULL values in CSV files (`\N` by default)
+func WithCSVNullValue(nullValue string) Option {
+	return func(c *config) {
+		c.csvNullValue = nullValue
+	}
+}
+
+// WithOnTable sets callback which calls before dump or restore of each table
+func WithOnTable(onTable func(path string)) Option {
+	return func(c *config) {
+		c.onTable = onTable
+	}
+}
+
+func newConfig(opts ...Option) *config {
+	c := &config{
+		format:       FormatJSONL,
+		concurrency:  defaultConcurrency,
+		chunkRows:    defaultChunkRows,
+		batchRows:    defaultBatchRows,
+		csvNullValue: defaultCSVNull,
+		onTable:      func(string) {},
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(c)
+		}
+	}
+
+	return c
+}
+
+func fileExt(format Format) string {
+	return "." + string(format)
+}
+
+// Dump writes schemas and data of all row tables from database path src (relative to database root)
+// into local directory dst. Each table is written into own subdirectory of dst with schema.json file and
+// data chunk files.
+func Dump(ctx context.Context, db db, src, dst string, opts ...Option) error {
+	cfg := newConfig(opts...)
+	if cfg.format != FormatJSONL && cfg.format != FormatCSV {
+		return xerrors.WithStackTrace(fmt.Errorf("%w: '%s'", errUnknownFormat, cfg.format))
+	}
+
+	root := path.Join(db.Name(), src)
+	entry, err := db.Scheme().DescribePath(ctx, root)
+	if err != nil {
+		return xerrors.WithStackTrace(fmt.Errorf("cannot describe path '%s': %w", root, err))
+	}
+
+	if entry.IsTable() {
+		return dumpTable(ctx, db.Table(), root, entry.Name, dst, cfg)
+	}
+
+	return dumpDirectory(ctx, db, root, "", dst, cfg)
+}
+
+func dumpDirectory(ctx context.Context, db db, root, rel, dst string, cfg *config) error {
+	dir, err := db.Scheme().ListDirectory(ctx, path.Join(root, rel))
+	if err != nil {
+		return xerrors.WithStackTrace(fmt.Errorf("cannot list directory '%s': %w", path.Join(root, rel), err))
+	}
+	for i := range dir.Children {
+		child := &dir.Children[i]
+		childRel := path.Join(rel, child.Name)
+		switch {
+		case child.IsDirectory():
+			if child.Name == sysDirectory || strings.HasPrefix(child.Name, ".") {
+				continue
+			}
+			if err = dumpDirectory(ctx, db, root, childRel, dst, cfg); err != nil {
+				return xerrors.WithStackTrace(err)
+			}
+		case child.IsTable():
+			if err = dumpTable(ctx, db.Table(), path.Join(root, childRel), childRel, dst, cfg); err != nil {
+				return xerrors.WithStackTrace(err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func dumpTable(ctx context.Context, c table.Client, tablePath, rel, dst string, cfg *config) error {
+	cfg.onTable(tablePath)
+
+	var desc options.Description
+	err := c.Do(ctx, func(ctx context.Context, s table.Session) (err error) {
+		desc, err = s.DescribeTable(ctx, tablePath, options.WithShardKeyBounds())
+
+		return err
+	}, table.WithIdempotent())
+	if err != nil {
+		return xerrors.WithStackTrace(fmt.Errorf("cannot describe table '%s': %w", tablePath, err))
+	}
+
+	ts, err := newTableSchema(rel, &desc, cfg.format)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	dir := filepath.Join(dst, filepath.FromSlash(rel))
+	if err = os.MkdirAll(dir, 0o755); err != nil { //nolint:gomnd
+		return xerrors.WithStackTrace(err)
+	}
+
+	var (
+		mu      sync.Mutex
+		chunks  = make([][]string, len(desc.KeyRanges))
+		g, gctx = errgroup.WithContext(ctx)
+	)
+	g.SetLimit(cfg.concurrency)
+	for i := range desc.KeyRanges {
+		i := i
+		g.Go(func() error {
+			files, err := dumpKeyRange(gctx, c, tablePath, dir, i, desc.KeyRanges[i], ts.columnNames(), cfg)
+			if err != nil {
+				return xerrors.WithStackTrace(err)
+			}
+			mu.Lock()
+			chunks[i] = files
+			mu.Unlock()
+
+			return nil
+		})
+	}
+	if err = g.Wait(); err != nil {
+		return xerrors.WithStackTrace(fmt.Errorf("cannot dump table '%s': %w", tablePath, err))
+	}
+	for _, files := range chunks {
+		ts.Chunks = append(ts.Chunks, files...)
+	}
+
+	data, err := json.MarshalIndent(ts, "", "  ")
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return xerrors.WithStackTrace(os.WriteFile(filepath.Join(dir, schemaFileName), data, 0o644)) //nolint:gomnd
+}
+
+// chunkFiles writes rows of one key range into sequence of chunk files
+type chunkFiles struct {
+	dir     string
+	prefix  string
+	columns []string
+	cfg     *config
+
+	names  []string
+	file   *os.File
+	writer rowWriter
+	rows   int
+}
+
+func (c *chunkFiles) write(values []value.Value) error {
+	if c.writer == nil || c.rows >= c.cfg.chunkRows {
+		if err := c.close(); err != nil {
+			return err
+		}
+		name := fmt.Sprintf("%s_%05d%s", c.prefix, len(c.names), fileExt(c.cfg.format))
+		f, err := os.Create(filepath.Join(c.dir, name))
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		c.names = append(c.names, name)
+		c.file = f
+		c.rows = 0
+		c.writer, err = newRowWriter(f, c.cfg.format, c.columns, c.cfg.csvNullValue)
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+	}
+	c.rows++
+
+	return c.writer.WriteRow(values)
+}
+
+func (c *chunkFiles) close() error {
+	if c.file == nil {
+		return nil
+	}
+	defer func() {
+		c.file = nil
+		c.writer = nil
+	}()
+	if err := c.writer.Flush(); err != nil {
+		_ = c.file.Close()
+
+		return xerrors.WithStackTrace(err)
+	}
+
+	return xerrors.WithStackTrace(c.file.Close())
+}
+
+// reset removes all written files (for restart reading of key range on retry)
+func (c *chunkFiles) reset() error {
+	if err := c.close(); err != nil {
+		return err
+	}
+	for _, name := range c.names {
+		if err := os.Remove(filepath.Join(c.dir, name)); err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+	}
+	c.names = c.names[:0]
+
+	return nil
+}
+
+func dumpKeyRange(ctx context.Context, c table.Client, tablePath, dir string, idx int, kr options.KeyRange,
+	columns []string, cfg *config,
+) ([]string, error) {
+	files := &chunkFiles{
+		dir:     dir,
+		prefix:  fmt.Sprintf("data_%05d", idx),
+		columns: columns,
+		cfg:     cfg,
+	}
+	err := c.Do(ctx, func(ctx context.Context, s table.Session) error {
+		if err := files.reset(); err != nil {
+			return err
+		}
+		res, err := s.StreamReadTable(ctx, tablePath,
+			options.ReadKeyRange(kr),
+			options.ReadColumns(columns...),
+		)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			_ = res.Close()
+		}()
+		values := make([]value.Value, len(columns))
+		dst := make([]indexed.RequiredOrOptional, len(columns))
+		for i := range values {
+			dst[i] = &values[i]
+		}
+		for res.NextResultSet(ctx) {
+			for res.NextRow() {
+				if err = res.Scan(dst...); err != nil {
+					return err
+				}
+				if err = files.write(values); err != nil {
+					return err
+				}
+			}
+		}
+
+		return res.Err()
+	}, table.WithIdempotent())
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	if err = files.close(); err != nil {
+		return nil, err
+	}
+
+	return files.names, nil
+}
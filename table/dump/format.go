@@ -0,0 +1,382 @@
+package dump
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/schema"
+)
+
+// Format is a format of data files
+type Format string
+
+const (
+	// FormatJSONL writes each row as JSON object on separate line
+	FormatJSONL = Format("jsonl")
+
+	// FormatCSV writes rows as CSV records with header
+	FormatCSV = Format("csv")
+)
+
+const schemaFileName = "schema.json"
+
+var (
+	errUnknownFormat  = errors.New("unknown format")
+	errColumnsMissing = errors.New("columns missing in row")
+)
+
+type (
+	column struct {
+		Name   string          `json:"name"`
+		Yql    string          `json:"yql"`
+		Type   json.RawMessage `json:"type"`
+		Family string          `json:"family,omitempty"`
+	}
+	tableSchema struct {
+		Path           string                      `json:"path"`
+		Columns        []column                    `json:"columns"`
+		PrimaryKey     []string                    `json:"primary_key"`
+		ColumnFamilies []options.ColumnFamily      `json:"column_families,omitempty"`
+		Indexes        []schema.Index              `json:"indexes,omitempty"`
+		TimeToLive     *options.TimeToLiveSettings `json:"ttl,omitempty"`
+		Attributes     map[string]string           `json:"attributes,omitempty"`
+		Format         Format                      `json:"format"`
+		Chunks         []string                    `json:"chunks"`
+	}
+)
+
+func newTableSchema(path string, desc *options.Description, format Format) (*tableSchema, error) {
+	a := allocator.New()
+	defer a.Free()
+
+	t := schema.FromDescription(desc)
+	s := &tableSchema{
+		Path:           path,
+		Columns:        make([]column, 0, len(t.Columns)),
+		PrimaryKey:     t.PrimaryKey,
+		ColumnFamilies: t.ColumnFamilies,
+		Indexes:        t.Indexes,
+		TimeToLive:     t.TimeToLive,
+		Attributes:     t.Attributes,
+		Format:         format,
+	}
+	for _, c := range t.Columns {
+		typ, err := protojson.Marshal(types.TypeToYDB(c.Type, a))
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+		s.Columns = append(s.Columns, column{
+			Name:   c.Name,
+			Yql:    c.Type.Yql(),
+			Type:   typ,
+			Family: c.Family,
+		})
+	}
+
+	return s, nil
+}
+
+func (s *tableSchema) columnNames() []string {
+	names := make([]string, len(s.Columns))
+	for i := range s.Columns {
+		names[i] = s.Columns[i].Name
+	}
+
+	return names
+}
+
+func (s *tableSchema) columnTypes() ([]types.Type, error) {
+	tt := make([]types.Type, len(s.Columns))
+	for i := range s.Columns {
+		var t Ydb.Type
+		if err := protojson.Unmarshal(s.Columns[i].Type, &t); err != nil {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("column '%s': %w", s.Columns[i].Name, err))
+		}
+		tt[i] = types.TypeFromYDB(&t)
+	}
+
+	return tt, nil
+}
+
+func (s *tableSchema) table() (schema.Table, error) {
+	tt, err := s.columnTypes()
+	if err != nil {
+		return schema.Table{}, xerrors.WithStackTrace(err)
+	}
+	t := schema.Table{
+		Columns:        make([]options.Column, len(s.Columns)),
+		PrimaryKey:     s.PrimaryKey,
+		ColumnFamilies: s.ColumnFamilies,
+		Indexes:        s.Indexes,
+		TimeToLive:     s.TimeToLive,
+		Attributes:     s.Attributes,
+	}
+	for i := range s.Columns {
+		t.Columns[i] = options.Column{
+			Name:   s.Columns[i].Name,
+			Type:   tt[i],
+			Family: s.Columns[i].Family,
+		}
+	}
+
+	return t, nil
+}
+
+type rowWriter interface {
+	WriteRow(values []value.Value) error
+	Flush() error
+}
+
+type rowReader interface {
+	// ReadRow returns io.EOF if no more rows
+	ReadRow() ([]value.Value, error)
+}
+
+func newRowWriter(w io.Writer, format Format, columns []string, csvNullValue string) (rowWriter, error) {
+	switch format {
+	case FormatJSONL:
+		return &jsonlWriter{
+			w:       bufio.NewWriter(w),
+			columns: columns,
+		}, nil
+	case FormatCSV:
+		cw := csv.NewWriter(w)
+		if err := cw.Write(columns); err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		return &csvWriter{
+			w:         cw,
+			nullValue: csvNullValue,
+			record:    make([]string, len(columns)),
+		}, nil
+	default:
+		return nil, xerrors.WithStackTrace(fmt.Errorf("%w: '%s'", errUnknownFormat, format))
+	}
+}
+
+func newRowReader(r io.Reader, format Format, columns []string, columnTypes []types.Type, csvNullValue string) (
+	rowReader, error,
+) {
+	switch format {
+	case FormatJSONL:
+		d := json.NewDecoder(r)
+		d.UseNumber()
+
+		return &jsonlReader{
+			d:       d,
+			columns: columns,
+			types:   columnTypes,
+		}, nil
+	case FormatCSV:
+		cr := csv.NewReader(r)
+		header, err := cr.Read()
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+		indexes := make(map[string]int, len(header))
+		for i, name := range header {
+			indexes[name] = i
+		}
+		order := make([]int, len(columns))
+		for i, name := range columns {
+			idx, has := indexes[name]
+			if !has {
+				return nil, xerrors.WithStackTrace(fmt.Errorf("%w: '%s'", errColumnsMissing, name))
+			}
+			order[i] = idx
+		}
+
+		return &csvReader{
+			r:         cr,
+			order:     order,
+			types:     columnTypes,
+			nullValue: csvNullValue,
+		}, nil
+	default:
+		return nil, xerrors.WithStackTrace(fmt.Errorf("%w: '%s'", errUnknownFormat, format))
+	}
+}
+
+type jsonlWriter struct {
+	w       *bufio.Writer
+	columns []string
+}
+
+func (w *jsonlWriter) WriteRow(values []value.Value) error {
+	row := make(map[string]interface{}, len(values))
+	for i, v := range values {
+		text, null, err := value.ToText(v)
+		if err != nil {
+			return xerrors.WithStackTrace(fmt.Errorf("column '%s': %w", w.columns[i], err))
+		}
+		switch {
+		case null:
+			row[w.columns[i]] = nil
+		case isJSONLiteral(v.Type(), text):
+			row[w.columns[i]] = json.RawMessage(text)
+		default:
+			row[w.columns[i]] = text
+		}
+	}
+	data, err := json.Marshal(row)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	if _, err = w.w.Write(data); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return xerrors.WithStackTrace(w.w.WriteByte('\n'))
+}
+
+func (w *jsonlWriter) Flush() error {
+	return xerrors.WithStackTrace(w.w.Flush())
+}
+
+// isJSONLiteral returns true if text representation of value with type t is valid JSON number or boolean
+func isJSONLiteral(t types.Type, text string) bool {
+	if o, ok := t.(types.Optional); ok {
+		t = o.InnerType()
+	}
+	switch t {
+	case types.Bool, types.Int8, types.Int16, types.Int32, types.Int64,
+		types.Uint8, types.Uint16, types.Uint32, types.Uint64, types.Interval:
+		return true
+	case types.Float, types.Double:
+		f, err := strconv.ParseFloat(text, 64)
+
+		return err == nil && !math.IsInf(f, 0) && !math.IsNaN(f)
+	default:
+		return false
+	}
+}
+
+type jsonlReader struct {
+	d       *json.Decoder
+	columns []string
+	types   []types.Type
+}
+
+func (r *jsonlReader) ReadRow() ([]value.Value, error) {
+	var row map[string]interface{}
+	if err := r.d.Decode(&row); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, io.EOF
+		}
+
+		return nil, xerrors.WithStackTrace(err)
+	}
+	values := make([]value.Value, len(r.columns))
+	for i, name := range r.columns {
+		raw, has := row[name]
+		if !has {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("%w: '%s'", errColumnsMissing, name))
+		}
+		var (
+			v   value.Value
+			err error
+		)
+		switch x := raw.(type) {
+		case nil:
+			v, err = nullValue(r.types[i])
+		case json.Number:
+			v, err = value.FromText(r.types[i], x.String())
+		case bool:
+			v, err = value.FromText(r.types[i], strconv.FormatBool(x))
+		case string:
+			v, err = value.FromText(r.types[i], x)
+		default:
+			err = fmt.Errorf("unexpected JSON value %v", x)
+		}
+		if err != nil {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("column '%s': %w", name, err))
+		}
+		values[i] = v
+	}
+
+	return values, nil
+}
+
+type csvWriter struct {
+	w         *csv.Writer
+	nullValue string
+	record    []string
+}
+
+func (w *csvWriter) WriteRow(values []value.Value) error {
+	for i, v := range values {
+		text, null, err := value.ToText(v)
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		if null {
+			text = w.nullValue
+		}
+		w.record[i] = text
+	}
+
+	return xerrors.WithStackTrace(w.w.Write(w.record))
+}
+
+func (w *csvWriter) Flush() error {
+	w.w.Flush()
+
+	return xerrors.WithStackTrace(w.w.Error())
+}
+
+type csvReader struct {
+	r         *csv.Reader
+	order     []int
+	types     []types.Type
+	nullValue string
+}
+
+func (r *csvReader) ReadRow() ([]value.Value, error) {
+	record, err := r.r.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, io.EOF
+		}
+
+		return nil, xerrors.WithStackTrace(err)
+	}
+	values := make([]value.Value, len(r.order))
+	for i, idx := range r.order {
+		var v value.Value
+		if _, isOptional := r.types[i].(types.Optional); isOptional && record[idx] == r.nullValue {
+			v, err = nullValue(r.types[i])
+		} else {
+			v, err = value.FromText(r.types[i], record[idx])
+		}
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+		values[i] = v
+	}
+
+	return values, nil
+}
+
+func nullValue(t types.Type) (value.Value, error) {
+	o, ok := t.(types.Optional)
+	if !ok {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("null value for not optional type %s", t.Yql()))
+	}
+
+	return value.NullValue(o.InnerType()), nil
+}
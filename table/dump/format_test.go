@@ -0,0 +1,152 @@
+package dump
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+)
+
+func testDescription() *options.Description {
+	ttl := options.NewTTLSettings().ColumnDateType("created_at").ExpireAfter(time.Hour)
+
+	return &options.Description{
+		Name: "users",
+		Columns: []options.Column{
+			{Name: "id", Type: types.NewOptional(types.Uint64)},
+			{Name: "name", Type: types.NewOptional(types.Text)},
+			{Name: "avatar", Type: types.NewOptional(types.Bytes)},
+			{Name: "score", Type: types.NewOptional(types.Double)},
+			{Name: "created_at", Type: types.NewOptional(types.Timestamp)},
+			{Name: "active", Type: types.Bool},
+		},
+		PrimaryKey: []string{"id"},
+		Indexes: []options.IndexDescription{
+			{Name: "name_idx", IndexColumns: []string{"name"}},
+		},
+		TimeToLiveSettings: &ttl,
+		Attributes: map[string]string{
+			"owner": "alice",
+		},
+	}
+}
+
+func testRows() [][]value.Value {
+	ts := time.Date(2024, 1, 2, 3, 4, 5, 6000, time.UTC)
+
+	return [][]value.Value{
+		{
+			value.OptionalValue(value.Uint64Value(1)),
+			value.OptionalValue(value.TextValue("Alice, \"the\" first")),
+			value.OptionalValue(value.BytesValue([]byte{0xff, 0})),
+			value.OptionalValue(value.DoubleValue(1.5)),
+			value.OptionalValue(value.TimestampValueFromTime(ts)),
+			value.BoolValue(true),
+		},
+		{
+			value.OptionalValue(value.Uint64Value(18446744073709551615)),
+			value.NullValue(types.Text),
+			value.NullValue(types.Bytes),
+			value.OptionalValue(value.DoubleValue(0)),
+			value.NullValue(types.Timestamp),
+			value.BoolValue(false),
+		},
+	}
+}
+
+func TestSchemaRoundTrip(t *testing.T) {
+	ts, err := newTableSchema("dir/users", testDescription(), FormatJSONL)
+	require.NoError(t, err)
+	ts.Chunks = []string{"data_00000_00000.jsonl"}
+
+	data, err := json.Marshal(ts)
+	require.NoError(t, err)
+
+	var restored tableSchema
+	require.NoError(t, json.Unmarshal(data, &restored))
+	require.Equal(t, []string{"id", "name", "avatar", "score", "created_at", "active"}, restored.columnNames())
+	require.Equal(t, "Optional<Uint64>", restored.Columns[0].Yql)
+
+	tbl, err := restored.table()
+	require.NoError(t, err)
+	require.Equal(t, testDescription().Columns, tbl.Columns)
+	require.Equal(t, []string{"id"}, tbl.PrimaryKey)
+	require.Equal(t, "name_idx", tbl.Indexes[0].Name)
+	require.Equal(t, "created_at", tbl.TimeToLive.ColumnName)
+	require.Equal(t, map[string]string{"owner": "alice"}, tbl.Attributes)
+}
+
+func TestRowsRoundTrip(t *testing.T) {
+	ts, err := newTableSchema("users", testDescription(), FormatJSONL)
+	require.NoError(t, err)
+	columnTypes, err := ts.columnTypes()
+	require.NoError(t, err)
+
+	for _, format := range []Format{FormatJSONL, FormatCSV} {
+		t.Run(string(format), func(t *testing.T) {
+			var buf bytes.Buffer
+			w, err := newRowWriter(&buf, format, ts.columnNames(), defaultCSVNull)
+			require.NoError(t, err)
+			for _, row := range testRows() {
+				require.NoError(t, w.WriteRow(row))
+			}
+			require.NoError(t, w.Flush())
+
+			r, err := newRowReader(&buf, format, ts.columnNames(), columnTypes, defaultCSVNull)
+			require.NoError(t, err)
+			for _, expected := range testRows() {
+				row, err := r.ReadRow()
+				require.NoError(t, err)
+				require.Len(t, row, len(expected))
+				for i := range expected {
+					require.Equal(t, expected[i].Yql(), row[i].Yql())
+				}
+			}
+			_, err = r.ReadRow()
+			require.True(t, errors.Is(err, io.EOF))
+		})
+	}
+}
+
+func TestJSONLNumbers(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := newRowWriter(&buf, FormatJSONL, []string{"id", "name", "active"}, "")
+	require.NoError(t, err)
+	require.NoError(t, w.WriteRow([]value.Value{
+		value.OptionalValue(value.Uint64Value(42)),
+		value.NullValue(types.Text),
+		value.BoolValue(true),
+	}))
+	require.NoError(t, w.Flush())
+	require.Equal(t, `{"active":true,"id":42,"name":null}`+"\n", buf.String())
+}
+
+func TestReaderErrors(t *testing.T) {
+	columnTypes := []types.Type{types.Uint64}
+	t.Run("MissingColumn", func(t *testing.T) {
+		r, err := newRowReader(bytes.NewBufferString(`{"other":1}`), FormatJSONL, []string{"id"}, columnTypes, "")
+		require.NoError(t, err)
+		_, err = r.ReadRow()
+		require.ErrorIs(t, err, errColumnsMissing)
+		_, err = newRowReader(bytes.NewBufferString("other\n1\n"), FormatCSV, []string{"id"}, columnTypes, "")
+		require.ErrorIs(t, err, errColumnsMissing)
+	})
+	t.Run("NullForRequired", func(t *testing.T) {
+		r, err := newRowReader(bytes.NewBufferString(`{"id":null}`), FormatJSONL, []string{"id"}, columnTypes, "")
+		require.NoError(t, err)
+		_, err = r.ReadRow()
+		require.Error(t, err)
+	})
+	t.Run("UnknownFormat", func(t *testing.T) {
+		_, err := newRowWriter(&bytes.Buffer{}, Format("xml"), []string{"id"}, "")
+		require.ErrorIs(t, err, errUnknownFormat)
+	})
+}
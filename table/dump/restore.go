@@ -0,0 +1,163 @@
+package dump
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/schema"
+)
+
+// Restore recreates tables from local directory src (made with Dump) inside database path dst
+// (relative to database root) and loads rows of tables.
+//
+// Existing tables are altered to schema from dump with non-destructive steps only.
+func Restore(ctx context.Context, db db, src, dst string, opts ...Option) error {
+	cfg := newConfig(opts...)
+
+	var schemaFiles []string
+	err := filepath.WalkDir(src, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() && d.Name() == schemaFileName {
+			schemaFiles = append(schemaFiles, p)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	sort.Strings(schemaFiles)
+
+	for _, schemaFile := range schemaFiles {
+		if err = restoreTable(ctx, db, schemaFile, path.Join(db.Name(), dst), cfg); err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+	}
+
+	return nil
+}
+
+func readTableSchema(schemaFile string) (*tableSchema, error) {
+	data, err := os.ReadFile(schemaFile)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	var ts tableSchema
+	if err = json.Unmarshal(data, &ts); err != nil {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("cannot parse '%s': %w", schemaFile, err))
+	}
+
+	return &ts, nil
+}
+
+func restoreTable(ctx context.Context, db db, schemaFile, root string, cfg *config) error {
+	ts, err := readTableSchema(schemaFile)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	desired, err := ts.table()
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	tablePath := path.Join(root, ts.Path)
+	cfg.onTable(tablePath)
+
+	if dir := path.Dir(tablePath); dir != db.Name() {
+		if err = db.Scheme().MakeDirectory(ctx, dir); err != nil {
+			return xerrors.WithStackTrace(fmt.Errorf("cannot make directory '%s': %w", dir, err))
+		}
+	}
+
+	if _, err = schema.Migrate(ctx, db.Table(), tablePath, desired); err != nil {
+		return xerrors.WithStackTrace(fmt.Errorf("cannot create table '%s': %w", tablePath, err))
+	}
+
+	columnTypes, err := ts.columnTypes()
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(cfg.concurrency)
+	dir := filepath.Dir(schemaFile)
+	for _, chunk := range ts.Chunks {
+		chunk := chunk
+		g.Go(func() error {
+			return restoreChunk(gctx, db.Table(), tablePath, filepath.Join(dir, chunk),
+				ts.Format, ts.columnNames(), columnTypes, cfg,
+			)
+		})
+	}
+	if err = g.Wait(); err != nil {
+		return xerrors.WithStackTrace(fmt.Errorf("cannot restore table '%s': %w", tablePath, err))
+	}
+
+	return nil
+}
+
+func restoreChunk(ctx context.Context, c table.Client, tablePath, chunkFile string, format Format,
+	columns []string, columnTypes []types.Type, cfg *config,
+) error {
+	f, err := os.Open(chunkFile)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	defer f.Close()
+
+	r, err := newRowReader(f, format, columns, columnTypes, cfg.csvNullValue)
+	if err != nil {
+		return xerrors.WithStackTrace(fmt.Errorf("chunk '%s': %w", chunkFile, err))
+	}
+
+	batch := make([]value.Value, 0, cfg.batchRows)
+	for {
+		values, err := r.ReadRow()
+		if err != nil && !errors.Is(err, io.EOF) {
+			return xerrors.WithStackTrace(fmt.Errorf("chunk '%s': %w", chunkFile, err))
+		}
+		if values != nil {
+			batch = append(batch, rowValue(columns, values))
+		}
+		if len(batch) == cfg.batchRows || (errors.Is(err, io.EOF) && len(batch) > 0) {
+			rows := value.ListValue(batch...)
+			if err := c.Do(ctx, func(ctx context.Context, s table.Session) error {
+				return s.BulkUpsert(ctx, tablePath, rows)
+			}, table.WithIdempotent()); err != nil {
+				return xerrors.WithStackTrace(fmt.Errorf("chunk '%s': %w", chunkFile, err))
+			}
+			batch = make([]value.Value, 0, cfg.batchRows)
+		}
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+	}
+}
+
+func rowValue(columns []string, values []value.Value) value.Value {
+	fields := make([]value.StructValueField, len(columns))
+	for i := range columns {
+		fields[i] = value.StructValueField{
+			Name: columns[i],
+			V:    values[i],
+		}
+	}
+
+	return value.StructValue(fields...)
+}
@@ -2,6 +2,7 @@ package options
 
 import (
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Formats"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
 	"google.golang.org/grpc"
 
@@ -758,6 +759,39 @@ func WithAlterColumnFamilies(cf ...ColumnFamily) AlterTableOption {
 	return columnFamilies(cf)
 }
 
+type alterColumnFamiliesSettings []ColumnFamily
+
+func (cf alterColumnFamiliesSettings) ApplyAlterTableOption(d *AlterTableDesc, a *allocator.Allocator) {
+	for i := range cf {
+		d.AlterColumnFamilies = append(d.AlterColumnFamilies, cf[i].toYDB())
+	}
+}
+
+// WithAlterColumnFamiliesSettings changes settings of existing column families in AlterTable request
+func WithAlterColumnFamiliesSettings(cf ...ColumnFamily) AlterTableOption {
+	return alterColumnFamiliesSettings(cf)
+}
+
+type alterColumnFamily struct {
+	column string
+	family string
+}
+
+func (c alterColumnFamily) ApplyAlterTableOption(d *AlterTableDesc, a *allocator.Allocator) {
+	d.AlterColumns = append(d.AlterColumns, &Ydb_Table.ColumnMeta{
+		Name:   c.column,
+		Family: c.family,
+	})
+}
+
+// WithAlterColumnFamily moves column to another column family in AlterTable request
+func WithAlterColumnFamily(column, family string) AlterTableOption {
+	return alterColumnFamily{
+		column: column,
+		family: family,
+	}
+}
+
 func WithAlterReadReplicasSettings(rr ReadReplicasSettings) AlterTableOption {
 	return readReplicasSettings(rr)
 }
@@ -977,11 +1011,113 @@ func WithCollectStatsModeBasic() ExecuteDataQueryOption {
 }
 
 type (
+	BulkUpsertDesc   Ydb_Table.BulkUpsertRequest
 	BulkUpsertOption interface {
 		ApplyBulkUpsertOption() []grpc.CallOption
 	}
+
+	// BulkUpsertFormatOption defines pre-serialized data of BulkUpsert request instead of rows value
+	BulkUpsertFormatOption interface {
+		BulkUpsertOption
+
+		ApplyBulkUpsertFormatOption(d *BulkUpsertDesc)
+	}
+)
+
+type (
+	csvSettings     Ydb_Formats.CsvSettings
+	CSVFormatOption func(s *csvSettings)
 )
 
+// WithCSVDelimiter sets fields delimiter of CSV data (comma by default)
+func WithCSVDelimiter(delimiter string) CSVFormatOption {
+	return func(s *csvSettings) {
+		s.Delimiter = []byte(delimiter)
+	}
+}
+
+// WithCSVNullValue sets text representation of NULL values in CSV data
+func WithCSVNullValue(nullValue string) CSVFormatOption {
+	return func(s *csvSettings) {
+		s.NullValue = []byte(nullValue)
+	}
+}
+
+// WithCSVHeader marks first row of CSV data as header with column names
+func WithCSVHeader() CSVFormatOption {
+	return func(s *csvSettings) {
+		s.Header = true
+	}
+}
+
+// WithCSVSkipRows sets count of rows to skip before the header or data
+func WithCSVSkipRows(skipRows uint32) CSVFormatOption {
+	return func(s *csvSettings) {
+		s.SkipRows = skipRows
+	}
+}
+
+type bulkUpsertCSV struct {
+	data []byte
+	opts []CSVFormatOption
+}
+
+func (bulkUpsertCSV) ApplyBulkUpsertOption() []grpc.CallOption {
+	return nil
+}
+
+func (csv bulkUpsertCSV) ApplyBulkUpsertFormatOption(d *BulkUpsertDesc) {
+	settings := &csvSettings{}
+	for _, opt := range csv.opts {
+		if opt != nil {
+			opt(settings)
+		}
+	}
+	d.Data = csv.data
+	d.DataFormat = &Ydb_Table.BulkUpsertRequest_CsvSettings{
+		CsvSettings: (*Ydb_Formats.CsvSettings)(settings),
+	}
+}
+
+// WithCSVData defines CSV data for BulkUpsert request
+//
+// Rows argument of BulkUpsert must be nil if WithCSVData option defined.
+func WithCSVData(data []byte, opts ...CSVFormatOption) BulkUpsertFormatOption {
+	return bulkUpsertCSV{
+		data: data,
+		opts: opts,
+	}
+}
+
+type bulkUpsertArrow struct {
+	schema []byte
+	data   []byte
+}
+
+func (bulkUpsertArrow) ApplyBulkUpsertOption() []grpc.CallOption {
+	return nil
+}
+
+func (arrow bulkUpsertArrow) ApplyBulkUpsertFormatOption(d *BulkUpsertDesc) {
+	d.Data = arrow.data
+	d.DataFormat = &Ydb_Table.BulkUpsertRequest_ArrowBatchSettings{
+		ArrowBatchSettings: &Ydb_Formats.ArrowBatchSettings{
+			Schema: arrow.schema,
+		},
+	}
+}
+
+// WithArrowData defines Apache Arrow data for BulkUpsert request:
+// schema is a serialized Arrow schema message, data is a serialized Arrow record batch message
+//
+// Rows argument of BulkUpsert must be nil if WithArrowData option defined.
+func WithArrowData(schema, data []byte) BulkUpsertFormatOption {
+	return bulkUpsertArrow{
+		schema: schema,
+		data:   data,
+	}
+}
+
 type (
 	ExecuteScanQueryDesc   Ydb_Table.ExecuteScanQueryRequest
 	ExecuteScanQueryOption interface {
@@ -252,3 +252,28 @@ func TestAlterTableOptions(t *testing.T) {
 		}
 	}
 }
+
+func TestBulkUpsertFormatOptions(t *testing.T) {
+	t.Run("CSV", func(t *testing.T) {
+		req := Ydb_Table.BulkUpsertRequest{}
+		WithCSVData([]byte("id;name\n1;a\n"),
+			WithCSVDelimiter(";"),
+			WithCSVNullValue("NULL"),
+			WithCSVHeader(),
+			WithCSVSkipRows(2),
+		).ApplyBulkUpsertFormatOption((*BulkUpsertDesc)(&req))
+		require.Equal(t, []byte("id;name\n1;a\n"), req.GetData())
+		require.Equal(t, []byte(";"), req.GetCsvSettings().GetDelimiter())
+		require.Equal(t, []byte("NULL"), req.GetCsvSettings().GetNullValue())
+		require.True(t, req.GetCsvSettings().GetHeader())
+		require.Equal(t, uint32(2), req.GetCsvSettings().GetSkipRows())
+		require.Nil(t, req.GetArrowBatchSettings())
+	})
+	t.Run("Arrow", func(t *testing.T) {
+		req := Ydb_Table.BulkUpsertRequest{}
+		WithArrowData([]byte("schema"), []byte("batch")).ApplyBulkUpsertFormatOption((*BulkUpsertDesc)(&req))
+		require.Equal(t, []byte("batch"), req.GetData())
+		require.Equal(t, []byte("schema"), req.GetArrowBatchSettings().GetSchema())
+		require.Nil(t, req.GetCsvSettings())
+	})
+}
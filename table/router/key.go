@@ -0,0 +1,109 @@
+package router
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+var errIncomparable = errors.New("incomparable key values")
+
+type (
+	kind uint8
+	// scalar is an ordered representation of primitive key column value
+	scalar struct {
+		kind kind
+		i    int64
+		u    uint64
+		f    float64
+		s    string
+	}
+	// key is a list of key columns values. Missing trailing values of key are less
+	// than any value, so key (1) is less than key (1, NULL)
+	key []scalar
+)
+
+const (
+	kindNull kind = iota
+	kindBool
+	kindInt
+	kindUint
+	kindFloat
+	kindBytes
+	kindInt128
+)
+
+func newScalar(v *Ydb.Value) scalar {
+	for v.GetNestedValue() != nil {
+		v = v.GetNestedValue()
+	}
+
+	switch x := v.GetValue().(type) {
+	case *Ydb.Value_BoolValue:
+		if x.BoolValue {
+			return scalar{kind: kindBool, u: 1}
+		}
+
+		return scalar{kind: kindBool}
+	case *Ydb.Value_Int32Value:
+		return scalar{kind: kindInt, i: int64(x.Int32Value)}
+	case *Ydb.Value_Int64Value:
+		return scalar{kind: kindInt, i: x.Int64Value}
+	case *Ydb.Value_Uint32Value:
+		return scalar{kind: kindUint, u: uint64(x.Uint32Value)}
+	case *Ydb.Value_Uint64Value:
+		return scalar{kind: kindUint, u: x.Uint64Value}
+	case *Ydb.Value_FloatValue:
+		return scalar{kind: kindFloat, f: float64(x.FloatValue)}
+	case *Ydb.Value_DoubleValue:
+		return scalar{kind: kindFloat, f: x.DoubleValue}
+	case *Ydb.Value_BytesValue:
+		return scalar{kind: kindBytes, s: string(x.BytesValue)}
+	case *Ydb.Value_TextValue:
+		return scalar{kind: kindBytes, s: x.TextValue}
+	case *Ydb.Value_Low_128:
+		return scalar{kind: kindInt128, i: int64(v.GetHigh_128()), u: x.Low_128}
+	default:
+		return scalar{kind: kindNull}
+	}
+}
+
+// compare compares scalars of same kind. NULL is less than any value
+func (s scalar) compare(other scalar) (int, error) {
+	switch {
+	case s.kind == kindNull || other.kind == kindNull:
+		return cmp.Compare(s.kind, other.kind), nil
+	case s.kind != other.kind:
+		return 0, xerrors.WithStackTrace(errIncomparable)
+	}
+
+	if c := cmp.Compare(s.i, other.i); c != 0 {
+		return c, nil
+	}
+	if c := cmp.Compare(s.u, other.u); c != 0 {
+		return c, nil
+	}
+	if c := cmp.Compare(s.f, other.f); c != 0 {
+		return c, nil
+	}
+
+	return cmp.Compare(s.s, other.s), nil
+}
+
+func (k key) compare(other key) (int, error) {
+	for i := 0; i < len(k) && i < len(other); i++ {
+		c, err := k[i].compare(other[i])
+		if err != nil {
+			return 0, xerrors.WithStackTrace(fmt.Errorf("key column %d: %w", i, err))
+		}
+		if c != 0 {
+			return c, nil
+		}
+	}
+
+	return cmp.Compare(len(k), len(other)), nil
+}
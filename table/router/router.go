@@ -0,0 +1,273 @@
+// Package router provides shard-aware routing of table requests to the node which hosts the key
+package router
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"golang.org/x/sync/singleflight"
+	grpcCodes "google.golang.org/grpc/codes"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/balancers"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+// DefaultTTL is a default lifetime of cached partitions of table
+const DefaultTTL = time.Minute
+
+var (
+	errUnknownLeader    = errors.New("unknown leader node of partition")
+	errNilKey           = errors.New("nil key")
+	errPartitionsLayout = errors.New("shard key bounds not matches partition stats")
+)
+
+type (
+	// Router resolves node ID which hosts the partition of table with given primary key
+	//
+	// Router caches partition boundaries and leader node IDs of tables and refreshes them
+	// after DefaultTTL (or ttl from WithTTL) or on routing errors of operations called with Router.Do.
+	// Concurrent refreshes of partitions of the same table share single describe of table
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	Router struct {
+		describe func(ctx context.Context, path string) (options.Description, error)
+		ttl      time.Duration
+		now      func() time.Time
+
+		mu     sync.RWMutex
+		tables map[string]*partitions
+		group  singleflight.Group
+	}
+	partitions struct {
+		bounds  []key
+		nodes   []uint32
+		expires time.Time
+	}
+	Option func(r *Router)
+)
+
+// WithTTL overrides lifetime of cached partitions of table
+func WithTTL(ttl time.Duration) Option {
+	return func(r *Router) {
+		r.ttl = ttl
+	}
+}
+
+// New makes Router which describes tables with client
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func New(client table.Client, opts ...Option) *Router {
+	return newRouter(func(ctx context.Context, path string) (desc options.Description, err error) {
+		err = client.Do(ctx, func(ctx context.Context, s table.Session) (err error) {
+			desc, err = s.DescribeTable(ctx, path,
+				options.WithShardKeyBounds(),
+				options.WithPartitionStats(),
+			)
+
+			return err
+		}, table.WithIdempotent())
+
+		return desc, err
+	}, opts...)
+}
+
+func newRouter(
+	describe func(ctx context.Context, path string) (options.Description, error), opts ...Option,
+) *Router {
+	r := &Router{
+		describe: describe,
+		ttl:      DefaultTTL,
+		now:      time.Now,
+		tables:   make(map[string]*partitions),
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(r)
+		}
+	}
+
+	return r
+}
+
+// NodeID returns ID of leader node of the partition of table which contains primary key k
+//
+// Key of table with composite primary key must be a tuple of key columns values
+func (r *Router) NodeID(ctx context.Context, path string, k types.Value) (uint32, error) {
+	if k == nil {
+		return 0, xerrors.WithStackTrace(errNilKey)
+	}
+
+	p, err := r.partitions(ctx, path)
+	if err != nil {
+		return 0, xerrors.WithStackTrace(err)
+	}
+
+	nodeID, err := p.nodeID(newKey(k))
+	if err != nil {
+		return 0, xerrors.WithStackTrace(fmt.Errorf("table '%s': %w", path, err))
+	}
+
+	return nodeID, nil
+}
+
+// WithKey returns context which pins requests and new sessions to the node which hosts
+// the primary key k of table
+func (r *Router) WithKey(ctx context.Context, path string, k types.Value) (context.Context, error) {
+	nodeID, err := r.NodeID(ctx, path, k)
+	if err != nil {
+		return ctx, xerrors.WithStackTrace(err)
+	}
+
+	return balancers.WithNodeID(ctx, nodeID), nil
+}
+
+// Do calls op with context pinned to the node which hosts the primary key k of table
+//
+// If node ID cannot be resolved op is called with ctx as is. Cached partitions of table
+// are dropped if op returns routing error (node of partition is unavailable or overloaded,
+// session is bad), so next call describes table again
+func (r *Router) Do(ctx context.Context, path string, k types.Value, op func(ctx context.Context) error) error {
+	pinnedCtx, err := r.WithKey(ctx, path, k)
+	if err != nil {
+		pinnedCtx = ctx
+	}
+
+	if err = op(pinnedCtx); err != nil {
+		if isRoutingError(err) {
+			r.Invalidate(path)
+		}
+
+		return xerrors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// isRoutingError checks that error may be caused by stale partitions of table,
+// for example if leader of partition moved to another node
+func isRoutingError(err error) bool {
+	if xerrors.IsTransportError(err, grpcCodes.Unavailable) {
+		return true
+	}
+
+	return xerrors.IsOperationError(err,
+		Ydb.StatusIds_BAD_SESSION,
+		Ydb.StatusIds_SESSION_EXPIRED,
+		Ydb.StatusIds_UNAVAILABLE,
+		Ydb.StatusIds_OVERLOADED,
+	)
+}
+
+// Invalidate drops cached partitions of table
+func (r *Router) Invalidate(path string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.tables, path)
+}
+
+func (r *Router) partitions(ctx context.Context, path string) (*partitions, error) {
+	r.mu.RLock()
+	p, has := r.tables[path]
+	r.mu.RUnlock()
+
+	if has && r.now().Before(p.expires) {
+		return p, nil
+	}
+
+	v, err, _ := r.group.Do(path, func() (interface{}, error) {
+		desc, err := r.describe(ctx, path)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		p, err := newPartitions(desc)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("table '%s': %w", path, err))
+		}
+		p.expires = r.now().Add(r.ttl)
+
+		r.mu.Lock()
+		defer r.mu.Unlock()
+
+		r.tables[path] = p
+
+		return p, nil
+	})
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return v.(*partitions), nil //nolint:forcetypeassert
+}
+
+func newPartitions(desc options.Description) (*partitions, error) {
+	if desc.Stats == nil || len(desc.Stats.PartitionStats) != len(desc.KeyRanges) {
+		return nil, xerrors.WithStackTrace(errPartitionsLayout)
+	}
+
+	a := allocator.New()
+	defer a.Free()
+
+	p := &partitions{
+		bounds: make([]key, 0, len(desc.KeyRanges)-1),
+		nodes:  make([]uint32, len(desc.Stats.PartitionStats)),
+	}
+	for i, r := range desc.KeyRanges {
+		if r.To != nil {
+			p.bounds = append(p.bounds, newKey(r.To))
+		}
+		p.nodes[i] = desc.Stats.PartitionStats[i].LeaderNodeID
+	}
+
+	return p, nil
+}
+
+// nodeID returns leader node of partition [bounds[i-1], bounds[i]) which contains key k
+func (p *partitions) nodeID(k key) (uint32, error) {
+	var err error
+	i := sort.Search(len(p.bounds), func(i int) bool {
+		c, cmpErr := k.compare(p.bounds[i])
+		if cmpErr != nil {
+			err = cmpErr
+		}
+
+		return c < 0
+	})
+	if err != nil {
+		return 0, xerrors.WithStackTrace(err)
+	}
+
+	if p.nodes[i] == 0 {
+		return 0, xerrors.WithStackTrace(errUnknownLeader)
+	}
+
+	return p.nodes[i], nil
+}
+
+func newKey(v value.Value) key {
+	a := allocator.New()
+	defer a.Free()
+
+	tv := value.ToYDB(v, a)
+	if items := tv.GetValue().GetItems(); len(items) > 0 {
+		k := make(key, len(items))
+		for i := range items {
+			k[i] = newScalar(items[i])
+		}
+
+		return k
+	}
+
+	return key{newScalar(tv.GetValue())}
+}
@@ -0,0 +1,251 @@
+package router
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	grpcCodes "google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/endpoint"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+func bound(items ...types.Value) types.Value {
+	return types.TupleValue(items...)
+}
+
+// description makes description of table with partitions (-inf, 100), [100, 200), [200, +inf)
+// on nodes 1, 2 and 3
+func description() options.Description {
+	return options.Description{
+		KeyRanges: []options.KeyRange{
+			{To: bound(types.OptionalValue(types.Uint64Value(100)))},
+			{
+				From: bound(types.OptionalValue(types.Uint64Value(100))),
+				To:   bound(types.OptionalValue(types.Uint64Value(200))),
+			},
+			{From: bound(types.OptionalValue(types.Uint64Value(200)))},
+		},
+		Stats: &options.TableStats{
+			PartitionStats: []options.PartitionStats{
+				{LeaderNodeID: 1},
+				{LeaderNodeID: 2},
+				{LeaderNodeID: 3},
+			},
+		},
+	}
+}
+
+func TestRouterNodeID(t *testing.T) {
+	ctx := context.Background()
+	r := newRouter(func(ctx context.Context, path string) (options.Description, error) {
+		return description(), nil
+	})
+	for _, tt := range []struct {
+		key    types.Value
+		nodeID uint32
+		err    error
+	}{
+		{key: types.Uint64Value(0), nodeID: 1},
+		{key: types.Uint64Value(99), nodeID: 1},
+		{key: types.Uint64Value(100), nodeID: 2},
+		{key: types.OptionalValue(types.Uint64Value(199)), nodeID: 2},
+		{key: types.TupleValue(types.Uint64Value(200), types.TextValue("a")), nodeID: 3},
+		{key: types.NullValue(types.TypeUint64), nodeID: 1},
+		{key: types.TextValue("100"), err: errIncomparable},
+		{key: nil, err: errNilKey},
+	} {
+		t.Run("", func(t *testing.T) {
+			nodeID, err := r.NodeID(ctx, "/local/t", tt.key)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.nodeID, nodeID)
+			}
+		})
+	}
+}
+
+func TestRouterPartitionsLayout(t *testing.T) {
+	r := newRouter(func(ctx context.Context, path string) (options.Description, error) {
+		desc := description()
+		desc.Stats.PartitionStats[1].LeaderNodeID = 0
+
+		return desc, nil
+	})
+	_, err := r.NodeID(context.Background(), "/local/t", types.Uint64Value(150))
+	require.ErrorIs(t, err, errUnknownLeader)
+
+	r = newRouter(func(ctx context.Context, path string) (options.Description, error) {
+		desc := description()
+		desc.Stats = nil
+
+		return desc, nil
+	})
+	_, err = r.NodeID(context.Background(), "/local/t", types.Uint64Value(150))
+	require.ErrorIs(t, err, errPartitionsLayout)
+}
+
+func TestRouterCache(t *testing.T) {
+	var (
+		ctx       = context.Background()
+		now       = time.Now()
+		describes = 0
+	)
+	r := newRouter(func(ctx context.Context, path string) (options.Description, error) {
+		describes++
+
+		return description(), nil
+	}, WithTTL(time.Minute))
+	r.now = func() time.Time {
+		return now
+	}
+
+	_, err := r.NodeID(ctx, "/local/t", types.Uint64Value(1))
+	require.NoError(t, err)
+	_, err = r.NodeID(ctx, "/local/t", types.Uint64Value(2))
+	require.NoError(t, err)
+	require.Equal(t, 1, describes)
+
+	now = now.Add(time.Minute)
+	_, err = r.NodeID(ctx, "/local/t", types.Uint64Value(1))
+	require.NoError(t, err)
+	require.Equal(t, 2, describes)
+
+	r.Invalidate("/local/t")
+	_, err = r.NodeID(ctx, "/local/t", types.Uint64Value(1))
+	require.NoError(t, err)
+	require.Equal(t, 3, describes)
+}
+
+func TestRouterDo(t *testing.T) {
+	var (
+		ctx       = context.Background()
+		describes = 0
+		errOp     = errors.New("op failed")
+	)
+	r := newRouter(func(ctx context.Context, path string) (options.Description, error) {
+		describes++
+
+		return description(), nil
+	})
+
+	err := r.Do(ctx, "/local/t", types.Uint64Value(150), func(ctx context.Context) error {
+		nodeID, pinned := endpoint.ContextNodeID(ctx)
+		require.True(t, pinned)
+		require.Equal(t, uint32(2), nodeID)
+
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, describes)
+
+	err = r.Do(ctx, "/local/t", types.Uint64Value(250), func(ctx context.Context) error {
+		return errOp
+	})
+	require.ErrorIs(t, err, errOp)
+	require.Equal(t, 1, describes)
+
+	errBadSession := xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_BAD_SESSION))
+	err = r.Do(ctx, "/local/t", types.Uint64Value(250), func(ctx context.Context) error {
+		return errBadSession
+	})
+	require.ErrorIs(t, err, errBadSession)
+
+	err = r.Do(ctx, "/local/t", types.Uint64Value(250), func(ctx context.Context) error {
+		nodeID, _ := endpoint.ContextNodeID(ctx)
+		require.Equal(t, uint32(3), nodeID)
+
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, describes)
+}
+
+func TestIsRoutingError(t *testing.T) {
+	for _, tt := range []struct {
+		err     error
+		routing bool
+	}{
+		{
+			err:     errors.New("op failed"),
+			routing: false,
+		},
+		{
+			err:     context.Canceled,
+			routing: false,
+		},
+		{
+			err:     xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_PRECONDITION_FAILED)),
+			routing: false,
+		},
+		{
+			err:     xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_BAD_SESSION)),
+			routing: true,
+		},
+		{
+			err:     xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_UNAVAILABLE)),
+			routing: true,
+		},
+		{
+			err:     xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_OVERLOADED)),
+			routing: true,
+		},
+		{
+			err:     xerrors.Transport(grpcStatus.Error(grpcCodes.Unavailable, "")),
+			routing: true,
+		},
+	} {
+		t.Run("", func(t *testing.T) {
+			require.Equal(t, tt.routing, isRoutingError(tt.err))
+		})
+	}
+}
+
+func TestRouterSharedDescribe(t *testing.T) {
+	var (
+		ctx       = context.Background()
+		describes atomic.Int32
+		started   = make(chan struct{})
+		release   = make(chan struct{})
+	)
+	r := newRouter(func(ctx context.Context, path string) (options.Description, error) {
+		if describes.Add(1) == 1 {
+			close(started)
+		}
+		<-release
+
+		return description(), nil
+	})
+
+	var wg sync.WaitGroup
+	nodeID := func() {
+		defer wg.Done()
+		id, err := r.NodeID(ctx, "/local/t", types.Uint64Value(150))
+		require.NoError(t, err)
+		require.Equal(t, uint32(2), id)
+	}
+	wg.Add(1)
+	go nodeID()
+	<-started
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go nodeID()
+	}
+	// waiters must join in-flight describe of the first caller
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	require.EqualValues(t, 1, describes.Load())
+}
@@ -0,0 +1,112 @@
+package schema
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+)
+
+type (
+	applyConfig struct {
+		allowDestructive bool
+		dryRun           bool
+	}
+	ApplyOption func(c *applyConfig)
+)
+
+// WithAllowDestructive allows to apply destructive steps (drop columns and indexes)
+func WithAllowDestructive() ApplyOption {
+	return func(c *applyConfig) {
+		c.allowDestructive = true
+	}
+}
+
+// WithDryRun makes Migrate to compute plan without applying it
+func WithDryRun() ApplyOption {
+	return func(c *applyConfig) {
+		c.dryRun = true
+	}
+}
+
+// Apply executes steps of plan on table with given path
+//
+// Apply returns ErrDestructiveChanges without execution of any step if plan contains
+// destructive steps and WithAllowDestructive option not defined.
+func (p Plan) Apply(ctx context.Context, s table.Session, path string, opts ...ApplyOption) error {
+	cfg := applyConfig{}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&cfg)
+		}
+	}
+
+	if !cfg.allowDestructive && p.Destructive() {
+		return xerrors.WithStackTrace(fmt.Errorf("%w:\n%s", ErrDestructiveChanges, p.String()))
+	}
+
+	if cfg.dryRun {
+		return nil
+	}
+
+	for i := range p {
+		var err error
+		if p[i].Kind == StepCreateTable {
+			err = s.CreateTable(ctx, path, p[i].createTableOptions...)
+		} else {
+			err = s.AlterTable(ctx, path, p[i].alterTableOptions...)
+		}
+		if err != nil {
+			return xerrors.WithStackTrace(fmt.Errorf("step '%s' of migration of table '%s' failed: %w",
+				p[i].String(), path, err,
+			))
+		}
+	}
+
+	return nil
+}
+
+// Describe returns actual description of table or nil if table not exists
+func Describe(ctx context.Context, s table.Session, path string) (*options.Description, error) {
+	desc, err := s.DescribeTable(ctx, path)
+	if err != nil {
+		if xerrors.IsOperationError(err, Ydb.StatusIds_SCHEME_ERROR) {
+			return nil, nil //nolint:nilnil
+		}
+
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return &desc, nil
+}
+
+// Migrate describes table with given path, computes plan of migration to desired schema and applies it
+//
+// Migrate retries whole cycle (describe, diff and apply) on retryable errors, so partially applied plan
+// continues from actual state of table. Migrate returns last computed plan.
+func Migrate(ctx context.Context, c table.Client, path string, desired Table, opts ...ApplyOption) (
+	plan Plan, _ error,
+) {
+	err := c.Do(ctx, func(ctx context.Context, s table.Session) (err error) {
+		actual, err := Describe(ctx, s, path)
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+
+		plan, err = Diff(actual, desired)
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+
+		return plan.Apply(ctx, s, path, opts...)
+	}, table.WithIdempotent())
+	if err != nil {
+		return plan, xerrors.WithStackTrace(err)
+	}
+
+	return plan, nil
+}
@@ -0,0 +1,431 @@
+package schema
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+)
+
+var (
+	ErrPrimaryKeyChanged  = errors.New("primary key of existing table cannot be changed")
+	ErrColumnTypeChanged  = errors.New("type of existing column cannot be changed")
+	ErrDestructiveChanges = errors.New("plan contains destructive steps")
+)
+
+type StepKind int
+
+const (
+	StepCreateTable = StepKind(iota)
+	StepAddColumnFamilies
+	StepAlterColumnFamilies
+	StepAddColumns
+	StepAlterColumnFamily
+	StepDropIndex
+	StepAddIndex
+	StepDropColumns
+	StepSetTimeToLive
+	StepDropTimeToLive
+	StepAlterPartitioning
+	StepAlterAttributes
+)
+
+func (k StepKind) String() string {
+	switch k {
+	case StepCreateTable:
+		return "create table"
+	case StepAddColumnFamilies:
+		return "add column families"
+	case StepAlterColumnFamilies:
+		return "alter column families"
+	case StepAddColumns:
+		return "add columns"
+	case StepAlterColumnFamily:
+		return "alter column family"
+	case StepDropIndex:
+		return "drop index"
+	case StepAddIndex:
+		return "add index"
+	case StepDropColumns:
+		return "drop columns"
+	case StepSetTimeToLive:
+		return "set TTL"
+	case StepDropTimeToLive:
+		return "drop TTL"
+	case StepAlterPartitioning:
+		return "alter partitioning settings"
+	case StepAlterAttributes:
+		return "alter attributes"
+	default:
+		return fmt.Sprintf("unknown_step_kind_%d", int(k))
+	}
+}
+
+// Step is a single CreateTable or AlterTable call of Plan
+type Step struct {
+	Kind StepKind
+
+	// Details is a human-readable description of step
+	Details string
+
+	// Destructive is true if step drops data or indexes
+	Destructive bool
+
+	createTableOptions []options.CreateTableOption
+	alterTableOptions  []options.AlterTableOption
+}
+
+func (s Step) String() string {
+	if s.Destructive {
+		return s.Kind.String() + " " + s.Details + " (destructive)"
+	}
+
+	return s.Kind.String() + " " + s.Details
+}
+
+// CreateTableOptions returns options for CreateTable call (only for StepCreateTable)
+func (s Step) CreateTableOptions() []options.CreateTableOption {
+	return s.createTableOptions
+}
+
+// AlterTableOptions returns options for AlterTable call (for all steps except StepCreateTable)
+func (s Step) AlterTableOptions() []options.AlterTableOption {
+	return s.alterTableOptions
+}
+
+// Plan is an ordered list of steps for migrate table to desired schema
+type Plan []Step
+
+// Empty returns true if table already has desired schema
+func (p Plan) Empty() bool {
+	return len(p) == 0
+}
+
+// Destructive returns true if plan contains destructive steps
+func (p Plan) Destructive() bool {
+	for i := range p {
+		if p[i].Destructive {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (p Plan) String() string {
+	var b strings.Builder
+	for i := range p {
+		fmt.Fprintf(&b, "%d. %s\n", i+1, p[i].String())
+	}
+
+	return b.String()
+}
+
+// Diff compares actual description of table with desired schema and returns plan of migration
+//
+// If actual is nil (table not exists) plan contains single StepCreateTable step.
+func Diff(actual *options.Description, desired Table) (Plan, error) { //nolint:gocyclo,funlen
+	if actual == nil {
+		return Plan{{
+			Kind:               StepCreateTable,
+			Details:            fmt.Sprintf("with columns [%s]", strings.Join(columnNames(desired.Columns), ",")),
+			createTableOptions: desired.createTableOptions(),
+		}}, nil
+	}
+
+	if !equalStrings(actual.PrimaryKey, desired.PrimaryKey) {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("%w: [%s] -> [%s]", ErrPrimaryKeyChanged,
+			strings.Join(actual.PrimaryKey, ","), strings.Join(desired.PrimaryKey, ","),
+		))
+	}
+
+	var plan Plan
+
+	addFamilies, alterFamilies := diffColumnFamilies(actual.ColumnFamilies, desired.ColumnFamilies)
+	if len(addFamilies) > 0 {
+		plan = append(plan, Step{
+			Kind:              StepAddColumnFamilies,
+			Details:           fmt.Sprintf("[%s]", strings.Join(familyNames(addFamilies), ",")),
+			alterTableOptions: []options.AlterTableOption{options.WithAddColumnFamilies(addFamilies...)},
+		})
+	}
+	if len(alterFamilies) > 0 {
+		plan = append(plan, Step{
+			Kind:              StepAlterColumnFamilies,
+			Details:           fmt.Sprintf("[%s]", strings.Join(familyNames(alterFamilies), ",")),
+			alterTableOptions: []options.AlterTableOption{options.WithAlterColumnFamiliesSettings(alterFamilies...)},
+		})
+	}
+
+	actualColumns := make(map[string]options.Column, len(actual.Columns))
+	for _, c := range actual.Columns {
+		actualColumns[c.Name] = c
+	}
+	desiredColumns := make(map[string]struct{}, len(desired.Columns))
+	var (
+		addColumns []options.Column
+		moveSteps  []Step
+	)
+	for _, c := range desired.Columns {
+		desiredColumns[c.Name] = struct{}{}
+		a, has := actualColumns[c.Name]
+		if !has {
+			addColumns = append(addColumns, c)
+
+			continue
+		}
+		if !types.Equal(a.Type, c.Type) {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("%w: column '%s' %s -> %s", ErrColumnTypeChanged,
+				c.Name, a.Type.Yql(), c.Type.Yql(),
+			))
+		}
+		if c.Family != "" && c.Family != a.Family {
+			moveSteps = append(moveSteps, Step{
+				Kind:              StepAlterColumnFamily,
+				Details:           fmt.Sprintf("'%s' -> '%s' for column '%s'", a.Family, c.Family, c.Name),
+				alterTableOptions: []options.AlterTableOption{options.WithAlterColumnFamily(c.Name, c.Family)},
+			})
+		}
+	}
+	if len(addColumns) > 0 {
+		step := Step{
+			Kind:    StepAddColumns,
+			Details: fmt.Sprintf("[%s]", strings.Join(columnNames(addColumns), ",")),
+		}
+		for _, c := range addColumns {
+			step.alterTableOptions = append(step.alterTableOptions, options.WithAddColumnMeta(c))
+		}
+		plan = append(plan, step)
+	}
+	plan = append(plan, moveSteps...)
+
+	actualIndexes := make(map[string]Index, len(actual.Indexes))
+	for _, idx := range FromDescription(actual).Indexes {
+		actualIndexes[idx.Name] = idx
+	}
+	desiredIndexes := make(map[string]struct{}, len(desired.Indexes))
+	var addIndexes []Index
+	for _, idx := range desired.Indexes {
+		desiredIndexes[idx.Name] = struct{}{}
+		a, has := actualIndexes[idx.Name]
+		switch {
+		case !has:
+			addIndexes = append(addIndexes, idx)
+		case !equalIndexes(a, idx):
+			plan = append(plan, dropIndexStep(a.Name, "changed"))
+			addIndexes = append(addIndexes, idx)
+		}
+	}
+	for _, idx := range actual.Indexes {
+		if _, has := desiredIndexes[idx.Name]; !has {
+			plan = append(plan, dropIndexStep(idx.Name, "not declared"))
+		}
+	}
+	for _, idx := range addIndexes {
+		plan = append(plan, Step{
+			Kind:              StepAddIndex,
+			Details:           fmt.Sprintf("'%s' on [%s]", idx.Name, strings.Join(idx.Columns, ",")),
+			alterTableOptions: []options.AlterTableOption{options.WithAddIndex(idx.Name, idx.options()...)},
+		})
+	}
+
+	var dropColumns []string
+	for _, c := range actual.Columns {
+		if _, has := desiredColumns[c.Name]; !has {
+			dropColumns = append(dropColumns, c.Name)
+		}
+	}
+	if len(dropColumns) > 0 {
+		step := Step{
+			Kind:        StepDropColumns,
+			Details:     fmt.Sprintf("[%s]", strings.Join(dropColumns, ",")),
+			Destructive: true,
+		}
+		for _, name := range dropColumns {
+			step.alterTableOptions = append(step.alterTableOptions, options.WithDropColumn(name))
+		}
+		plan = append(plan, step)
+	}
+
+	switch {
+	case desired.TimeToLive == nil && actual.TimeToLiveSettings != nil:
+		plan = append(plan, Step{
+			Kind:              StepDropTimeToLive,
+			Details:           fmt.Sprintf("on column '%s'", actual.TimeToLiveSettings.ColumnName),
+			alterTableOptions: []options.AlterTableOption{options.WithDropTimeToLive()},
+		})
+	case desired.TimeToLive != nil && !equalTimeToLive(actual.TimeToLiveSettings, desired.TimeToLive):
+		plan = append(plan, Step{
+			Kind: StepSetTimeToLive,
+			Details: fmt.Sprintf("on column '%s' expire after %ds",
+				desired.TimeToLive.ColumnName, desired.TimeToLive.ExpireAfterSeconds,
+			),
+			alterTableOptions: []options.AlterTableOption{options.WithSetTimeToLiveSettings(*desired.TimeToLive)},
+		})
+	}
+
+	if desired.Partitioning != nil && !equalPartitioning(actual.PartitioningSettings, *desired.Partitioning) {
+		plan = append(plan, Step{
+			Kind:    StepAlterPartitioning,
+			Details: fmt.Sprintf("%+v", *desired.Partitioning),
+			alterTableOptions: []options.AlterTableOption{
+				options.WithAlterPartitionSettingsObject(*desired.Partitioning),
+			},
+		})
+	}
+
+	if desired.Attributes != nil {
+		var (
+			details []string
+			opts    []options.AlterTableOption
+		)
+		for _, k := range sortedKeys(desired.Attributes) {
+			if v, has := actual.Attributes[k]; !has || v != desired.Attributes[k] {
+				details = append(details, fmt.Sprintf("%s=%q", k, desired.Attributes[k]))
+				opts = append(opts, options.WithAlterAttribute(k, desired.Attributes[k]))
+			}
+		}
+		for _, k := range sortedKeys(actual.Attributes) {
+			if _, has := desired.Attributes[k]; !has {
+				details = append(details, "-"+k)
+				opts = append(opts, options.WithDropAttribute(k))
+			}
+		}
+		if len(opts) > 0 {
+			plan = append(plan, Step{
+				Kind:              StepAlterAttributes,
+				Details:           fmt.Sprintf("[%s]", strings.Join(details, ",")),
+				alterTableOptions: opts,
+			})
+		}
+	}
+
+	return plan, nil
+}
+
+func dropIndexStep(name, reason string) Step {
+	return Step{
+		Kind:              StepDropIndex,
+		Details:           fmt.Sprintf("'%s' (%s)", name, reason),
+		Destructive:       true,
+		alterTableOptions: []options.AlterTableOption{options.WithDropIndex(name)},
+	}
+}
+
+func diffColumnFamilies(actual, desired []options.ColumnFamily) (add, alter []options.ColumnFamily) {
+	actualFamilies := make(map[string]options.ColumnFamily, len(actual))
+	for _, cf := range actual {
+		actualFamilies[cf.Name] = cf
+	}
+	for _, cf := range desired {
+		a, has := actualFamilies[cf.Name]
+		switch {
+		case !has:
+			add = append(add, cf)
+		case !equalColumnFamily(a, cf):
+			alter = append(alter, cf)
+		}
+	}
+
+	return add, alter
+}
+
+// equalColumnFamily compares only settings which defined in desired column family
+func equalColumnFamily(actual, desired options.ColumnFamily) bool {
+	if desired.Data.Media != "" && desired.Data.Media != actual.Data.Media {
+		return false
+	}
+	if desired.Compression != options.ColumnFamilyCompressionUnknown && desired.Compression != actual.Compression {
+		return false
+	}
+	if desired.KeepInMemory != 0 && desired.KeepInMemory != actual.KeepInMemory {
+		return false
+	}
+
+	return true
+}
+
+// equalPartitioning compares only settings which defined in desired partitioning settings
+func equalPartitioning(actual, desired options.PartitioningSettings) bool {
+	if desired.PartitioningBySize != 0 && desired.PartitioningBySize != actual.PartitioningBySize {
+		return false
+	}
+	if desired.PartitionSizeMb != 0 && desired.PartitionSizeMb != actual.PartitionSizeMb {
+		return false
+	}
+	if desired.PartitioningByLoad != 0 && desired.PartitioningByLoad != actual.PartitioningByLoad {
+		return false
+	}
+	if desired.MinPartitionsCount != 0 && desired.MinPartitionsCount != actual.MinPartitionsCount {
+		return false
+	}
+	if desired.MaxPartitionsCount != 0 && desired.MaxPartitionsCount != actual.MaxPartitionsCount {
+		return false
+	}
+
+	return true
+}
+
+func equalTimeToLive(actual, desired *options.TimeToLiveSettings) bool {
+	if actual == nil || desired == nil {
+		return actual == desired
+	}
+	if actual.ColumnName != desired.ColumnName ||
+		actual.Mode != desired.Mode ||
+		actual.ExpireAfterSeconds != desired.ExpireAfterSeconds {
+		return false
+	}
+
+	return actual.ColumnUnit.ToYDB() == desired.ColumnUnit.ToYDB()
+}
+
+func equalIndexes(lhs, rhs Index) bool {
+	return lhs.Type == rhs.Type &&
+		equalStrings(lhs.Columns, rhs.Columns) &&
+		equalStrings(lhs.DataColumns, rhs.DataColumns)
+}
+
+func equalStrings(lhs, rhs []string) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+	for i := range lhs {
+		if lhs[i] != rhs[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func columnNames(columns []options.Column) []string {
+	names := make([]string, len(columns))
+	for i := range columns {
+		names[i] = columns[i].Name
+	}
+
+	return names
+}
+
+func familyNames(families []options.ColumnFamily) []string {
+	names := make([]string, len(families))
+	for i := range families {
+		names[i] = families[i].Name
+	}
+
+	return names
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
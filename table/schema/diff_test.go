@@ -0,0 +1,198 @@
+package schema
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+func actualDescription() *options.Description {
+	return &options.Description{
+		Name: "users",
+		Columns: []options.Column{
+			{Name: "id", Type: types.Optional(types.TypeUint64)},
+			{Name: "email", Type: types.Optional(types.TypeText)},
+			{Name: "legacy", Type: types.Optional(types.TypeBytes)},
+		},
+		PrimaryKey: []string{"id"},
+		ColumnFamilies: []options.ColumnFamily{
+			{Name: "default", Compression: options.ColumnFamilyCompressionNone},
+		},
+		Indexes: []options.IndexDescription{
+			{Name: "email_idx", IndexColumns: []string{"email"}, Type: options.IndexTypeGlobal},
+			{Name: "legacy_idx", IndexColumns: []string{"legacy"}, Type: options.IndexTypeGlobal},
+		},
+		Attributes: map[string]string{
+			"owner": "alice",
+			"stale": "true",
+		},
+	}
+}
+
+func alterRequest(t *testing.T, step Step) *Ydb_Table.AlterTableRequest {
+	t.Helper()
+	a := allocator.New()
+	defer a.Free()
+	req := &Ydb_Table.AlterTableRequest{}
+	for _, opt := range step.AlterTableOptions() {
+		opt.ApplyAlterTableOption((*options.AlterTableDesc)(req), a)
+	}
+
+	return req
+}
+
+func TestDiffCreateTable(t *testing.T) {
+	desired, err := FromStruct[user]()
+	require.NoError(t, err)
+	plan, err := Diff(nil, desired)
+	require.NoError(t, err)
+	require.Len(t, plan, 1)
+	require.Equal(t, StepCreateTable, plan[0].Kind)
+	require.False(t, plan.Destructive())
+
+	a := allocator.New()
+	defer a.Free()
+	req := &Ydb_Table.CreateTableRequest{}
+	for _, opt := range plan[0].CreateTableOptions() {
+		opt.ApplyCreateTableOption((*options.CreateTableDesc)(req), a)
+	}
+	require.Len(t, req.GetColumns(), 4)
+	require.Equal(t, []string{"id"}, req.GetPrimaryKey())
+	require.Len(t, req.GetIndexes(), 1)
+	require.Equal(t, "email_idx", req.GetIndexes()[0].GetName())
+}
+
+func TestDiffNoChanges(t *testing.T) {
+	actual := actualDescription()
+	plan, err := Diff(actual, FromDescription(actual))
+	require.NoError(t, err)
+	require.True(t, plan.Empty(), plan.String())
+}
+
+func TestDiffAlterTable(t *testing.T) {
+	desired := FromDescription(actualDescription())
+	desired.Columns = []options.Column{
+		{Name: "id", Type: types.Optional(types.TypeUint64)},
+		{Name: "email", Type: types.Optional(types.TypeText), Family: "cold"},
+		{Name: "created_at", Type: types.Optional(types.TypeTimestamp)},
+	}
+	desired.ColumnFamilies = []options.ColumnFamily{
+		{Name: "default", Compression: options.ColumnFamilyCompressionLZ4},
+		{Name: "cold", Data: options.StoragePool{Media: "hdd"}},
+	}
+	desired.Indexes = []Index{
+		{Name: "email_idx", Columns: []string{"email"}, DataColumns: []string{"created_at"}},
+	}
+	ttl := options.NewTTLSettings().ColumnDateType("created_at").ExpireAfter(time.Hour)
+	desired.TimeToLive = &ttl
+	desired.Attributes = map[string]string{
+		"owner": "bob",
+	}
+
+	plan, err := Diff(actualDescription(), desired)
+	require.NoError(t, err)
+	require.True(t, plan.Destructive())
+
+	kinds := make([]StepKind, len(plan))
+	for i := range plan {
+		kinds[i] = plan[i].Kind
+	}
+	require.Equal(t, []StepKind{
+		StepAddColumnFamilies,
+		StepAlterColumnFamilies,
+		StepAddColumns,
+		StepAlterColumnFamily,
+		StepDropIndex,
+		StepDropIndex,
+		StepAddIndex,
+		StepDropColumns,
+		StepSetTimeToLive,
+		StepAlterAttributes,
+	}, kinds, plan.String())
+
+	require.Equal(t, "cold", alterRequest(t, plan[0]).GetAddColumnFamilies()[0].GetName())
+	require.Equal(t, "default", alterRequest(t, plan[1]).GetAlterColumnFamilies()[0].GetName())
+	require.Equal(t, "created_at", alterRequest(t, plan[2]).GetAddColumns()[0].GetName())
+	require.Equal(t, "cold", alterRequest(t, plan[3]).GetAlterColumns()[0].GetFamily())
+	require.Equal(t, []string{"email_idx"}, alterRequest(t, plan[4]).GetDropIndexes())
+	require.Equal(t, []string{"legacy_idx"}, alterRequest(t, plan[5]).GetDropIndexes())
+	require.Equal(t, []string{"created_at"}, alterRequest(t, plan[6]).GetAddIndexes()[0].GetDataColumns())
+	require.Equal(t, []string{"legacy"}, alterRequest(t, plan[7]).GetDropColumns())
+	require.Equal(t, uint32(3600),
+		alterRequest(t, plan[8]).GetSetTtlSettings().GetDateTypeColumn().GetExpireAfterSeconds(),
+	)
+	require.Equal(t, map[string]string{
+		"owner": "bob",
+		"stale": "",
+	}, alterRequest(t, plan[9]).GetAlterAttributes())
+}
+
+func TestDiffDropTimeToLive(t *testing.T) {
+	actual := actualDescription()
+	ttl := options.NewTTLSettings().ColumnSeconds("id").ExpireAfter(time.Minute)
+	actual.TimeToLiveSettings = &ttl
+	desired := FromDescription(actual)
+	desired.TimeToLive = nil
+	plan, err := Diff(actual, desired)
+	require.NoError(t, err)
+	require.Len(t, plan, 1)
+	require.Equal(t, StepDropTimeToLive, plan[0].Kind)
+	require.False(t, plan.Destructive())
+}
+
+func TestDiffPartitioning(t *testing.T) {
+	actual := actualDescription()
+	actual.PartitioningSettings = options.PartitioningSettings{
+		PartitioningBySize: options.FeatureEnabled,
+		PartitionSizeMb:    2048,
+		MinPartitionsCount: 1,
+	}
+	desired := FromDescription(actual)
+	desired.Partitioning = &options.PartitioningSettings{
+		MinPartitionsCount: 1,
+	}
+	plan, err := Diff(actual, desired)
+	require.NoError(t, err)
+	require.True(t, plan.Empty(), plan.String())
+
+	desired.Partitioning.MinPartitionsCount = 10
+	plan, err = Diff(actual, desired)
+	require.NoError(t, err)
+	require.Len(t, plan, 1)
+	require.Equal(t, StepAlterPartitioning, plan[0].Kind)
+	require.Equal(t, uint64(10), alterRequest(t, plan[0]).GetAlterPartitioningSettings().GetMinPartitionsCount())
+}
+
+func TestDiffErrors(t *testing.T) {
+	t.Run("PrimaryKey", func(t *testing.T) {
+		desired := FromDescription(actualDescription())
+		desired.PrimaryKey = []string{"id", "email"}
+		_, err := Diff(actualDescription(), desired)
+		require.ErrorIs(t, err, ErrPrimaryKeyChanged)
+	})
+	t.Run("ColumnType", func(t *testing.T) {
+		desired := FromDescription(actualDescription())
+		desired.Columns = append([]options.Column{}, desired.Columns...)
+		desired.Columns[1].Type = types.Optional(types.TypeBytes)
+		_, err := Diff(actualDescription(), desired)
+		require.ErrorIs(t, err, ErrColumnTypeChanged)
+	})
+}
+
+func TestPlanApplyRefusesDestructive(t *testing.T) {
+	desired := FromDescription(actualDescription())
+	desired.Columns = desired.Columns[:2]
+	plan, err := Diff(actualDescription(), desired)
+	require.NoError(t, err)
+	require.True(t, plan.Destructive())
+	err = plan.Apply(context.Background(), nil, "users")
+	require.ErrorIs(t, err, ErrDestructiveChanges)
+	require.NoError(t, plan.Apply(context.Background(), nil, "users", WithAllowDestructive(), WithDryRun()))
+}
@@ -0,0 +1,97 @@
+// Package schema provides declarative management of row tables.
+//
+// Desired schema of table describes with Table (manually or from tagged go struct with FromStruct).
+// Diff compares desired schema with actual table description and produces ordered Plan of
+// CreateTable/AlterTable operations which can be reviewed and applied.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+package schema
+
+import (
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+)
+
+// Index describes secondary index of table
+type Index struct {
+	Name        string
+	Columns     []string
+	DataColumns []string
+	Type        options.IndexType
+}
+
+// Table describes desired schema of table
+//
+// Columns, PrimaryKey, Indexes and TimeToLive describe full desired state: columns and indexes
+// which not declared will be dropped, TTL settings will be dropped if TimeToLive is nil.
+// ColumnFamilies are only added or altered (YDB not supports dropping of column families).
+// Partitioning and Attributes are not managed if nil.
+type Table struct {
+	Columns        []options.Column
+	PrimaryKey     []string
+	ColumnFamilies []options.ColumnFamily
+	Indexes        []Index
+	TimeToLive     *options.TimeToLiveSettings
+	Partitioning   *options.PartitioningSettings
+	Attributes     map[string]string
+}
+
+// FromDescription makes Table from actual description of table
+func FromDescription(desc *options.Description) Table {
+	t := Table{
+		Columns:        desc.Columns,
+		PrimaryKey:     desc.PrimaryKey,
+		ColumnFamilies: desc.ColumnFamilies,
+		Indexes:        make([]Index, 0, len(desc.Indexes)),
+		TimeToLive:     desc.TimeToLiveSettings,
+		Attributes:     desc.Attributes,
+	}
+	partitioning := desc.PartitioningSettings
+	t.Partitioning = &partitioning
+	for _, idx := range desc.Indexes {
+		t.Indexes = append(t.Indexes, Index{
+			Name:        idx.Name,
+			Columns:     idx.IndexColumns,
+			DataColumns: idx.DataColumns,
+			Type:        idx.Type,
+		})
+	}
+
+	return t
+}
+
+func (idx Index) options() []options.IndexOption {
+	opts := []options.IndexOption{
+		options.WithIndexColumns(idx.Columns...),
+		options.WithIndexType(idx.Type),
+	}
+	if len(idx.DataColumns) > 0 {
+		opts = append(opts, options.WithDataColumns(idx.DataColumns...))
+	}
+
+	return opts
+}
+
+func (t *Table) createTableOptions() []options.CreateTableOption {
+	opts := make([]options.CreateTableOption, 0, len(t.Columns)+len(t.Indexes)+len(t.Attributes)+4) //nolint:gomnd
+	for _, c := range t.Columns {
+		opts = append(opts, options.WithColumnMeta(c))
+	}
+	opts = append(opts, options.WithPrimaryKeyColumn(t.PrimaryKey...))
+	if len(t.ColumnFamilies) > 0 {
+		opts = append(opts, options.WithColumnFamilies(t.ColumnFamilies...))
+	}
+	for _, idx := range t.Indexes {
+		opts = append(opts, options.WithIndex(idx.Name, idx.options()...))
+	}
+	if t.TimeToLive != nil {
+		opts = append(opts, options.WithTimeToLiveSettings(*t.TimeToLive))
+	}
+	if t.Partitioning != nil {
+		opts = append(opts, options.WithPartitioningSettingsObject(*t.Partitioning))
+	}
+	for _, k := range sortedKeys(t.Attributes) {
+		opts = append(opts, options.WithAttribute(k, t.Attributes[k]))
+	}
+
+	return opts
+}
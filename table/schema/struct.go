@@ -0,0 +1,107 @@
+package schema
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+)
+
+const (
+	nameTag   = "sql"
+	schemaTag = "ydb"
+)
+
+var (
+	errNotAStruct     = errors.New("not a struct")
+	errNoPrimaryKey   = errors.New("primary key not declared")
+	errUnknownTagHint = errors.New("unknown tag hint")
+)
+
+// FromStruct makes Table from fields of struct type T
+//
+// Column names are taken from `sql` tag (same as for ScanStruct) or from field names.
+// Fields with tag `sql:"-"` are skipped. Column types are inferred from types of fields
+// and wrapped into Optional unless field marked as `notnull`.
+// Schema hints are taken from `ydb` tag as comma-separated list:
+//   - pk - column is a part of primary key (in order of fields declaration)
+//   - notnull - column type is not wrapped into Optional
+//   - family=<name> - column is stored in column family with given name
+//   - index=<name> - column is a part of global index with given name (in order of fields declaration)
+//
+// Example:
+//
+//	type User struct {
+//		ID    uint64  `sql:"id" ydb:"pk"`
+//		Email string  `sql:"email" ydb:"index=email_idx"`
+//		Bio   *string `sql:"bio" ydb:"family=cold"`
+//	}
+func FromStruct[T any]() (Table, error) {
+	tt := reflect.TypeOf((*T)(nil)).Elem()
+	if tt.Kind() != reflect.Struct {
+		return Table{}, xerrors.WithStackTrace(fmt.Errorf("%w: %s", errNotAStruct, tt.String()))
+	}
+
+	var (
+		t       Table
+		indexes = make(map[string]int)
+	)
+	for i := 0; i < tt.NumField(); i++ {
+		f := tt.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		name := types.FieldName(f, nameTag)
+		if name == "-" {
+			continue
+		}
+		typ, err := types.FromGoType(f.Type)
+		if err != nil {
+			return Table{}, xerrors.WithStackTrace(fmt.Errorf("field '%s': %w", f.Name, err))
+		}
+		column := options.Column{
+			Name: name,
+		}
+		notNull := false
+		for _, hint := range strings.Split(f.Tag.Get(schemaTag), ",") {
+			k, v, _ := strings.Cut(strings.TrimSpace(hint), "=")
+			switch k {
+			case "":
+			case "pk":
+				t.PrimaryKey = append(t.PrimaryKey, name)
+			case "notnull":
+				notNull = true
+			case "family":
+				column.Family = v
+			case "index":
+				idx, has := indexes[v]
+				if !has {
+					idx = len(t.Indexes)
+					indexes[v] = idx
+					t.Indexes = append(t.Indexes, Index{
+						Name: v,
+						Type: options.IndexTypeGlobal,
+					})
+				}
+				t.Indexes[idx].Columns = append(t.Indexes[idx].Columns, name)
+			default:
+				return Table{}, xerrors.WithStackTrace(fmt.Errorf("field '%s': %w '%s'", f.Name, errUnknownTagHint, k))
+			}
+		}
+		if _, isOptional := typ.(types.Optional); !isOptional && !notNull {
+			typ = types.NewOptional(typ)
+		}
+		column.Type = typ
+		t.Columns = append(t.Columns, column)
+	}
+
+	if len(t.PrimaryKey) == 0 {
+		return Table{}, xerrors.WithStackTrace(fmt.Errorf("%w: %s", errNoPrimaryKey, tt.String()))
+	}
+
+	return t, nil
+}
@@ -0,0 +1,71 @@
+package schema
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+type user struct {
+	ID        uint64    `sql:"id" ydb:"pk,notnull"`
+	Email     string    `sql:"email" ydb:"index=email_idx"`
+	Bio       *string   `sql:"bio" ydb:"family=cold"`
+	CreatedAt time.Time `sql:"created_at"`
+	Ignored   string    `sql:"-"`
+	internal  int
+}
+
+func TestFromStruct(t *testing.T) {
+	t.Run("User", func(t *testing.T) {
+		tbl, err := FromStruct[user]()
+		require.NoError(t, err)
+		require.Equal(t, []string{"id"}, tbl.PrimaryKey)
+		require.Equal(t, []options.Column{
+			{Name: "id", Type: types.TypeUint64},
+			{Name: "email", Type: types.Optional(types.TypeText)},
+			{Name: "bio", Type: types.Optional(types.TypeText), Family: "cold"},
+			{Name: "created_at", Type: types.Optional(types.TypeTimestamp)},
+		}, tbl.Columns)
+		require.Equal(t, []Index{
+			{Name: "email_idx", Columns: []string{"email"}, Type: options.IndexTypeGlobal},
+		}, tbl.Indexes)
+	})
+	t.Run("CompositeKey", func(t *testing.T) {
+		type event struct {
+			Tenant string    `ydb:"pk"`
+			ID     uuid.UUID `ydb:"pk"`
+			Data   []byte
+		}
+		tbl, err := FromStruct[event]()
+		require.NoError(t, err)
+		require.Equal(t, []string{"Tenant", "ID"}, tbl.PrimaryKey)
+		require.Equal(t, []options.Column{
+			{Name: "Tenant", Type: types.Optional(types.TypeText)},
+			{Name: "ID", Type: types.Optional(types.TypeUUID)},
+			{Name: "Data", Type: types.Optional(types.TypeBytes)},
+		}, tbl.Columns)
+	})
+	t.Run("NoPrimaryKey", func(t *testing.T) {
+		type noKey struct {
+			ID uint64
+		}
+		_, err := FromStruct[noKey]()
+		require.ErrorIs(t, err, errNoPrimaryKey)
+	})
+	t.Run("UnknownHint", func(t *testing.T) {
+		type badHint struct {
+			ID uint64 `ydb:"primary"`
+		}
+		_, err := FromStruct[badHint]()
+		require.ErrorIs(t, err, errUnknownTagHint)
+	})
+	t.Run("NotAStruct", func(t *testing.T) {
+		_, err := FromStruct[int]()
+		require.ErrorIs(t, err, errNotAStruct)
+	})
+}
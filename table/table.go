@@ -179,6 +179,9 @@ type Session interface {
 		opts ...options.ExecuteScanQueryOption,
 	) (_ result.StreamResult, err error)
 
+	// BulkUpsert upserts rows into table without transaction
+	//
+	// Rows may be nil if pre-serialized data defined with options.WithCSVData or options.WithArrowData.
 	BulkUpsert(
 		ctx context.Context,
 		table string,
@@ -0,0 +1,128 @@
+package testutil
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+type (
+	// Cassette is a list of recorded gRPC exchanges stored in golden file
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	Cassette struct {
+		Interactions []*Interaction `json:"interactions"`
+	}
+	// Interaction is a recorded unary call or stream
+	//
+	// Unary call has single request and single response (if call was successful).
+	// Stream has sequence of events: sent requests and received responses in order of calls
+	Interaction struct {
+		Method string          `json:"method"`
+		Stream bool            `json:"stream,omitempty"`
+		Events []Event         `json:"events"`
+		Error  *RecordedStatus `json:"error,omitempty"`
+	}
+	// Event is a sent request or a received response of call
+	Event struct {
+		Request  json.RawMessage `json:"request,omitempty"`
+		Response json.RawMessage `json:"response,omitempty"`
+	}
+	// RecordedStatus is a recorded gRPC status of failed call
+	RecordedStatus struct {
+		Code    codes.Code `json:"code"`
+		Message string     `json:"message,omitempty"`
+	}
+)
+
+// LoadCassette reads cassette from golden file
+func LoadCassette(path string) (*Cassette, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	var c Cassette
+	if err = json.Unmarshal(b, &c); err != nil {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("cassette '%s': %w", path, err))
+	}
+
+	return &c, nil
+}
+
+// Save writes cassette to golden file
+func (c *Cassette) Save(path string) error {
+	b, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return xerrors.WithStackTrace(os.WriteFile(path, append(b, '\n'), 0o600)) //nolint:gomnd
+}
+
+func recordedStatus(err error) *RecordedStatus {
+	if err == nil {
+		return nil
+	}
+	s, _ := status.FromError(err)
+
+	return &RecordedStatus{
+		Code:    s.Code(),
+		Message: s.Message(),
+	}
+}
+
+func (s *RecordedStatus) err() error {
+	if s == nil {
+		return nil
+	}
+
+	return status.Error(s.Code, s.Message)
+}
+
+// marshal makes canonical JSON of proto message with scrubbed fields
+func marshal(m interface{}, scrubbers []Scrubber) (json.RawMessage, error) {
+	msg, ok := m.(proto.Message)
+	if !ok {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("%T is not a proto message", m))
+	}
+
+	b, err := protojson.Marshal(msg)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return canonical(b, scrubbers)
+}
+
+// canonical makes JSON with sorted keys and without extra whitespace. protojson output
+// is unstable by design, so canonical JSON is used for storing and matching of messages
+func canonical(b []byte, scrubbers []Scrubber) (json.RawMessage, error) {
+	var v interface{}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	b, err := json.Marshal(scrub("", v, scrubbers))
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return b, nil
+}
+
+func unmarshal(b json.RawMessage, m interface{}) error {
+	msg, ok := m.(proto.Message)
+	if !ok {
+		return xerrors.WithStackTrace(fmt.Errorf("%T is not a proto message", m))
+	}
+
+	return xerrors.WithStackTrace(protojson.Unmarshal(b, msg))
+}
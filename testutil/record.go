@@ -0,0 +1,212 @@
+package testutil
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"sync"
+
+	"google.golang.org/grpc"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// Recorder records unary and streaming gRPC exchanges to cassette
+//
+// Recorder can wrap any grpc.ClientConnInterface (balancer, grpc.ClientConn, testutil balancer stub)
+// or can be attached to driver with interceptors from Recorder.DialOptions. Requests and responses
+// are stored as canonical protojson with applied scrubbers
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type Recorder struct {
+	scrubbers []Scrubber
+
+	mu       sync.Mutex
+	cassette Cassette
+	err      error
+}
+
+// NewRecorder makes recorder with scrubbers for volatile fields of messages
+func NewRecorder(scrubbers ...Scrubber) *Recorder {
+	return &Recorder{
+		scrubbers: scrubbers,
+	}
+}
+
+// Cassette returns recorded interactions or first error of messages serialization
+func (r *Recorder) Cassette() (*Cassette, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.err != nil {
+		return nil, xerrors.WithStackTrace(r.err)
+	}
+
+	return &Cassette{
+		Interactions: append([]*Interaction(nil), r.cassette.Interactions...),
+	}, nil
+}
+
+// Save writes recorded interactions to golden file
+func (r *Recorder) Save(path string) error {
+	c, err := r.Cassette()
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return c.Save(path)
+}
+
+// Wrap returns grpc.ClientConnInterface which records all calls to cc
+func (r *Recorder) Wrap(cc grpc.ClientConnInterface) grpc.ClientConnInterface {
+	return &recordingConn{
+		cc:       cc,
+		recorder: r,
+	}
+}
+
+// DialOptions returns interceptors for recording calls of driver
+//
+// Use it with ydb.With(config.WithGrpcOptions(recorder.DialOptions()...))
+func (r *Recorder) DialOptions() []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(func(
+			ctx context.Context, method string, req, reply interface{},
+			cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption,
+		) error {
+			err := invoker(ctx, method, req, reply, cc, opts...)
+			r.recordUnary(method, req, reply, err)
+
+			return err
+		}),
+		grpc.WithChainStreamInterceptor(func(
+			ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string,
+			streamer grpc.Streamer, opts ...grpc.CallOption,
+		) (grpc.ClientStream, error) {
+			s, err := streamer(ctx, desc, cc, method, opts...)
+
+			return r.recordStream(method, s, err)
+		}),
+	}
+}
+
+func (r *Recorder) marshal(m interface{}) json.RawMessage {
+	b, err := marshal(m, r.scrubbers)
+	if err != nil && r.err == nil {
+		r.err = err
+	}
+
+	return b
+}
+
+func (r *Recorder) recordUnary(method string, req, reply interface{}, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	event := Event{
+		Request: r.marshal(req),
+	}
+	if err == nil {
+		event.Response = r.marshal(reply)
+	}
+
+	r.cassette.Interactions = append(r.cassette.Interactions, &Interaction{
+		Method: method,
+		Events: []Event{event},
+		Error:  recordedStatus(err),
+	})
+}
+
+func (r *Recorder) recordStream(method string, s grpc.ClientStream, err error) (grpc.ClientStream, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	interaction := &Interaction{
+		Method: method,
+		Stream: true,
+		Error:  recordedStatus(err),
+	}
+	r.cassette.Interactions = append(r.cassette.Interactions, interaction)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &recordingStream{
+		ClientStream: s,
+		recorder:     r,
+		interaction:  interaction,
+	}, nil
+}
+
+type recordingConn struct {
+	cc       grpc.ClientConnInterface
+	recorder *Recorder
+}
+
+func (c *recordingConn) Invoke(
+	ctx context.Context,
+	method string,
+	args interface{},
+	reply interface{},
+	opts ...grpc.CallOption,
+) error {
+	err := c.cc.Invoke(ctx, method, args, reply, opts...)
+	c.recorder.recordUnary(method, args, reply, err)
+
+	return err
+}
+
+func (c *recordingConn) NewStream(
+	ctx context.Context,
+	desc *grpc.StreamDesc,
+	method string,
+	opts ...grpc.CallOption,
+) (grpc.ClientStream, error) {
+	s, err := c.cc.NewStream(ctx, desc, method, opts...)
+
+	return c.recorder.recordStream(method, s, err)
+}
+
+type recordingStream struct {
+	grpc.ClientStream
+
+	recorder    *Recorder
+	interaction *Interaction
+}
+
+func (s *recordingStream) SendMsg(m interface{}) error {
+	err := s.ClientStream.SendMsg(m)
+	if err != nil {
+		return err
+	}
+
+	s.recorder.mu.Lock()
+	defer s.recorder.mu.Unlock()
+
+	s.interaction.Events = append(s.interaction.Events, Event{
+		Request: s.recorder.marshal(m),
+	})
+
+	return nil
+}
+
+func (s *recordingStream) RecvMsg(m interface{}) error {
+	err := s.ClientStream.RecvMsg(m)
+
+	s.recorder.mu.Lock()
+	defer s.recorder.mu.Unlock()
+
+	switch {
+	case err == nil:
+		s.interaction.Events = append(s.interaction.Events, Event{
+			Response: s.recorder.marshal(m),
+		})
+	case errors.Is(err, io.EOF):
+	default:
+		s.interaction.Error = recordedStatus(err)
+	}
+
+	return err
+}
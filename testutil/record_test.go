@@ -0,0 +1,255 @@
+package testutil
+
+import (
+	"context"
+	"io"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestRecordReplayUnary(t *testing.T) {
+	ctx := context.Background()
+	recorder := NewRecorder(ScrubSessionIDs(), ScrubTimestamps())
+	cc := recorder.Wrap(NewBalancer(WithInvokeHandlers(InvokeHandlers{
+		TableCreateSession: func(request interface{}) (proto.Message, error) {
+			return &Ydb_Table.CreateSessionResult{
+				SessionId: "ydb://session/3?node_id=1&id=recorded",
+			}, nil
+		},
+		TableKeepAlive: func(request interface{}) (proto.Message, error) {
+			return nil, status.Error(codes.Unavailable, "node is unavailable")
+		},
+	})))
+
+	err := cc.Invoke(ctx, "/Ydb.Table.V1.TableService/CreateSession",
+		&Ydb_Table.CreateSessionRequest{}, &Ydb_Table.CreateSessionResponse{},
+	)
+	require.NoError(t, err)
+	err = cc.Invoke(ctx, "/Ydb.Table.V1.TableService/KeepAlive",
+		&Ydb_Table.KeepAliveRequest{SessionId: "ydb://session/3?node_id=1&id=recorded"},
+		&Ydb_Table.KeepAliveResponse{},
+	)
+	require.Error(t, err)
+
+	path := filepath.Join(t.TempDir(), "unary.json")
+	require.NoError(t, recorder.Save(path))
+
+	cassette, err := LoadCassette(path)
+	require.NoError(t, err)
+	require.Len(t, cassette.Interactions, 2)
+
+	replayer, err := NewReplayer(cassette, ScrubSessionIDs(), ScrubTimestamps())
+	require.NoError(t, err)
+
+	var response Ydb_Table.CreateSessionResponse
+	err = replayer.Invoke(ctx, "/Ydb.Table.V1.TableService/CreateSession",
+		&Ydb_Table.CreateSessionRequest{}, &response,
+	)
+	require.NoError(t, err)
+	var result Ydb_Table.CreateSessionResult
+	require.NoError(t, response.GetOperation().GetResult().UnmarshalTo(&result))
+	require.Equal(t, "<scrubbed>", result.GetSessionId())
+
+	err = replayer.Invoke(ctx, "/Ydb.Table.V1.TableService/KeepAlive",
+		&Ydb_Table.KeepAliveRequest{SessionId: "ydb://session/3?node_id=2&id=replayed"},
+		&Ydb_Table.KeepAliveResponse{},
+	)
+	require.Error(t, err)
+	require.Equal(t, codes.Unavailable, status.Code(err))
+
+	err = replayer.Invoke(ctx, "/Ydb.Table.V1.TableService/CreateSession",
+		&Ydb_Table.CreateSessionRequest{}, &response,
+	)
+	require.Error(t, err)
+}
+
+func TestRecordReplayStream(t *testing.T) {
+	ctx := context.Background()
+	parts := []*Ydb_Table.ExecuteScanQueryPartialResponse{
+		{Status: Ydb.StatusIds_SUCCESS, Result: &Ydb_Table.ExecuteScanQueryPartialResult{
+			ResultSet: &Ydb.ResultSet{Truncated: true},
+		}},
+		{Status: Ydb.StatusIds_SUCCESS, Result: &Ydb_Table.ExecuteScanQueryPartialResult{
+			ResultSet: &Ydb.ResultSet{},
+		}},
+	}
+	recorder := NewRecorder(ScrubTimestamps())
+	cc := recorder.Wrap(NewBalancer(WithNewStreamHandlers(NewStreamHandlers{
+		TableStreamExecuteScanQuery: func(desc *grpc.StreamDesc) (grpc.ClientStream, error) {
+			i := 0
+
+			return &ClientStream{
+				OnSendMsg: func(m interface{}) error {
+					return nil
+				},
+				OnRecvMsg: func(m interface{}) error {
+					if i == len(parts) {
+						return io.EOF
+					}
+					proto.Merge(m.(proto.Message), parts[i])
+					i++
+
+					return nil
+				},
+			}, nil
+		},
+	})))
+
+	recv := func(s grpc.ClientStream) (responses []*Ydb_Table.ExecuteScanQueryPartialResponse) {
+		for {
+			var response Ydb_Table.ExecuteScanQueryPartialResponse
+			if err := s.RecvMsg(&response); err != nil {
+				require.ErrorIs(t, err, io.EOF)
+
+				return responses
+			}
+			responses = append(responses, &response)
+		}
+	}
+
+	request := &Ydb_Table.ExecuteScanQueryRequest{
+		Query: &Ydb_Table.Query{Query: &Ydb_Table.Query_YqlText{YqlText: "SELECT 1"}},
+		Mode:  Ydb_Table.ExecuteScanQueryRequest_MODE_EXEC,
+	}
+	s, err := cc.NewStream(ctx, &grpc.StreamDesc{ServerStreams: true},
+		"/Ydb.Table.V1.TableService/StreamExecuteScanQuery",
+	)
+	require.NoError(t, err)
+	require.NoError(t, s.SendMsg(request))
+	recorded := recv(s)
+	require.Len(t, recorded, 2)
+
+	c, err := recorder.Cassette()
+	require.NoError(t, err)
+
+	replayer, err := NewReplayer(c, ScrubTimestamps())
+	require.NoError(t, err)
+	s, err = replayer.NewStream(ctx, &grpc.StreamDesc{ServerStreams: true},
+		"/Ydb.Table.V1.TableService/StreamExecuteScanQuery",
+	)
+	require.NoError(t, err)
+	require.Error(t, s.SendMsg(&Ydb_Table.ExecuteScanQueryRequest{}))
+	require.NoError(t, s.SendMsg(request))
+	replayed := recv(s)
+	require.Len(t, replayed, len(recorded))
+	for i := range recorded {
+		require.True(t, proto.Equal(recorded[i], replayed[i]))
+	}
+
+	_, err = replayer.NewStream(ctx, &grpc.StreamDesc{ServerStreams: true},
+		"/Ydb.Table.V1.TableService/StreamExecuteScanQuery",
+	)
+	require.Error(t, err)
+}
+
+func TestReplayStreamOrder(t *testing.T) {
+	const method = "/Ydb.Table.V1.TableService/StreamExecuteScanQuery"
+	ctx := context.Background()
+	event := func(request, response proto.Message) Event {
+		var e Event
+		if request != nil {
+			b, err := marshal(request, nil)
+			require.NoError(t, err)
+			e.Request = b
+		}
+		if response != nil {
+			b, err := marshal(response, nil)
+			require.NoError(t, err)
+			e.Response = b
+		}
+
+		return e
+	}
+	first := &Ydb_Table.ExecuteScanQueryRequest{Mode: Ydb_Table.ExecuteScanQueryRequest_MODE_EXPLAIN}
+	second := &Ydb_Table.ExecuteScanQueryRequest{Mode: Ydb_Table.ExecuteScanQueryRequest_MODE_EXEC}
+	replayer, err := NewReplayer(&Cassette{Interactions: []*Interaction{
+		{
+			Method: method,
+			Stream: true,
+			Events: []Event{
+				event(first, nil),
+				event(nil, &Ydb_Table.ExecuteScanQueryPartialResponse{Status: Ydb.StatusIds_SUCCESS}),
+				event(second, nil),
+				event(nil, &Ydb_Table.ExecuteScanQueryPartialResponse{Status: Ydb.StatusIds_OVERLOADED}),
+			},
+		},
+		{
+			Method: method,
+			Stream: true,
+			Events: []Event{
+				event(first, nil),
+				event(nil, &Ydb_Table.ExecuteScanQueryPartialResponse{Status: Ydb.StatusIds_SUCCESS}),
+				event(second, nil),
+			},
+		},
+	}})
+	require.NoError(t, err)
+
+	recv := func(s grpc.ClientStream) <-chan error {
+		ch := make(chan error, 1)
+		go func() {
+			var response Ydb_Table.ExecuteScanQueryPartialResponse
+			ch <- s.RecvMsg(&response)
+		}()
+
+		return ch
+	}
+	requireBlocked := func(ch <-chan error) {
+		select {
+		case err := <-ch:
+			t.Fatalf("response received before recorded request sent: %v", err)
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+
+	t.Run("RecvWaitsForRecordedRequests", func(t *testing.T) {
+		s, err := replayer.NewStream(ctx, &grpc.StreamDesc{ServerStreams: true}, method)
+		require.NoError(t, err)
+
+		received := recv(s)
+		requireBlocked(received)
+		require.NoError(t, s.SendMsg(first))
+		require.NoError(t, <-received)
+
+		received = recv(s)
+		requireBlocked(received)
+		require.NoError(t, s.SendMsg(second))
+		require.NoError(t, <-received)
+
+		require.ErrorIs(t, <-recv(s), io.EOF)
+	})
+	t.Run("CloseSendBeforeRecordedRequest", func(t *testing.T) {
+		s, err := replayer.NewStream(ctx, &grpc.StreamDesc{ServerStreams: true}, method)
+		require.NoError(t, err)
+
+		require.NoError(t, s.SendMsg(first))
+		require.NoError(t, <-recv(s))
+
+		received := recv(s)
+		requireBlocked(received)
+		require.NoError(t, s.CloseSend())
+		require.Error(t, <-received)
+	})
+}
+
+func TestScrubTimestamps(t *testing.T) {
+	b, err := marshal(&Ydb_Table.TableStats{
+		CreationTime: timestamppb.Now(),
+	}, []Scrubber{ScrubTimestamps()})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"creationTime":"1970-01-01T00:00:00Z"}`, string(b))
+
+	var stats Ydb_Table.TableStats
+	require.NoError(t, unmarshal(b, &stats))
+	require.Equal(t, int64(0), stats.GetCreationTime().GetSeconds())
+}
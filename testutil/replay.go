@@ -0,0 +1,290 @@
+package testutil
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// Replayer serves recorded gRPC exchanges from cassette without network
+//
+// Unary calls are matched by method and normalized (canonical and scrubbed) request.
+// Streams are matched by method and replayed in recorded order: sent requests are compared
+// with recorded ones and receive of recorded response blocks until all requests which preceded
+// the response on record have been sent. Calls with same key are served in recorded order
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type Replayer struct {
+	scrubbers []Scrubber
+
+	mu      sync.Mutex
+	unary   map[string][]*Interaction
+	streams map[string][]*Interaction
+}
+
+var _ grpc.ClientConnInterface = (*Replayer)(nil)
+
+// NewReplayer makes replayer of cassette. Scrubbers must be the same as on record
+func NewReplayer(c *Cassette, scrubbers ...Scrubber) (*Replayer, error) {
+	r := &Replayer{
+		scrubbers: scrubbers,
+		unary:     make(map[string][]*Interaction),
+		streams:   make(map[string][]*Interaction),
+	}
+	for _, interaction := range c.Interactions {
+		// golden files are indented and may be edited by hand
+		for i := range interaction.Events {
+			if interaction.Events[i].Request == nil {
+				continue
+			}
+			request, err := canonical(interaction.Events[i].Request, scrubbers)
+			if err != nil {
+				return nil, xerrors.WithStackTrace(err)
+			}
+			interaction.Events[i].Request = request
+		}
+		if interaction.Stream {
+			r.streams[interaction.Method] = append(r.streams[interaction.Method], interaction)
+
+			continue
+		}
+		var request []byte
+		if len(interaction.Events) > 0 {
+			request = interaction.Events[0].Request
+		}
+		key := interaction.Method + "\x00" + string(request)
+		r.unary[key] = append(r.unary[key], interaction)
+	}
+
+	return r, nil
+}
+
+// WithReplayer makes balancer stub which serves calls from replayer
+func WithReplayer(r *Replayer) balancerOption {
+	return func(b *balancerStub) {
+		b.onInvoke = r.Invoke
+		b.onNewStream = r.NewStream
+	}
+}
+
+// DialOptions returns interceptors which serve calls of driver from replayer
+//
+// Use it with ydb.With(config.WithGrpcOptions(replayer.DialOptions()...))
+func (r *Replayer) DialOptions() []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(func(
+			ctx context.Context, method string, req, reply interface{},
+			cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption,
+		) error {
+			return r.Invoke(ctx, method, req, reply, opts...)
+		}),
+		grpc.WithChainStreamInterceptor(func(
+			ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string,
+			streamer grpc.Streamer, opts ...grpc.CallOption,
+		) (grpc.ClientStream, error) {
+			return r.NewStream(ctx, desc, method, opts...)
+		}),
+	}
+}
+
+// Invoke serves unary call from cassette
+func (r *Replayer) Invoke(
+	ctx context.Context,
+	method string,
+	args interface{},
+	reply interface{},
+	opts ...grpc.CallOption,
+) error {
+	request, err := marshal(args, r.scrubbers)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	interaction := r.next(r.unary, method+"\x00"+string(request))
+	if interaction == nil {
+		return xerrors.WithStackTrace(fmt.Errorf(
+			"testutil: no recorded interaction for '%s' with request %s", method, request,
+		))
+	}
+
+	if interaction.Error != nil {
+		return xerrors.WithStackTrace(interaction.Error.err())
+	}
+
+	return unmarshal(interaction.Events[0].Response, reply)
+}
+
+// NewStream serves stream from cassette
+func (r *Replayer) NewStream(
+	ctx context.Context,
+	desc *grpc.StreamDesc,
+	method string,
+	opts ...grpc.CallOption,
+) (grpc.ClientStream, error) {
+	interaction := r.next(r.streams, method)
+	if interaction == nil {
+		return nil, xerrors.WithStackTrace(fmt.Errorf(
+			"testutil: no recorded stream for '%s'", method,
+		))
+	}
+
+	stream := &replayStream{
+		ctx:         ctx,
+		method:      method,
+		scrubbers:   r.scrubbers,
+		interaction: interaction,
+		sent:        make(chan struct{}),
+	}
+	for i, event := range interaction.Events {
+		if event.Request != nil {
+			stream.requests = append(stream.requests, i)
+		}
+		if event.Response != nil {
+			stream.responses = append(stream.responses, i)
+		}
+	}
+
+	if len(stream.responses) == 0 && len(stream.requests) == 0 && interaction.Error != nil {
+		return nil, xerrors.WithStackTrace(interaction.Error.err())
+	}
+
+	return &ClientStream{
+		OnHeader: func() (metadata.MD, error) {
+			return metadata.MD{}, nil
+		},
+		OnCloseSend: stream.closeSend,
+		OnContext: func() context.Context {
+			return ctx
+		},
+		OnSendMsg: stream.send,
+		OnRecvMsg: stream.recv,
+	}, nil
+}
+
+// replayStream replays events of recorded stream in recorded order: response is received
+// only after all requests which preceded it on record have been sent
+type replayStream struct {
+	ctx         context.Context //nolint:containedctx
+	method      string
+	scrubbers   []Scrubber
+	interaction *Interaction
+
+	mu sync.Mutex
+	// requests and responses are indexes of not yet replayed events
+	requests  []int
+	responses []int
+	// sent closes and replaces on each sent request and on close of sending
+	sent       chan struct{}
+	sendClosed bool
+}
+
+func (s *replayStream) send(m interface{}) error {
+	request, err := marshal(m, s.scrubbers)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.requests) == 0 {
+		return xerrors.WithStackTrace(fmt.Errorf(
+			"testutil: unexpected request %s to stream '%s'", request, s.method,
+		))
+	}
+	if expected := s.interaction.Events[s.requests[0]].Request; string(expected) != string(request) {
+		return xerrors.WithStackTrace(fmt.Errorf(
+			"testutil: unexpected request %s to stream '%s', expected %s", request, s.method, expected,
+		))
+	}
+	s.requests = s.requests[1:]
+	s.notify()
+
+	return nil
+}
+
+func (s *replayStream) closeSend() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sendClosed = true
+	s.notify()
+
+	return nil
+}
+
+// notify wakes up receivers which wait for requests. s.mu must be locked
+func (s *replayStream) notify() {
+	close(s.sent)
+	s.sent = make(chan struct{})
+}
+
+func (s *replayStream) recv(m interface{}) error {
+	for {
+		response, wait, err := s.nextResponse()
+		if err != nil {
+			return err
+		}
+		if wait == nil {
+			return unmarshal(response, m)
+		}
+
+		select {
+		case <-s.ctx.Done():
+			return xerrors.WithStackTrace(s.ctx.Err())
+		case <-wait:
+		}
+	}
+}
+
+// nextResponse returns next recorded response if all requests which preceded it on record
+// have been sent, otherwise returns channel for waiting of next sent request
+func (s *replayStream) nextResponse() (response []byte, wait chan struct{}, _ error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	next := len(s.interaction.Events)
+	if len(s.responses) > 0 {
+		next = s.responses[0]
+	}
+	if len(s.requests) > 0 && s.requests[0] < next {
+		if s.sendClosed {
+			return nil, nil, xerrors.WithStackTrace(fmt.Errorf(
+				"testutil: stream '%s' closed for send before recorded request %s",
+				s.method, s.interaction.Events[s.requests[0]].Request,
+			))
+		}
+
+		return nil, s.sent, nil
+	}
+
+	if len(s.responses) == 0 {
+		if s.interaction.Error != nil {
+			return nil, nil, xerrors.WithStackTrace(s.interaction.Error.err())
+		}
+
+		return nil, nil, io.EOF
+	}
+	s.responses = s.responses[1:]
+
+	return s.interaction.Events[next].Response, nil, nil
+}
+
+func (r *Replayer) next(interactions map[string][]*Interaction, key string) *Interaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	queue := interactions[key]
+	if len(queue) == 0 {
+		return nil
+	}
+	interactions[key] = queue[1:]
+
+	return queue[0]
+}
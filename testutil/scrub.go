@@ -0,0 +1,70 @@
+package testutil
+
+import "regexp"
+
+// Scrubber replaces volatile values of message fields (session IDs, timestamps, etc.) before
+// storing messages to cassette and before matching requests on replay
+//
+// Scrubber gets name of field in protojson (camelCase) form and value of field and returns
+// replacement of value
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type Scrubber func(field string, value interface{}) interface{}
+
+var timestampRe = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}(\.\d+)?Z$`)
+
+// ScrubFields replaces values of string fields with given names to "<scrubbed>"
+func ScrubFields(fields ...string) Scrubber {
+	names := make(map[string]struct{}, len(fields))
+	for _, field := range fields {
+		names[field] = struct{}{}
+	}
+
+	return func(field string, value interface{}) interface{} {
+		if _, has := names[field]; has {
+			return "<scrubbed>"
+		}
+
+		return value
+	}
+}
+
+// ScrubSessionIDs replaces session IDs in requests
+func ScrubSessionIDs() Scrubber {
+	return ScrubFields("sessionId")
+}
+
+// ScrubTimestamps replaces values of google.protobuf.Timestamp fields to unix epoch.
+// Replaced values are still valid timestamps, so scrubbed responses can be replayed
+func ScrubTimestamps() Scrubber {
+	return func(field string, value interface{}) interface{} {
+		if s, ok := value.(string); ok && timestampRe.MatchString(s) {
+			return "1970-01-01T00:00:00Z"
+		}
+
+		return value
+	}
+}
+
+func scrub(field string, v interface{}, scrubbers []Scrubber) interface{} {
+	switch vv := v.(type) {
+	case map[string]interface{}:
+		for k := range vv {
+			vv[k] = scrub(k, vv[k], scrubbers)
+		}
+
+		return vv
+	case []interface{}:
+		for i := range vv {
+			vv[i] = scrub(field, vv[i], scrubbers)
+		}
+
+		return vv
+	default:
+		for _, s := range scrubbers {
+			v = s(field, v)
+		}
+
+		return v
+	}
+}
@@ -22,6 +22,13 @@ type Client interface {
 	// Describe topic
 	Describe(ctx context.Context, path string, opts ...topicoptions.DescribeOption) (topictypes.TopicDescription, error)
 
+	// DescribeConsumer describe topic consumer and reading of topic partitions by the consumer
+	DescribeConsumer(
+		ctx context.Context,
+		path, consumer string,
+		opts ...topicoptions.DescribeConsumerOption,
+	) (topictypes.TopicConsumerDescription, error)
+
 	// Drop topic
 	Drop(ctx context.Context, path string, opts ...topicoptions.DropOption) error
 
@@ -2,5 +2,22 @@ package topicoptions
 
 import "github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic"
 
-// DescribeOption type for options of describe method. Not used now.
+// DescribeOption type for options of describe method
 type DescribeOption func(req *rawtopic.DescribeTopicRequest)
+
+// IncludeStats additionally requests statistics of topic and partitions
+func IncludeStats() DescribeOption {
+	return func(req *rawtopic.DescribeTopicRequest) {
+		req.IncludeStats = true
+	}
+}
+
+// DescribeConsumerOption type for options of describe consumer method
+type DescribeConsumerOption func(req *rawtopic.DescribeConsumerRequest)
+
+// IncludeConsumerStats additionally requests statistics of partitions and reading of partitions by consumer
+func IncludeConsumerStats() DescribeConsumerOption {
+	return func(req *rawtopic.DescribeConsumerRequest) {
+		req.IncludeStats = true
+	}
+}
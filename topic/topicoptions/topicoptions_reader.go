@@ -243,6 +243,44 @@ func WithReaderGetPartitionStartOffset(f GetPartitionStartOffsetFunc) ReaderOpti
 	}
 }
 
+type (
+	// PartitionAssignedInfo info about partition, assigned to the reader
+	PartitionAssignedInfo = topicreaderinternal.PublicPartitionAssignedInfo
+
+	// OnPartitionAssignedFunc callback function for handle assign partition to the reader
+	OnPartitionAssignedFunc = topicreaderinternal.PublicOnPartitionAssignedFunc
+
+	// PartitionRevokedInfo info about partition, revoked from the reader
+	PartitionRevokedInfo = topicreaderinternal.PublicPartitionRevokedInfo
+
+	// OnPartitionRevokedFunc callback function for handle revoke partition from the reader
+	OnPartitionRevokedFunc = topicreaderinternal.PublicOnPartitionRevokedFunc
+)
+
+// WithReaderOnPartitionAssigned set handler for start partition session on the reader,
+// for example for load checkpoints of the partition from own storage.
+// The reader doesn't read the partition until the handler returns.
+// The handler can be called concurrently with read messages methods.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithReaderOnPartitionAssigned(f OnPartitionAssignedFunc) ReaderOption {
+	return func(cfg *topicreaderinternal.ReaderConfig) {
+		cfg.OnPartitionAssigned = f
+	}
+}
+
+// WithReaderOnPartitionRevoked set handler for stop partition session on the reader,
+// for example for flush state of the partition.
+// Graceful stop of the partition confirms to the server after the handler returns.
+// The handler calls with Graceful=false for all assigned partitions on reconnect and close of the reader.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithReaderOnPartitionRevoked(f OnPartitionRevokedFunc) ReaderOption {
+	return func(cfg *topicreaderinternal.ReaderConfig) {
+		cfg.OnPartitionRevoked = f
+	}
+}
+
 // WithReaderTrace set tracer for the topic reader
 //
 // Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
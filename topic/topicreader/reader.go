@@ -3,6 +3,7 @@ package topicreader
 import (
 	"context"
 	"sync/atomic"
+	"time"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/topic/topicreadercommon"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/topic/topicreaderinternal"
@@ -160,6 +161,75 @@ type Batch = topicreadercommon.PublicBatch
 // ReadBatchOption is type for options of read batch
 type ReadBatchOption = topicreaderinternal.PublicReadBatchOption
 
+// Pause stops delivering messages of the partitions of topic to ReadMessage and ReadMessagesBatch.
+// Topic is the path of topic as returned by Message.Topic().
+//
+// Buffered messages of the partitions are dropped. Server can't stop partition session by client request, so if
+// the partitions are read now - the reader restarts the stream with all partition sessions of the reader.
+// Start of partition sessions of paused partitions is not confirmed to server until Resume: server doesn't send
+// messages of paused partitions and doesn't spend read quota for them. After Resume the reader reads the partitions
+// from the first not delivered message. The pause is kept over reconnections.
+//
+// Pause, Resume and Seek can be called concurrently with read and commit methods.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func (r *Reader) Pause(ctx context.Context, topic string, partitionIDs ...int64) error {
+	return r.reader.PausePartitions(ctx, topic, partitionIDs...)
+}
+
+// Resume continues delivering messages of paused partitions
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func (r *Reader) Resume(ctx context.Context, topic string, partitionIDs ...int64) error {
+	return r.reader.ResumePartitions(ctx, topic, partitionIDs...)
+}
+
+// Seek moves read position of the partition.
+// Topic is the path of topic as returned by Message.Topic().
+//
+// Seek forward (after received messages) skips messages before the position and doesn't commit them:
+// skipped messages are committed with commit of first message after the position.
+//
+// Seek backward (to received messages or before committed offset of the consumer) restarts the partition
+// session only: the reader moves committed offset of the consumer back to the position (or keeps it, if the
+// position after committed offset) by CommitOffset call outside of the read session, server restarts
+// the partition session and new partition session starts read from the position. Other partitions of the
+// reader continue reading. Reader without consumer restarts all partition sessions of the reader.
+//
+// Seek by time before received messages resolves offset of first message, written at the time or later, by server:
+// the reader opens separate stream without consumer for the partition with read from the time filter. Then seek
+// applied as seek to the offset, committed offset of the consumer moves back to the offset only.
+//
+// If the partition is not assigned to the reader now - the position is applied on next start of the partition.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func (r *Reader) Seek(ctx context.Context, topic string, partitionID int64, position SeekPosition) error {
+	return r.reader.Seek(ctx, topic, partitionID, position)
+}
+
+// Assignments returns partition sessions, assigned to the reader now
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func (r *Reader) Assignments() []PartitionSession {
+	return r.reader.Assignments()
+}
+
+// PartitionSession contains information about partition session of the reader
+type PartitionSession = topicreadercommon.PublicPartitionSession
+
+// SeekPosition is position in partition for Reader.Seek
+type SeekPosition = topicreadercommon.PublicSeekPosition
+
+// SeekToOffset returns position of message with the offset
+func SeekToOffset(offset int64) SeekPosition {
+	return topicreadercommon.NewSeekPositionOffset(offset)
+}
+
+// SeekToTime returns position of first message, written at t or later
+func SeekToTime(t time.Time) SeekPosition {
+	return topicreadercommon.NewSeekPositionTime(t)
+}
+
 // Close stop work with reader
 // return when reader complete internal works, flush commit buffer, ets
 // or when ctx cancelled
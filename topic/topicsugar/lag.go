@@ -0,0 +1,166 @@
+package topicsugar
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/repeater"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/metrics"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicoptions"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topictypes"
+)
+
+const defaultLagPollInterval = 30 * time.Second
+
+type consumerDescriber interface {
+	DescribeConsumer(
+		ctx context.Context,
+		path, consumer string,
+		opts ...topicoptions.DescribeConsumerOption,
+	) (topictypes.TopicConsumerDescription, error)
+}
+
+type (
+	lagPollerConfig struct {
+		interval time.Duration
+		onError  func(err error)
+	}
+	LagPollerOption func(c *lagPollerConfig)
+)
+
+// WithLagPollInterval sets interval between polls of consumer statistics (30s by default)
+func WithLagPollInterval(interval time.Duration) LagPollerOption {
+	return func(c *lagPollerConfig) {
+		if interval > 0 {
+			c.interval = interval
+		}
+	}
+}
+
+// WithLagPollErrorHandler sets callback which calls on errors of describe consumer
+func WithLagPollErrorHandler(onError func(err error)) LagPollerOption {
+	return func(c *lagPollerConfig) {
+		c.onError = onError
+	}
+}
+
+// LagPoller periodically describes topic consumer with statistics and exports lag of consumer
+// per partition into metrics registry
+//
+// Exported gauges (with labels topic, consumer and partition):
+//   - consumer_lag_messages - count of written and not committed messages
+//   - consumer_read_time_lag_seconds - max difference between read time and write time of messages
+//     read during last minute
+//   - consumer_last_read_age_seconds - time since last read from partition
+//   - consumer_committed_offset - committed offset of consumer
+//   - partition_end_offset - offset of next message which will be written into partition
+//
+// Counter consumer_lag_poll_errors (with labels topic and consumer) counts failed polls.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type LagPoller struct {
+	client    consumerDescriber
+	topicPath string
+	consumer  string
+	onError   func(err error)
+	now       func() time.Time
+
+	lagMessages metrics.GaugeVec
+	readTimeLag metrics.GaugeVec
+	lastReadAge metrics.GaugeVec
+	committed   metrics.GaugeVec
+	endOffset   metrics.GaugeVec
+	errors      metrics.CounterVec
+	repeater    repeater.Repeater
+}
+
+func newLagPoller(client consumerDescriber, registry metrics.Registry, topicPath, consumer string,
+	onError func(err error),
+) *LagPoller {
+	return &LagPoller{
+		client:      client,
+		topicPath:   topicPath,
+		consumer:    consumer,
+		onError:     onError,
+		now:         time.Now,
+		lagMessages: registry.GaugeVec("consumer_lag_messages", "topic", "consumer", "partition"),
+		readTimeLag: registry.GaugeVec("consumer_read_time_lag_seconds", "topic", "consumer", "partition"),
+		lastReadAge: registry.GaugeVec("consumer_last_read_age_seconds", "topic", "consumer", "partition"),
+		committed:   registry.GaugeVec("consumer_committed_offset", "topic", "consumer", "partition"),
+		endOffset:   registry.GaugeVec("partition_end_offset", "topic", "consumer", "partition"),
+		errors:      registry.CounterVec("consumer_lag_poll_errors", "topic", "consumer"),
+	}
+}
+
+func (p *LagPoller) partitionLabels(partitionID int64) map[string]string {
+	return map[string]string{
+		"topic":     p.topicPath,
+		"consumer":  p.consumer,
+		"partition": strconv.FormatInt(partitionID, 10),
+	}
+}
+
+// StartLagPoller starts background polling of statistics of consumer of topic
+//
+// Client usually is a topic.Client. Poller must be stopped with Close.
+func StartLagPoller(client consumerDescriber, registry metrics.Registry, topicPath, consumer string,
+	opts ...LagPollerOption,
+) *LagPoller {
+	cfg := &lagPollerConfig{
+		interval: defaultLagPollInterval,
+		onError:  func(err error) {},
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(cfg)
+		}
+	}
+
+	p := newLagPoller(client, registry, topicPath, consumer, cfg.onError)
+	p.repeater = repeater.New(context.Background(), cfg.interval, p.Poll,
+		repeater.WithName("topic lag poller"),
+	)
+	p.repeater.Force()
+
+	return p
+}
+
+// Poll describes consumer and updates metrics immediately
+func (p *LagPoller) Poll(ctx context.Context) error {
+	desc, err := p.client.DescribeConsumer(ctx, p.topicPath, p.consumer, topicoptions.IncludeConsumerStats())
+	if err != nil {
+		p.errors.With(map[string]string{
+			"topic":    p.topicPath,
+			"consumer": p.consumer,
+		}).Inc()
+		p.onError(err)
+
+		return xerrors.WithStackTrace(err)
+	}
+
+	now := p.now()
+	for i := range desc.Partitions {
+		partition := &desc.Partitions[i]
+		labels := p.partitionLabels(partition.PartitionID)
+		stats := &partition.PartitionConsumerStats
+
+		p.lagMessages.With(labels).Set(float64(partition.LagMessages()))
+		p.readTimeLag.With(labels).Set(stats.MaxReadTimeLag.Seconds())
+		p.committed.With(labels).Set(float64(stats.CommittedOffset))
+		p.endOffset.With(labels).Set(float64(partition.PartitionStats.EndOffset))
+		if !stats.LastReadTime.IsZero() {
+			p.lastReadAge.With(labels).Set(now.Sub(stats.LastReadTime).Seconds())
+		}
+	}
+
+	return nil
+}
+
+// Close stops background polling
+func (p *LagPoller) Close() {
+	if p.repeater != nil {
+		p.repeater.Stop()
+	}
+}
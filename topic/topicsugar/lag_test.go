@@ -0,0 +1,140 @@
+package topicsugar
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/metrics"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicoptions"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topictypes"
+)
+
+type testRegistry struct {
+	mu     sync.Mutex
+	values map[string]float64
+}
+
+type testMetric struct {
+	r    *testRegistry
+	name string
+	key  string
+}
+
+func labelsKey(labels map[string]string) string {
+	pairs := make([]string, 0, len(labels))
+	for k, v := range labels {
+		pairs = append(pairs, k+"="+v)
+	}
+	sort.Strings(pairs)
+
+	return strings.Join(pairs, ",")
+}
+
+func (m testMetric) Add(delta float64) {
+	m.r.mu.Lock()
+	defer m.r.mu.Unlock()
+	m.r.values[m.name+"{"+m.key+"}"] += delta
+}
+
+func (m testMetric) Set(value float64) {
+	m.r.mu.Lock()
+	defer m.r.mu.Unlock()
+	m.r.values[m.name+"{"+m.key+"}"] = value
+}
+
+func (m testMetric) Inc() {
+	m.Add(1)
+}
+
+type testVec struct {
+	r    *testRegistry
+	name string
+}
+
+func (v testVec) With(labels map[string]string) testMetric {
+	return testMetric{r: v.r, name: v.name, key: labelsKey(labels)}
+}
+
+type testGaugeVec struct{ testVec }
+
+func (v testGaugeVec) With(labels map[string]string) metrics.Gauge { return v.testVec.With(labels) }
+
+type testCounterVec struct{ testVec }
+
+func (v testCounterVec) With(labels map[string]string) metrics.Counter { return v.testVec.With(labels) }
+
+func (r *testRegistry) CounterVec(name string, labelNames ...string) metrics.CounterVec {
+	return testCounterVec{testVec{r: r, name: name}}
+}
+
+func (r *testRegistry) GaugeVec(name string, labelNames ...string) metrics.GaugeVec {
+	return testGaugeVec{testVec{r: r, name: name}}
+}
+
+func (r *testRegistry) TimerVec(name string, labelNames ...string) metrics.TimerVec {
+	panic("not implemented")
+}
+
+func (r *testRegistry) HistogramVec(name string, buckets []float64, labelNames ...string) metrics.HistogramVec {
+	panic("not implemented")
+}
+
+type testDescriber struct {
+	desc topictypes.TopicConsumerDescription
+	err  error
+}
+
+func (d *testDescriber) DescribeConsumer(ctx context.Context, path, consumer string,
+	opts ...topicoptions.DescribeConsumerOption,
+) (topictypes.TopicConsumerDescription, error) {
+	return d.desc, d.err
+}
+
+func TestLagPollerPoll(t *testing.T) {
+	now := time.Date(2024, time.May, 1, 0, 0, 10, 0, time.UTC)
+	registry := &testRegistry{values: map[string]float64{}}
+	describer := &testDescriber{
+		desc: topictypes.TopicConsumerDescription{
+			Partitions: []topictypes.DescribeConsumerPartitionInfo{
+				{
+					PartitionID: 3,
+					PartitionStats: topictypes.PartitionStats{
+						EndOffset: 100,
+					},
+					PartitionConsumerStats: topictypes.PartitionConsumerStats{
+						CommittedOffset: 60,
+						LastReadTime:    now.Add(-5 * time.Second),
+						MaxReadTimeLag:  1500 * time.Millisecond,
+					},
+				},
+			},
+		},
+	}
+	var errs []error
+	p := newLagPoller(describer, registry, "/local/topic", "consumer", func(err error) {
+		errs = append(errs, err)
+	})
+	p.now = func() time.Time { return now }
+
+	require.NoError(t, p.Poll(context.Background()))
+	labels := "{consumer=consumer,partition=3,topic=/local/topic}"
+	require.Equal(t, map[string]float64{
+		"consumer_lag_messages" + labels:          40,
+		"consumer_read_time_lag_seconds" + labels: 1.5,
+		"consumer_last_read_age_seconds" + labels: 5,
+		"consumer_committed_offset" + labels:      60,
+		"partition_end_offset" + labels:           100,
+	}, registry.values)
+
+	describer.err = errors.New("test")
+	require.ErrorIs(t, p.Poll(context.Background()), describer.err)
+	require.Len(t, errs, 1)
+	require.Equal(t, float64(1), registry.values["consumer_lag_poll_errors{consumer=consumer,topic=/local/topic}"])
+}
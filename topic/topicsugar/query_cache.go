@@ -0,0 +1,30 @@
+package topicsugar
+
+import (
+	"context"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicreader"
+)
+
+// InvalidateQueryResultCache reads changefeed topic of tables and invalidates cached query results which
+// depend on tables on each batch of changes. InvalidateQueryResultCache blocks until context done or reader failed
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func InvalidateQueryResultCache(
+	ctx context.Context, reader *topicreader.Reader, c *query.ResultCache, tables ...string,
+) error {
+	for {
+		batch, err := reader.ReadMessageBatch(ctx)
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+
+		c.Invalidate(tables...)
+
+		if err = reader.Commit(ctx, batch); err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+	}
+}
@@ -0,0 +1,169 @@
+package topictypes
+
+import (
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/clone"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic"
+)
+
+// MultipleWindowsStat contains value of statistic for last minute, hour and day
+type MultipleWindowsStat struct {
+	PerMinute int64
+	PerHour   int64
+	PerDay    int64
+}
+
+// FromRaw convert from internal format to public. Used internally only.
+func (s *MultipleWindowsStat) FromRaw(raw *rawtopic.MultipleWindowsStat) {
+	s.PerMinute = raw.PerMinute
+	s.PerHour = raw.PerHour
+	s.PerDay = raw.PerDay
+}
+
+// TopicStats contains statistics of topic
+type TopicStats struct {
+	StoreSizeBytes int64
+
+	// MinLastWriteTime is a minimum of last write time over all partitions
+	MinLastWriteTime time.Time
+
+	// MaxWriteTimeLag is a maximum of differences between write time and create time of messages
+	// written during last minute
+	MaxWriteTimeLag time.Duration
+
+	BytesWritten MultipleWindowsStat
+}
+
+// FromRaw convert from internal format to public. Used internally only.
+func (s *TopicStats) FromRaw(raw *rawtopic.TopicStats) {
+	s.StoreSizeBytes = raw.StoreSizeBytes
+	s.MinLastWriteTime = raw.MinLastWriteTime.Value
+	s.MaxWriteTimeLag = raw.MaxWriteTimeLag.Value
+	s.BytesWritten.FromRaw(&raw.BytesWritten)
+}
+
+// PartitionStats contains statistics of topic partition
+type PartitionStats struct {
+	// StartOffset is an offset of first message available in partition
+	StartOffset int64
+
+	// EndOffset is an offset of next message which will be written into partition
+	EndOffset int64
+
+	StoreSizeBytes  int64
+	LastWriteTime   time.Time
+	MaxWriteTimeLag time.Duration
+	BytesWritten    MultipleWindowsStat
+
+	// NodeID is an id of node which hosts partition
+	NodeID int32
+}
+
+// FromRaw convert from internal format to public. Used internally only.
+func (s *PartitionStats) FromRaw(raw *rawtopic.PartitionStats) {
+	s.StartOffset = raw.StartOffset
+	s.EndOffset = raw.EndOffset
+	s.StoreSizeBytes = raw.StoreSizeBytes
+	s.LastWriteTime = raw.LastWriteTime.Value
+	s.MaxWriteTimeLag = raw.MaxWriteTimeLag.Value
+	s.BytesWritten.FromRaw(&raw.BytesWritten)
+	s.NodeID = raw.PartitionNodeID
+}
+
+// PartitionConsumerStats contains statistics of reading of topic partition by consumer
+type PartitionConsumerStats struct {
+	LastReadOffset  int64
+	CommittedOffset int64
+
+	// ReadSessionID is an id of reader session which holds partition now (empty if partition is not read)
+	ReadSessionID string
+
+	// ReaderName is a name of reader which holds partition now
+	ReaderName string
+
+	// PartitionReadSessionCreateTime is a time of start reading of partition by current reader session
+	PartitionReadSessionCreateTime time.Time
+
+	LastReadTime time.Time
+
+	// MaxReadTimeLag is a maximum of differences between read time and write time of messages
+	// read during last minute
+	MaxReadTimeLag time.Duration
+
+	// MaxWriteTimeLag is a maximum of differences between write time and create time of messages
+	// read during last minute
+	MaxWriteTimeLag time.Duration
+
+	BytesRead MultipleWindowsStat
+
+	// ConnectionNodeID is an id of node which serves reader session
+	ConnectionNodeID int32
+}
+
+// FromRaw convert from internal format to public. Used internally only.
+func (s *PartitionConsumerStats) FromRaw(raw *rawtopic.PartitionConsumerStats) {
+	s.LastReadOffset = raw.LastReadOffset
+	s.CommittedOffset = raw.CommittedOffset
+	s.ReadSessionID = raw.ReadSessionID
+	s.ReaderName = raw.ReaderName
+	s.PartitionReadSessionCreateTime = raw.PartitionReadSessionCreateTime.Value
+	s.LastReadTime = raw.LastReadTime.Value
+	s.MaxReadTimeLag = raw.MaxReadTimeLag.Value
+	s.MaxWriteTimeLag = raw.MaxWriteTimeLag.Value
+	s.BytesRead.FromRaw(&raw.BytesRead)
+	s.ConnectionNodeID = raw.ConnectionNodeID
+}
+
+// TopicConsumerDescription contains info about topic consumer and reading of topic partitions by the consumer
+type TopicConsumerDescription struct {
+	Path       string
+	Consumer   Consumer
+	Partitions []DescribeConsumerPartitionInfo
+}
+
+// FromRaw convert from internal format to public. Used internally only.
+func (d *TopicConsumerDescription) FromRaw(raw *rawtopic.DescribeConsumerResult) {
+	d.Path = raw.Self.Name
+	d.Consumer.FromRaw(&raw.Consumer)
+
+	d.Partitions = make([]DescribeConsumerPartitionInfo, len(raw.Partitions))
+	for i := range raw.Partitions {
+		d.Partitions[i].FromRaw(&raw.Partitions[i])
+	}
+}
+
+// DescribeConsumerPartitionInfo contains info about partition and reading of partition by consumer
+type DescribeConsumerPartitionInfo struct {
+	PartitionID        int64
+	Active             bool
+	ChildPartitionIDs  []int64
+	ParentPartitionIDs []int64
+
+	// PartitionStats and PartitionConsumerStats filled only if describe called with
+	// topicoptions.IncludeConsumerStats option
+	PartitionStats         PartitionStats
+	PartitionConsumerStats PartitionConsumerStats
+}
+
+// FromRaw convert from internal format to public. Used internally only.
+func (p *DescribeConsumerPartitionInfo) FromRaw(raw *rawtopic.DescribeConsumerPartitionInfo) {
+	p.PartitionID = raw.PartitionID
+	p.Active = raw.Active
+
+	p.ChildPartitionIDs = clone.Int64Slice(raw.ChildPartitionIDs)
+	p.ParentPartitionIDs = clone.Int64Slice(raw.ParentPartitionIDs)
+
+	p.PartitionStats.FromRaw(&raw.PartitionStats)
+	p.PartitionConsumerStats.FromRaw(&raw.PartitionConsumerStats)
+}
+
+// LagMessages returns count of written into partition messages which are not committed by consumer
+func (p *DescribeConsumerPartitionInfo) LagMessages() int64 {
+	lag := p.PartitionStats.EndOffset - p.PartitionConsumerStats.CommittedOffset
+	if lag < 0 {
+		return 0
+	}
+
+	return lag
+}
@@ -0,0 +1,85 @@
+package topictypes
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawoptional"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawscheme"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic"
+)
+
+func TestTopicConsumerDescriptionFromRaw(t *testing.T) {
+	lastRead := time.Date(2024, time.May, 1, 2, 3, 4, 0, time.UTC)
+	raw := &rawtopic.DescribeConsumerResult{
+		Self: rawscheme.Entry{Name: "consumer"},
+		Consumer: rawtopic.Consumer{
+			Name:      "consumer",
+			Important: true,
+		},
+		Partitions: []rawtopic.DescribeConsumerPartitionInfo{
+			{
+				PartitionID:       1,
+				Active:            true,
+				ChildPartitionIDs: []int64{2},
+				PartitionStats: rawtopic.PartitionStats{
+					StartOffset:     10,
+					EndOffset:       100,
+					StoreSizeBytes:  1024,
+					MaxWriteTimeLag: rawoptional.Duration{Value: time.Second, HasValue: true},
+					BytesWritten:    rawtopic.MultipleWindowsStat{PerMinute: 1, PerHour: 2, PerDay: 3},
+					PartitionNodeID: 7,
+				},
+				PartitionConsumerStats: rawtopic.PartitionConsumerStats{
+					LastReadOffset:   90,
+					CommittedOffset:  80,
+					ReadSessionID:    "session",
+					ReaderName:       "reader",
+					LastReadTime:     rawoptional.Time{Value: lastRead, HasValue: true},
+					MaxReadTimeLag:   rawoptional.Duration{Value: 2 * time.Second, HasValue: true},
+					ConnectionNodeID: 8,
+				},
+			},
+		},
+	}
+
+	var desc TopicConsumerDescription
+	desc.FromRaw(raw)
+
+	require.Equal(t, TopicConsumerDescription{
+		Path: "consumer",
+		Consumer: Consumer{
+			Name:            "consumer",
+			Important:       true,
+			SupportedCodecs: []Codec{},
+		},
+		Partitions: []DescribeConsumerPartitionInfo{
+			{
+				PartitionID:        1,
+				Active:             true,
+				ChildPartitionIDs:  []int64{2},
+				ParentPartitionIDs: nil,
+				PartitionStats: PartitionStats{
+					StartOffset:     10,
+					EndOffset:       100,
+					StoreSizeBytes:  1024,
+					MaxWriteTimeLag: time.Second,
+					BytesWritten:    MultipleWindowsStat{PerMinute: 1, PerHour: 2, PerDay: 3},
+					NodeID:          7,
+				},
+				PartitionConsumerStats: PartitionConsumerStats{
+					LastReadOffset:   90,
+					CommittedOffset:  80,
+					ReadSessionID:    "session",
+					ReaderName:       "reader",
+					LastReadTime:     lastRead,
+					MaxReadTimeLag:   2 * time.Second,
+					ConnectionNodeID: 8,
+				},
+			},
+		},
+	}, desc)
+	require.Equal(t, int64(20), desc.Partitions[0].LagMessages())
+}
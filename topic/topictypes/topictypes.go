@@ -123,6 +123,9 @@ type TopicDescription struct {
 	Attributes                        map[string]string
 	Consumers                         []Consumer
 	MeteringMode                      MeteringMode
+
+	// TopicStats filled only if describe called with topicoptions.IncludeStats option
+	TopicStats TopicStats
 }
 
 // FromRaw convert from public format to internal. Used internally only.
@@ -160,6 +163,8 @@ func (d *TopicDescription) FromRaw(raw *rawtopic.DescribeTopicResult) {
 	}
 
 	d.MeteringMode.FromRaw(raw.MeteringMode)
+
+	d.TopicStats.FromRaw(&raw.TopicStats)
 }
 
 // PartitionInfo contains info about partition.
@@ -168,6 +173,9 @@ type PartitionInfo struct {
 	Active             bool
 	ChildPartitionIDs  []int64
 	ParentPartitionIDs []int64
+
+	// PartitionStats filled only if describe called with topicoptions.IncludeStats option
+	PartitionStats PartitionStats
 }
 
 // FromRaw convert from internal format to public. Used internally only.
@@ -177,4 +185,6 @@ func (p *PartitionInfo) FromRaw(raw *rawtopic.PartitionInfo) {
 
 	p.ChildPartitionIDs = clone.Int64Slice(raw.ChildPartitionIDs)
 	p.ParentPartitionIDs = clone.Int64Slice(raw.ParentPartitionIDs)
+
+	p.PartitionStats.FromRaw(&raw.PartitionStats)
 }
@@ -857,6 +857,111 @@ func (t *Driver) Compose(x *Driver, opts ...DriverComposeOption) *Driver {
 			}
 		}
 	}
+	{
+		h1 := t.OnBalancerCircuitBreakerStateChange
+		h2 := x.OnBalancerCircuitBreakerStateChange
+		ret.OnBalancerCircuitBreakerStateChange = func(d DriverBalancerCircuitBreakerStateChangeStartInfo) func(DriverBalancerCircuitBreakerStateChangeDoneInfo) {
+			if options.panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						options.panicCallback(e)
+					}
+				}()
+			}
+			var r, r1 func(DriverBalancerCircuitBreakerStateChangeDoneInfo)
+			if h1 != nil {
+				r = h1(d)
+			}
+			if h2 != nil {
+				r1 = h2(d)
+			}
+			return func(d DriverBalancerCircuitBreakerStateChangeDoneInfo) {
+				if options.panicCallback != nil {
+					defer func() {
+						if e := recover(); e != nil {
+							options.panicCallback(e)
+						}
+					}()
+				}
+				if r != nil {
+					r(d)
+				}
+				if r1 != nil {
+					r1(d)
+				}
+			}
+		}
+	}
+	{
+		h1 := t.OnBalancerConcurrencyLimiterAcquire
+		h2 := x.OnBalancerConcurrencyLimiterAcquire
+		ret.OnBalancerConcurrencyLimiterAcquire = func(d DriverBalancerConcurrencyLimiterAcquireStartInfo) func(DriverBalancerConcurrencyLimiterAcquireDoneInfo) {
+			if options.panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						options.panicCallback(e)
+					}
+				}()
+			}
+			var r, r1 func(DriverBalancerConcurrencyLimiterAcquireDoneInfo)
+			if h1 != nil {
+				r = h1(d)
+			}
+			if h2 != nil {
+				r1 = h2(d)
+			}
+			return func(d DriverBalancerConcurrencyLimiterAcquireDoneInfo) {
+				if options.panicCallback != nil {
+					defer func() {
+						if e := recover(); e != nil {
+							options.panicCallback(e)
+						}
+					}()
+				}
+				if r != nil {
+					r(d)
+				}
+				if r1 != nil {
+					r1(d)
+				}
+			}
+		}
+	}
+	{
+		h1 := t.OnBalancerConcurrencyLimitChange
+		h2 := x.OnBalancerConcurrencyLimitChange
+		ret.OnBalancerConcurrencyLimitChange = func(d DriverBalancerConcurrencyLimitChangeStartInfo) func(DriverBalancerConcurrencyLimitChangeDoneInfo) {
+			if options.panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						options.panicCallback(e)
+					}
+				}()
+			}
+			var r, r1 func(DriverBalancerConcurrencyLimitChangeDoneInfo)
+			if h1 != nil {
+				r = h1(d)
+			}
+			if h2 != nil {
+				r1 = h2(d)
+			}
+			return func(d DriverBalancerConcurrencyLimitChangeDoneInfo) {
+				if options.panicCallback != nil {
+					defer func() {
+						if e := recover(); e != nil {
+							options.panicCallback(e)
+						}
+					}()
+				}
+				if r != nil {
+					r(d)
+				}
+				if r1 != nil {
+					r1(d)
+				}
+			}
+		}
+	}
 	{
 		h1 := t.OnGetCredentials
 		h2 := x.OnGetCredentials
@@ -892,6 +997,41 @@ func (t *Driver) Compose(x *Driver, opts ...DriverComposeOption) *Driver {
 			}
 		}
 	}
+	{
+		h1 := t.OnTLSCertificatesReload
+		h2 := x.OnTLSCertificatesReload
+		ret.OnTLSCertificatesReload = func(d DriverTLSCertificatesReloadStartInfo) func(DriverTLSCertificatesReloadDoneInfo) {
+			if options.panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						options.panicCallback(e)
+					}
+				}()
+			}
+			var r, r1 func(DriverTLSCertificatesReloadDoneInfo)
+			if h1 != nil {
+				r = h1(d)
+			}
+			if h2 != nil {
+				r1 = h2(d)
+			}
+			return func(d DriverTLSCertificatesReloadDoneInfo) {
+				if options.panicCallback != nil {
+					defer func() {
+						if e := recover(); e != nil {
+							options.panicCallback(e)
+						}
+					}()
+				}
+				if r != nil {
+					r(d)
+				}
+				if r1 != nil {
+					r1(d)
+				}
+			}
+		}
+	}
 	return &ret
 }
 func (t *Driver) onInit(d DriverInitStartInfo) func(DriverInitDoneInfo) {
@@ -1246,6 +1386,51 @@ func (t *Driver) onBalancerUpdate(d DriverBalancerUpdateStartInfo) func(DriverBa
 	}
 	return res
 }
+func (t *Driver) onBalancerCircuitBreakerStateChange(d DriverBalancerCircuitBreakerStateChangeStartInfo) func(DriverBalancerCircuitBreakerStateChangeDoneInfo) {
+	fn := t.OnBalancerCircuitBreakerStateChange
+	if fn == nil {
+		return func(DriverBalancerCircuitBreakerStateChangeDoneInfo) {
+			return
+		}
+	}
+	res := fn(d)
+	if res == nil {
+		return func(DriverBalancerCircuitBreakerStateChangeDoneInfo) {
+			return
+		}
+	}
+	return res
+}
+func (t *Driver) onBalancerConcurrencyLimiterAcquire(d DriverBalancerConcurrencyLimiterAcquireStartInfo) func(DriverBalancerConcurrencyLimiterAcquireDoneInfo) {
+	fn := t.OnBalancerConcurrencyLimiterAcquire
+	if fn == nil {
+		return func(DriverBalancerConcurrencyLimiterAcquireDoneInfo) {
+			return
+		}
+	}
+	res := fn(d)
+	if res == nil {
+		return func(DriverBalancerConcurrencyLimiterAcquireDoneInfo) {
+			return
+		}
+	}
+	return res
+}
+func (t *Driver) onBalancerConcurrencyLimitChange(d DriverBalancerConcurrencyLimitChangeStartInfo) func(DriverBalancerConcurrencyLimitChangeDoneInfo) {
+	fn := t.OnBalancerConcurrencyLimitChange
+	if fn == nil {
+		return func(DriverBalancerConcurrencyLimitChangeDoneInfo) {
+			return
+		}
+	}
+	res := fn(d)
+	if res == nil {
+		return func(DriverBalancerConcurrencyLimitChangeDoneInfo) {
+			return
+		}
+	}
+	return res
+}
 func (t *Driver) onGetCredentials(d DriverGetCredentialsStartInfo) func(DriverGetCredentialsDoneInfo) {
 	fn := t.OnGetCredentials
 	if fn == nil {
@@ -1261,6 +1446,21 @@ func (t *Driver) onGetCredentials(d DriverGetCredentialsStartInfo) func(DriverGe
 	}
 	return res
 }
+func (t *Driver) onTLSCertificatesReload(d DriverTLSCertificatesReloadStartInfo) func(DriverTLSCertificatesReloadDoneInfo) {
+	fn := t.OnTLSCertificatesReload
+	if fn == nil {
+		return func(DriverTLSCertificatesReloadDoneInfo) {
+			return
+		}
+	}
+	res := fn(d)
+	if res == nil {
+		return func(DriverTLSCertificatesReloadDoneInfo) {
+			return
+		}
+	}
+	return res
+}
 // Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 func DriverOnInit(t *Driver, c *context.Context, call call, endpoint string, database string, secure bool) func(error) {
 	var p DriverInitStartInfo
@@ -1580,6 +1780,51 @@ func DriverOnBalancerUpdate(t *Driver, c *context.Context, call call, needLocalD
 	}
 }
 // Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func DriverOnBalancerCircuitBreakerStateChange(t *Driver, c *context.Context, call call, endpoint EndpointInfo, label string, from string, to string) func() {
+	var p DriverBalancerCircuitBreakerStateChangeStartInfo
+	p.Context = c
+	p.Call = call
+	p.Endpoint = endpoint
+	p.Label = label
+	p.From = from
+	p.To = to
+	res := t.onBalancerCircuitBreakerStateChange(p)
+	return func() {
+		var p DriverBalancerCircuitBreakerStateChangeDoneInfo
+		res(p)
+	}
+}
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func DriverOnBalancerConcurrencyLimiterAcquire(t *Driver, c *context.Context, call call, service string, limit int, inFlight int, queued int) func(error) {
+	var p DriverBalancerConcurrencyLimiterAcquireStartInfo
+	p.Context = c
+	p.Call = call
+	p.Service = service
+	p.Limit = limit
+	p.InFlight = inFlight
+	p.Queued = queued
+	res := t.onBalancerConcurrencyLimiterAcquire(p)
+	return func(e error) {
+		var p DriverBalancerConcurrencyLimiterAcquireDoneInfo
+		p.Error = e
+		res(p)
+	}
+}
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func DriverOnBalancerConcurrencyLimitChange(t *Driver, c *context.Context, call call, service string, from int, to int) func() {
+	var p DriverBalancerConcurrencyLimitChangeStartInfo
+	p.Context = c
+	p.Call = call
+	p.Service = service
+	p.From = from
+	p.To = to
+	res := t.onBalancerConcurrencyLimitChange(p)
+	return func() {
+		var p DriverBalancerConcurrencyLimitChangeDoneInfo
+		res(p)
+	}
+}
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 func DriverOnGetCredentials(t *Driver, c *context.Context, call call) func(token string, _ error) {
 	var p DriverGetCredentialsStartInfo
 	p.Context = c
@@ -1592,3 +1837,18 @@ func DriverOnGetCredentials(t *Driver, c *context.Context, call call) func(token
 		res(p)
 	}
 }
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func DriverOnTLSCertificatesReload(t *Driver, c *context.Context, call call, cAFile string, certFile string, keyFile string) func(error) {
+	var p DriverTLSCertificatesReloadStartInfo
+	p.Context = c
+	p.Call = call
+	p.CAFile = cAFile
+	p.CertFile = certFile
+	p.KeyFile = keyFile
+	res := t.onTLSCertificatesReload(p)
+	return func(e error) {
+		var p DriverTLSCertificatesReloadDoneInfo
+		p.Error = e
+		res(p)
+	}
+}
@@ -2,6 +2,7 @@ package trace
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_TableStats"
 )
@@ -119,7 +120,8 @@ type (
 		Context *context.Context
 		Call    call
 
-		Query string
+		Query            string
+		QueryFingerprint fmt.Stringer
 	}
 	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 	QueryExecDoneInfo struct {
@@ -134,7 +136,8 @@ type (
 		Context *context.Context
 		Call    call
 
-		Query string
+		Query            string
+		QueryFingerprint fmt.Stringer
 	}
 	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 	QueryQueryDoneInfo struct {
@@ -149,7 +152,8 @@ type (
 		Context *context.Context
 		Call    call
 
-		Query string
+		Query            string
+		QueryFingerprint fmt.Stringer
 	}
 	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 	QueryQueryResultSetDoneInfo struct {
@@ -164,8 +168,9 @@ type (
 		Context *context.Context
 		Call    call
 
-		Session sessionInfo
-		Query   string
+		Session          sessionInfo
+		Query            string
+		QueryFingerprint fmt.Stringer
 	}
 	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 	QuerySessionQueryResultSetDoneInfo struct {
@@ -180,8 +185,9 @@ type (
 		Context *context.Context
 		Call    call
 
-		Tx    txInfo
-		Query string
+		Tx               txInfo
+		Query            string
+		QueryFingerprint fmt.Stringer
 	}
 	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 	QueryTxQueryResultSetDoneInfo struct {
@@ -196,7 +202,8 @@ type (
 		Context *context.Context
 		Call    call
 
-		Query string
+		Query            string
+		QueryFingerprint fmt.Stringer
 	}
 	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 	QueryQueryRowDoneInfo struct {
@@ -211,8 +218,9 @@ type (
 		Context *context.Context
 		Call    call
 
-		Session sessionInfo
-		Query   string
+		Session          sessionInfo
+		Query            string
+		QueryFingerprint fmt.Stringer
 	}
 	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 	QuerySessionQueryRowDoneInfo struct {
@@ -227,8 +235,9 @@ type (
 		Context *context.Context
 		Call    call
 
-		Tx    txInfo
-		Query string
+		Tx               txInfo
+		Query            string
+		QueryFingerprint fmt.Stringer
 	}
 	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 	QueryTxQueryRowDoneInfo struct {
@@ -243,7 +252,8 @@ type (
 		Context *context.Context
 		Call    call
 
-		Query string
+		Query            string
+		QueryFingerprint fmt.Stringer
 	}
 	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 	QueryReadRowDoneInfo struct {
@@ -258,7 +268,8 @@ type (
 		Context *context.Context
 		Call    call
 
-		Query string
+		Query            string
+		QueryFingerprint fmt.Stringer
 	}
 	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 	QueryReadResultSetDoneInfo struct {
@@ -287,8 +298,9 @@ type (
 		Context *context.Context
 		Call    call
 
-		Session sessionInfo
-		Query   string
+		Session          sessionInfo
+		Query            string
+		QueryFingerprint fmt.Stringer
 	}
 	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 	QuerySessionExecDoneInfo struct {
@@ -303,8 +315,9 @@ type (
 		Context *context.Context
 		Call    call
 
-		Session sessionInfo
-		Query   string
+		Session          sessionInfo
+		Query            string
+		QueryFingerprint fmt.Stringer
 	}
 	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 	QuerySessionQueryDoneInfo struct {
@@ -319,9 +332,10 @@ type (
 		Context *context.Context
 		Call    call
 
-		Session sessionInfo
-		Tx      txInfo
-		Query   string
+		Session          sessionInfo
+		Tx               txInfo
+		Query            string
+		QueryFingerprint fmt.Stringer
 	}
 	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 	QueryTxExecDoneInfo struct {
@@ -336,9 +350,10 @@ type (
 		Context *context.Context
 		Call    call
 
-		Session sessionInfo
-		Tx      txInfo
-		Query   string
+		Session          sessionInfo
+		Tx               txInfo
+		Query            string
+		QueryFingerprint fmt.Stringer
 	}
 	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 	QueryTxQueryDoneInfo struct {
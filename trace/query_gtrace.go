@@ -4,6 +4,7 @@ package trace
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_TableStats"
 )
@@ -1693,11 +1694,12 @@ func QueryOnDoTx(t *Query, c *context.Context, call call) func(attempts int, _ e
 	}
 }
 // Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
-func QueryOnExec(t *Query, c *context.Context, call call, query string) func(error) {
+func QueryOnExec(t *Query, c *context.Context, call call, query string, queryFingerprint fmt.Stringer) func(error) {
 	var p QueryExecStartInfo
 	p.Context = c
 	p.Call = call
 	p.Query = query
+	p.QueryFingerprint = queryFingerprint
 	res := t.onExec(p)
 	return func(e error) {
 		var p QueryExecDoneInfo
@@ -1706,11 +1708,12 @@ func QueryOnExec(t *Query, c *context.Context, call call, query string) func(err
 	}
 }
 // Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
-func QueryOnQuery(t *Query, c *context.Context, call call, query string) func(error) {
+func QueryOnQuery(t *Query, c *context.Context, call call, query string, queryFingerprint fmt.Stringer) func(error) {
 	var p QueryQueryStartInfo
 	p.Context = c
 	p.Call = call
 	p.Query = query
+	p.QueryFingerprint = queryFingerprint
 	res := t.onQuery(p)
 	return func(e error) {
 		var p QueryQueryDoneInfo
@@ -1719,11 +1722,12 @@ func QueryOnQuery(t *Query, c *context.Context, call call, query string) func(er
 	}
 }
 // Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
-func QueryOnQueryResultSet(t *Query, c *context.Context, call call, query string) func(error) {
+func QueryOnQueryResultSet(t *Query, c *context.Context, call call, query string, queryFingerprint fmt.Stringer) func(error) {
 	var p QueryQueryResultSetStartInfo
 	p.Context = c
 	p.Call = call
 	p.Query = query
+	p.QueryFingerprint = queryFingerprint
 	res := t.onQueryResultSet(p)
 	return func(e error) {
 		var p QueryQueryResultSetDoneInfo
@@ -1732,11 +1736,12 @@ func QueryOnQueryResultSet(t *Query, c *context.Context, call call, query string
 	}
 }
 // Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
-func QueryOnQueryRow(t *Query, c *context.Context, call call, query string) func(error) {
+func QueryOnQueryRow(t *Query, c *context.Context, call call, query string, queryFingerprint fmt.Stringer) func(error) {
 	var p QueryQueryRowStartInfo
 	p.Context = c
 	p.Call = call
 	p.Query = query
+	p.QueryFingerprint = queryFingerprint
 	res := t.onQueryRow(p)
 	return func(e error) {
 		var p QueryQueryRowDoneInfo
@@ -1784,12 +1789,13 @@ func QueryOnSessionDelete(t *Query, c *context.Context, call call, session sessi
 	}
 }
 // Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
-func QueryOnSessionExec(t *Query, c *context.Context, call call, session sessionInfo, query string) func(error) {
+func QueryOnSessionExec(t *Query, c *context.Context, call call, session sessionInfo, query string, queryFingerprint fmt.Stringer) func(error) {
 	var p QuerySessionExecStartInfo
 	p.Context = c
 	p.Call = call
 	p.Session = session
 	p.Query = query
+	p.QueryFingerprint = queryFingerprint
 	res := t.onSessionExec(p)
 	return func(e error) {
 		var p QuerySessionExecDoneInfo
@@ -1798,12 +1804,13 @@ func QueryOnSessionExec(t *Query, c *context.Context, call call, session session
 	}
 }
 // Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
-func QueryOnSessionQuery(t *Query, c *context.Context, call call, session sessionInfo, query string) func(error) {
+func QueryOnSessionQuery(t *Query, c *context.Context, call call, session sessionInfo, query string, queryFingerprint fmt.Stringer) func(error) {
 	var p QuerySessionQueryStartInfo
 	p.Context = c
 	p.Call = call
 	p.Session = session
 	p.Query = query
+	p.QueryFingerprint = queryFingerprint
 	res := t.onSessionQuery(p)
 	return func(e error) {
 		var p QuerySessionQueryDoneInfo
@@ -1812,12 +1819,13 @@ func QueryOnSessionQuery(t *Query, c *context.Context, call call, session sessio
 	}
 }
 // Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
-func QueryOnSessionQueryResultSet(t *Query, c *context.Context, call call, session sessionInfo, query string) func(error) {
+func QueryOnSessionQueryResultSet(t *Query, c *context.Context, call call, session sessionInfo, query string, queryFingerprint fmt.Stringer) func(error) {
 	var p QuerySessionQueryResultSetStartInfo
 	p.Context = c
 	p.Call = call
 	p.Session = session
 	p.Query = query
+	p.QueryFingerprint = queryFingerprint
 	res := t.onSessionQueryResultSet(p)
 	return func(e error) {
 		var p QuerySessionQueryResultSetDoneInfo
@@ -1826,12 +1834,13 @@ func QueryOnSessionQueryResultSet(t *Query, c *context.Context, call call, sessi
 	}
 }
 // Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
-func QueryOnSessionQueryRow(t *Query, c *context.Context, call call, session sessionInfo, query string) func(error) {
+func QueryOnSessionQueryRow(t *Query, c *context.Context, call call, session sessionInfo, query string, queryFingerprint fmt.Stringer) func(error) {
 	var p QuerySessionQueryRowStartInfo
 	p.Context = c
 	p.Call = call
 	p.Session = session
 	p.Query = query
+	p.QueryFingerprint = queryFingerprint
 	res := t.onSessionQueryRow(p)
 	return func(e error) {
 		var p QuerySessionQueryRowDoneInfo
@@ -1854,13 +1863,14 @@ func QueryOnSessionBegin(t *Query, c *context.Context, call call, session sessio
 	}
 }
 // Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
-func QueryOnTxExec(t *Query, c *context.Context, call call, session sessionInfo, tx txInfo, query string) func(error) {
+func QueryOnTxExec(t *Query, c *context.Context, call call, session sessionInfo, tx txInfo, query string, queryFingerprint fmt.Stringer) func(error) {
 	var p QueryTxExecStartInfo
 	p.Context = c
 	p.Call = call
 	p.Session = session
 	p.Tx = tx
 	p.Query = query
+	p.QueryFingerprint = queryFingerprint
 	res := t.onTxExec(p)
 	return func(e error) {
 		var p QueryTxExecDoneInfo
@@ -1869,13 +1879,14 @@ func QueryOnTxExec(t *Query, c *context.Context, call call, session sessionInfo,
 	}
 }
 // Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
-func QueryOnTxQuery(t *Query, c *context.Context, call call, session sessionInfo, tx txInfo, query string) func(error) {
+func QueryOnTxQuery(t *Query, c *context.Context, call call, session sessionInfo, tx txInfo, query string, queryFingerprint fmt.Stringer) func(error) {
 	var p QueryTxQueryStartInfo
 	p.Context = c
 	p.Call = call
 	p.Session = session
 	p.Tx = tx
 	p.Query = query
+	p.QueryFingerprint = queryFingerprint
 	res := t.onTxQuery(p)
 	return func(e error) {
 		var p QueryTxQueryDoneInfo
@@ -1884,12 +1895,13 @@ func QueryOnTxQuery(t *Query, c *context.Context, call call, session sessionInfo
 	}
 }
 // Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
-func QueryOnTxQueryResultSet(t *Query, c *context.Context, call call, tx txInfo, query string) func(error) {
+func QueryOnTxQueryResultSet(t *Query, c *context.Context, call call, tx txInfo, query string, queryFingerprint fmt.Stringer) func(error) {
 	var p QueryTxQueryResultSetStartInfo
 	p.Context = c
 	p.Call = call
 	p.Tx = tx
 	p.Query = query
+	p.QueryFingerprint = queryFingerprint
 	res := t.onTxQueryResultSet(p)
 	return func(e error) {
 		var p QueryTxQueryResultSetDoneInfo
@@ -1898,12 +1910,13 @@ func QueryOnTxQueryResultSet(t *Query, c *context.Context, call call, tx txInfo,
 	}
 }
 // Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
-func QueryOnTxQueryRow(t *Query, c *context.Context, call call, tx txInfo, query string) func(error) {
+func QueryOnTxQueryRow(t *Query, c *context.Context, call call, tx txInfo, query string, queryFingerprint fmt.Stringer) func(error) {
 	var p QueryTxQueryRowStartInfo
 	p.Context = c
 	p.Call = call
 	p.Tx = tx
 	p.Query = query
+	p.QueryFingerprint = queryFingerprint
 	res := t.onTxQueryRow(p)
 	return func(e error) {
 		var p QueryTxQueryRowDoneInfo
@@ -2,6 +2,7 @@ package trace
 
 import (
 	"context"
+	"fmt"
 )
 
 // tool gtrace used from ./internal/cmd/gtrace
@@ -162,10 +163,11 @@ type (
 		// Pointer to context provide replacement of context in trace callback function.
 		// Warning: concurrent access to pointer on client side must be excluded.
 		// Safe replacement of context are provided only inside callback function
-		Context *context.Context
-		Call    call
-		Session sessionInfo
-		Query   string
+		Context          *context.Context
+		Call             call
+		Session          sessionInfo
+		Query            string
+		QueryFingerprint fmt.Stringer
 	}
 	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 	TablePrepareDataQueryDoneInfo struct {
@@ -178,12 +180,13 @@ type (
 		// Pointer to context provide replacement of context in trace callback function.
 		// Warning: concurrent access to pointer on client side must be excluded.
 		// Safe replacement of context are provided only inside callback function
-		Context     *context.Context
-		Call        call
-		Session     sessionInfo
-		Query       tableDataQuery
-		Parameters  tableQueryParameters
-		KeepInCache bool
+		Context          *context.Context
+		Call             call
+		Session          sessionInfo
+		Query            tableDataQuery
+		Parameters       tableQueryParameters
+		KeepInCache      bool
+		QueryFingerprint fmt.Stringer
 	}
 	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 	TableTransactionExecuteStartInfo struct {
@@ -191,12 +194,13 @@ type (
 		// Pointer to context provide replacement of context in trace callback function.
 		// Warning: concurrent access to pointer on client side must be excluded.
 		// Safe replacement of context are provided only inside callback function
-		Context    *context.Context
-		Call       call
-		Session    sessionInfo
-		Tx         txInfo
-		Query      tableDataQuery
-		Parameters tableQueryParameters
+		Context          *context.Context
+		Call             call
+		Session          sessionInfo
+		Tx               txInfo
+		Query            tableDataQuery
+		Parameters       tableQueryParameters
+		QueryFingerprint fmt.Stringer
 	}
 	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 	TableTransactionExecuteStatementStartInfo struct {
@@ -204,12 +208,13 @@ type (
 		// Pointer to context provide replacement of context in trace callback function.
 		// Warning: concurrent access to pointer on client side must be excluded.
 		// Safe replacement of context are provided only inside callback function
-		Context        *context.Context
-		Call           call
-		Session        sessionInfo
-		Tx             txInfo
-		StatementQuery tableDataQuery
-		Parameters     tableQueryParameters
+		Context          *context.Context
+		Call             call
+		Session          sessionInfo
+		Tx               txInfo
+		StatementQuery   tableDataQuery
+		Parameters       tableQueryParameters
+		QueryFingerprint fmt.Stringer
 	}
 	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 	TableExplainQueryStartInfo struct {
@@ -217,10 +222,11 @@ type (
 		// Pointer to context provide replacement of context in trace callback function.
 		// Warning: concurrent access to pointer on client side must be excluded.
 		// Safe replacement of context are provided only inside callback function
-		Context *context.Context
-		Call    call
-		Session sessionInfo
-		Query   string
+		Context          *context.Context
+		Call             call
+		Session          sessionInfo
+		Query            string
+		QueryFingerprint fmt.Stringer
 	}
 	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 	TableExplainQueryDoneInfo struct {
@@ -265,11 +271,12 @@ type (
 		// Pointer to context provide replacement of context in trace callback function.
 		// Warning: concurrent access to pointer on client side must be excluded.
 		// Safe replacement of context are provided only inside callback function
-		Context    *context.Context
-		Call       call
-		Session    sessionInfo
-		Query      tableDataQuery
-		Parameters tableQueryParameters
+		Context          *context.Context
+		Call             call
+		Session          sessionInfo
+		Query            tableDataQuery
+		Parameters       tableQueryParameters
+		QueryFingerprint fmt.Stringer
 	}
 	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 	TableSessionQueryStreamExecuteDoneInfo struct {
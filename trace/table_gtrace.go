@@ -4,6 +4,7 @@ package trace
 
 import (
 	"context"
+	"fmt"
 )
 
 // tableComposeOptions is a holder of options
@@ -1336,12 +1337,13 @@ func TableOnSessionBulkUpsert(t *Table, c *context.Context, call call, session s
 	}
 }
 // Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
-func TableOnSessionQueryPrepare(t *Table, c *context.Context, call call, session sessionInfo, query string) func(result tableDataQuery, _ error) {
+func TableOnSessionQueryPrepare(t *Table, c *context.Context, call call, session sessionInfo, query string, queryFingerprint fmt.Stringer) func(result tableDataQuery, _ error) {
 	var p TablePrepareDataQueryStartInfo
 	p.Context = c
 	p.Call = call
 	p.Session = session
 	p.Query = query
+	p.QueryFingerprint = queryFingerprint
 	res := t.onSessionQueryPrepare(p)
 	return func(result tableDataQuery, e error) {
 		var p TablePrepareDataQueryDoneInfo
@@ -1351,7 +1353,7 @@ func TableOnSessionQueryPrepare(t *Table, c *context.Context, call call, session
 	}
 }
 // Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
-func TableOnSessionQueryExecute(t *Table, c *context.Context, call call, session sessionInfo, query tableDataQuery, parameters tableQueryParameters, keepInCache bool) func(tx txInfo, prepared bool, result tableResult, _ error) {
+func TableOnSessionQueryExecute(t *Table, c *context.Context, call call, session sessionInfo, query tableDataQuery, parameters tableQueryParameters, keepInCache bool, queryFingerprint fmt.Stringer) func(tx txInfo, prepared bool, result tableResult, _ error) {
 	var p TableExecuteDataQueryStartInfo
 	p.Context = c
 	p.Call = call
@@ -1359,6 +1361,7 @@ func TableOnSessionQueryExecute(t *Table, c *context.Context, call call, session
 	p.Query = query
 	p.Parameters = parameters
 	p.KeepInCache = keepInCache
+	p.QueryFingerprint = queryFingerprint
 	res := t.onSessionQueryExecute(p)
 	return func(tx txInfo, prepared bool, result tableResult, e error) {
 		var p TableExecuteDataQueryDoneInfo
@@ -1370,12 +1373,13 @@ func TableOnSessionQueryExecute(t *Table, c *context.Context, call call, session
 	}
 }
 // Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
-func TableOnSessionQueryExplain(t *Table, c *context.Context, call call, session sessionInfo, query string) func(aST string, plan string, _ error) {
+func TableOnSessionQueryExplain(t *Table, c *context.Context, call call, session sessionInfo, query string, queryFingerprint fmt.Stringer) func(aST string, plan string, _ error) {
 	var p TableExplainQueryStartInfo
 	p.Context = c
 	p.Call = call
 	p.Session = session
 	p.Query = query
+	p.QueryFingerprint = queryFingerprint
 	res := t.onSessionQueryExplain(p)
 	return func(aST string, plan string, e error) {
 		var p TableExplainQueryDoneInfo
@@ -1386,13 +1390,14 @@ func TableOnSessionQueryExplain(t *Table, c *context.Context, call call, session
 	}
 }
 // Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
-func TableOnSessionQueryStreamExecute(t *Table, c *context.Context, call call, session sessionInfo, query tableDataQuery, parameters tableQueryParameters) func(error) {
+func TableOnSessionQueryStreamExecute(t *Table, c *context.Context, call call, session sessionInfo, query tableDataQuery, parameters tableQueryParameters, queryFingerprint fmt.Stringer) func(error) {
 	var p TableSessionQueryStreamExecuteStartInfo
 	p.Context = c
 	p.Call = call
 	p.Session = session
 	p.Query = query
 	p.Parameters = parameters
+	p.QueryFingerprint = queryFingerprint
 	res := t.onSessionQueryStreamExecute(p)
 	return func(e error) {
 		var p TableSessionQueryStreamExecuteDoneInfo
@@ -1428,7 +1433,7 @@ func TableOnTxBegin(t *Table, c *context.Context, call call, session sessionInfo
 	}
 }
 // Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
-func TableOnTxExecute(t *Table, c *context.Context, call call, session sessionInfo, tx txInfo, query tableDataQuery, parameters tableQueryParameters) func(result tableResult, _ error) {
+func TableOnTxExecute(t *Table, c *context.Context, call call, session sessionInfo, tx txInfo, query tableDataQuery, parameters tableQueryParameters, queryFingerprint fmt.Stringer) func(result tableResult, _ error) {
 	var p TableTransactionExecuteStartInfo
 	p.Context = c
 	p.Call = call
@@ -1436,6 +1441,7 @@ func TableOnTxExecute(t *Table, c *context.Context, call call, session sessionIn
 	p.Tx = tx
 	p.Query = query
 	p.Parameters = parameters
+	p.QueryFingerprint = queryFingerprint
 	res := t.onTxExecute(p)
 	return func(result tableResult, e error) {
 		var p TableTransactionExecuteDoneInfo
@@ -1445,7 +1451,7 @@ func TableOnTxExecute(t *Table, c *context.Context, call call, session sessionIn
 	}
 }
 // Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
-func TableOnTxExecuteStatement(t *Table, c *context.Context, call call, session sessionInfo, tx txInfo, statementQuery tableDataQuery, parameters tableQueryParameters) func(result tableResult, _ error) {
+func TableOnTxExecuteStatement(t *Table, c *context.Context, call call, session sessionInfo, tx txInfo, statementQuery tableDataQuery, parameters tableQueryParameters, queryFingerprint fmt.Stringer) func(result tableResult, _ error) {
 	var p TableTransactionExecuteStatementStartInfo
 	p.Context = c
 	p.Call = call
@@ -1453,6 +1459,7 @@ func TableOnTxExecuteStatement(t *Table, c *context.Context, call call, session
 	p.Tx = tx
 	p.StatementQuery = statementQuery
 	p.Parameters = parameters
+	p.QueryFingerprint = queryFingerprint
 	res := t.onTxExecuteStatement(p)
 	return func(result tableResult, e error) {
 		var p TableTransactionExecuteStatementDoneInfo